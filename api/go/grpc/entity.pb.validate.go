@@ -90,6 +90,55 @@ func (m *Transaction) validate(all bool) error {
 
 	// no validation rules for Amount
 
+	// no validation rules for Id
+
+	// no validation rules for AmountSats
+
+	// no validation rules for TransferId
+
+	// no validation rules for ReversedId
+
+	// no validation rules for ReversalReason
+
+	// no validation rules for Asset
+
+	if all {
+		switch v := interface{}(m.GetQuote()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "Quote",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionValidationError{
+					field:  "Quote",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuote()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionValidationError{
+				field:  "Quote",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Type
+
+	// no validation rules for Description
+
+	// no validation rules for ExternalRef
+
+	// no validation rules for Labels
+
 	if len(errors) > 0 {
 		return TransactionMultiError(errors)
 	}
@@ -191,6 +240,43 @@ func (m *UserBalance) validate(all bool) error {
 
 	// no validation rules for Balance
 
+	// no validation rules for BalanceSats
+
+	// no validation rules for Asset
+
+	if all {
+		switch v := interface{}(m.GetQuote()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserBalanceValidationError{
+					field:  "Quote",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserBalanceValidationError{
+					field:  "Quote",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuote()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserBalanceValidationError{
+				field:  "Quote",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AvailableBalance
+
+	// no validation rules for AvailableBalanceSats
+
 	if len(errors) > 0 {
 		return UserBalanceMultiError(errors)
 	}
@@ -267,3 +353,1683 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = UserBalanceValidationError{}
+
+// Validate checks the field values on Quote with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Quote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Quote with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in QuoteMultiError, or nil if none found.
+func (m *Quote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Quote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Currency
+
+	// no validation rules for Price
+
+	// no validation rules for Value
+
+	if all {
+		switch v := interface{}(m.GetDatetime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QuoteValidationError{
+					field:  "Datetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QuoteValidationError{
+					field:  "Datetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatetime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QuoteValidationError{
+				field:  "Datetime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return QuoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuoteMultiError is an error wrapping multiple validation errors returned by
+// Quote.ValidateAll() if the designated constraints aren't met.
+type QuoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuoteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuoteMultiError) AllErrors() []error { return m }
+
+// QuoteValidationError is the validation error returned by Quote.Validate if
+// the designated constraints aren't met.
+type QuoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuoteValidationError) ErrorName() string { return "QuoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuoteValidationError{}
+
+// Validate checks the field values on BalancePoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BalancePoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BalancePoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BalancePointMultiError, or
+// nil if none found.
+func (m *BalancePoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BalancePoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDatetime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BalancePointValidationError{
+					field:  "Datetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BalancePointValidationError{
+					field:  "Datetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDatetime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BalancePointValidationError{
+				field:  "Datetime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Balance
+
+	// no validation rules for BalanceSats
+
+	if len(errors) > 0 {
+		return BalancePointMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalancePointMultiError is an error wrapping multiple validation errors
+// returned by BalancePoint.ValidateAll() if the designated constraints aren't met.
+type BalancePointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalancePointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalancePointMultiError) AllErrors() []error { return m }
+
+// BalancePointValidationError is the validation error returned by
+// BalancePoint.Validate if the designated constraints aren't met.
+type BalancePointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalancePointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalancePointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalancePointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalancePointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalancePointValidationError) ErrorName() string { return "BalancePointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BalancePointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalancePoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalancePointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalancePointValidationError{}
+
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ExternalRef
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeactivatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeactivatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeactivatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeactivatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "DeactivatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserMultiError) AllErrors() []error { return m }
+
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserValidationError{}
+
+// Validate checks the field values on ImportCheckpoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportCheckpoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportCheckpoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportCheckpointMultiError, or nil if none found.
+func (m *ImportCheckpoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportCheckpoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImportId
+
+	// no validation rules for Sequence
+
+	// no validation rules for Imported
+
+	// no validation rules for Rejected
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportCheckpointValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportCheckpointValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportCheckpointValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportCheckpointMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportCheckpointMultiError is an error wrapping multiple validation errors
+// returned by ImportCheckpoint.ValidateAll() if the designated constraints
+// aren't met.
+type ImportCheckpointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportCheckpointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportCheckpointMultiError) AllErrors() []error { return m }
+
+// ImportCheckpointValidationError is the validation error returned by
+// ImportCheckpoint.Validate if the designated constraints aren't met.
+type ImportCheckpointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportCheckpointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportCheckpointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportCheckpointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportCheckpointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportCheckpointValidationError) ErrorName() string { return "ImportCheckpointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportCheckpointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportCheckpoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportCheckpointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportCheckpointValidationError{}
+
+// Validate checks the field values on Statement with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Statement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Statement with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatementMultiError, or nil
+// if none found.
+func (m *Statement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Statement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Asset
+
+	if all {
+		switch v := interface{}(m.GetStartDatetime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatementValidationError{
+					field:  "StartDatetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatementValidationError{
+					field:  "StartDatetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDatetime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatementValidationError{
+				field:  "StartDatetime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDatetime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatementValidationError{
+					field:  "EndDatetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatementValidationError{
+					field:  "EndDatetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDatetime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatementValidationError{
+				field:  "EndDatetime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OpeningBalance
+
+	// no validation rules for OpeningBalanceSats
+
+	// no validation rules for TotalIn
+
+	// no validation rules for TotalInSats
+
+	// no validation rules for TotalOut
+
+	// no validation rules for TotalOutSats
+
+	// no validation rules for ClosingBalance
+
+	// no validation rules for ClosingBalanceSats
+
+	for idx, item := range m.GetTransactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatementValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatementValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatementValidationError{
+					field:  fmt.Sprintf("Transactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatementValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatementValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatementValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatementMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatementMultiError is an error wrapping multiple validation errors returned
+// by Statement.ValidateAll() if the designated constraints aren't met.
+type StatementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatementMultiError) AllErrors() []error { return m }
+
+// StatementValidationError is the validation error returned by
+// Statement.Validate if the designated constraints aren't met.
+type StatementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatementValidationError) ErrorName() string { return "StatementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatementValidationError{}
+
+// Validate checks the field values on Hold with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Hold) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Hold with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in HoldMultiError, or nil if none found.
+func (m *Hold) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Hold) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Asset
+
+	// no validation rules for AmountSats
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HoldValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HoldValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HoldValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for ExternalRef
+
+	// no validation rules for TransactionId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HoldValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HoldValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HoldValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSettledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HoldValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HoldValidationError{
+					field:  "SettledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HoldValidationError{
+				field:  "SettledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HoldMultiError(errors)
+	}
+
+	return nil
+}
+
+// HoldMultiError is an error wrapping multiple validation errors returned by
+// Hold.ValidateAll() if the designated constraints aren't met.
+type HoldMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HoldMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HoldMultiError) AllErrors() []error { return m }
+
+// HoldValidationError is the validation error returned by Hold.Validate if the
+// designated constraints aren't met.
+type HoldValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HoldValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HoldValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HoldValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HoldValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HoldValidationError) ErrorName() string { return "HoldValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HoldValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHold.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HoldValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HoldValidationError{}
+
+// Validate checks the field values on TransactionLimits with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransactionLimits) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransactionLimits with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransactionLimitsMultiError, or nil if none found.
+func (m *TransactionLimits) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransactionLimits) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Asset
+
+	// no validation rules for MaxAmountSats
+
+	// no validation rules for MaxDailyOutflowSats
+
+	// no validation rules for MaxCount
+
+	// no validation rules for CountWindowSeconds
+
+	if len(errors) > 0 {
+		return TransactionLimitsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionLimitsMultiError is an error wrapping multiple validation errors
+// returned by TransactionLimits.ValidateAll() if the designated constraints
+// aren't met.
+type TransactionLimitsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionLimitsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionLimitsMultiError) AllErrors() []error { return m }
+
+// TransactionLimitsValidationError is the validation error returned by
+// TransactionLimits.Validate if the designated constraints aren't met.
+type TransactionLimitsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionLimitsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionLimitsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionLimitsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionLimitsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionLimitsValidationError) ErrorName() string {
+	return "TransactionLimitsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransactionLimitsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransactionLimits.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionLimitsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionLimitsValidationError{}
+
+// Validate checks the field values on Schedule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Schedule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Schedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScheduleMultiError, or nil
+// if none found.
+func (m *Schedule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Schedule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Asset
+
+	// no validation rules for AmountSats
+
+	// no validation rules for Type
+
+	// no validation rules for Description
+
+	// no validation rules for Labels
+
+	// no validation rules for Interval
+
+	if all {
+		switch v := interface{}(m.GetStartAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "StartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "StartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "StartAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "EndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "EndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "EndAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextRunAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "NextRunAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for RunCount
+
+	if all {
+		switch v := interface{}(m.GetLastRunAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "LastRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "LastRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRunAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "LastRunAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastTransactionId
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCancelledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "CancelledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "CancelledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCancelledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "CancelledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleMultiError is an error wrapping multiple validation errors returned
+// by Schedule.ValidateAll() if the designated constraints aren't met.
+type ScheduleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleMultiError) AllErrors() []error { return m }
+
+// ScheduleValidationError is the validation error returned by
+// Schedule.Validate if the designated constraints aren't met.
+type ScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleValidationError) ErrorName() string { return "ScheduleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleValidationError{}
+
+// Validate checks the field values on TransactionCreatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransactionCreatedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransactionCreatedEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransactionCreatedEventMultiError, or nil if none found.
+func (m *TransactionCreatedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransactionCreatedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionCreatedEventValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionCreatedEventValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionCreatedEventValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransactionCreatedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionCreatedEventMultiError is an error wrapping multiple validation
+// errors returned by TransactionCreatedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type TransactionCreatedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionCreatedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionCreatedEventMultiError) AllErrors() []error { return m }
+
+// TransactionCreatedEventValidationError is the validation error returned by
+// TransactionCreatedEvent.Validate if the designated constraints aren't met.
+type TransactionCreatedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionCreatedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionCreatedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionCreatedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionCreatedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionCreatedEventValidationError) ErrorName() string {
+	return "TransactionCreatedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransactionCreatedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransactionCreatedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionCreatedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionCreatedEventValidationError{}
+
+// Validate checks the field values on BalanceChangedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BalanceChangedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BalanceChangedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BalanceChangedEventMultiError, or nil if none found.
+func (m *BalanceChangedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BalanceChangedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if all {
+		switch v := interface{}(m.GetBalance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BalanceChangedEventValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BalanceChangedEventValidationError{
+					field:  "Balance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBalance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BalanceChangedEventValidationError{
+				field:  "Balance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BalanceChangedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalanceChangedEventMultiError is an error wrapping multiple validation
+// errors returned by BalanceChangedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type BalanceChangedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalanceChangedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalanceChangedEventMultiError) AllErrors() []error { return m }
+
+// BalanceChangedEventValidationError is the validation error returned by
+// BalanceChangedEvent.Validate if the designated constraints aren't met.
+type BalanceChangedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalanceChangedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalanceChangedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalanceChangedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalanceChangedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalanceChangedEventValidationError) ErrorName() string {
+	return "BalanceChangedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BalanceChangedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalanceChangedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalanceChangedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalanceChangedEventValidationError{}
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _service_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on CreateTransactionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -79,10 +82,69 @@ func (m *CreateTransactionRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetAmount(); val > -0.1 && val < 0.1 {
+	// no validation rules for Amount
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 64 {
+		err := CreateTransactionRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AmountSats
+
+	if _, ok := _CreateTransactionRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := CreateTransactionRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TransactionType_name[int32(m.GetType())]; !ok {
+		err := CreateTransactionRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1024 {
+		err := CreateTransactionRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetExternalRef()) > 256 {
+		err := CreateTransactionRequestValidationError{
+			field:  "ExternalRef",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLabels()) > 16 {
 		err := CreateTransactionRequestValidationError{
-			field:  "Amount",
-			reason: "value must be outside range (-0.1, 0.1)",
+			field:  "Labels",
+			reason: "value must contain no more than 16 pair(s)",
 		}
 		if !all {
 			return err
@@ -90,6 +152,43 @@ func (m *CreateTransactionRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 63 {
+				err := CreateTransactionRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 63 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 256 {
+				err := CreateTransactionRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 256 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateTransactionRequestMultiError(errors)
 	}
@@ -170,32 +269,39 @@ var _ interface {
 	ErrorName() string
 } = CreateTransactionRequestValidationError{}
 
-// Validate checks the field values on ListTransactionRequest with the rules
+var _CreateTransactionRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on CreateTransactionsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListTransactionRequest) Validate() error {
+func (m *CreateTransactionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListTransactionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListTransactionRequestMultiError, or nil if none found.
-func (m *ListTransactionRequest) ValidateAll() error {
+// CreateTransactionsRequestMultiError, or nil if none found.
+func (m *CreateTransactionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListTransactionRequest) validate(all bool) error {
+func (m *CreateTransactionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserId() < 1 {
-		err := ListTransactionRequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than or equal to 1",
+	if l := len(m.GetTransactions()); l < 1 || l > 1000 {
+		err := CreateTransactionsRequestValidationError{
+			field:  "Transactions",
+			reason: "value must contain between 1 and 1000 items, inclusive",
 		}
 		if !all {
 			return err
@@ -203,42 +309,56 @@ func (m *ListTransactionRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStartDatetime() == nil {
-		err := ListTransactionRequestValidationError{
-			field:  "StartDatetime",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetTransactions() {
+		_, _ = idx, item
 
-	if m.GetEndDatetime() == nil {
-		err := ListTransactionRequestValidationError{
-			field:  "EndDatetime",
-			reason: "value is required",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTransactionsRequestValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTransactionsRequestValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTransactionsRequestValidationError{
+					field:  fmt.Sprintf("Transactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
+	// no validation rules for BestEffort
+
 	if len(errors) > 0 {
-		return ListTransactionRequestMultiError(errors)
+		return CreateTransactionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListTransactionRequestMultiError is an error wrapping multiple validation
-// errors returned by ListTransactionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListTransactionRequestMultiError []error
+// CreateTransactionsRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTransactionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateTransactionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListTransactionRequestMultiError) Error() string {
+func (m CreateTransactionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -247,11 +367,11 @@ func (m ListTransactionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListTransactionRequestMultiError) AllErrors() []error { return m }
+func (m CreateTransactionsRequestMultiError) AllErrors() []error { return m }
 
-// ListTransactionRequestValidationError is the validation error returned by
-// ListTransactionRequest.Validate if the designated constraints aren't met.
-type ListTransactionRequestValidationError struct {
+// CreateTransactionsRequestValidationError is the validation error returned by
+// CreateTransactionsRequest.Validate if the designated constraints aren't met.
+type CreateTransactionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -259,24 +379,24 @@ type ListTransactionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTransactionRequestValidationError) Field() string { return e.field }
+func (e CreateTransactionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTransactionRequestValidationError) Reason() string { return e.reason }
+func (e CreateTransactionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTransactionRequestValidationError) Cause() error { return e.cause }
+func (e CreateTransactionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTransactionRequestValidationError) Key() bool { return e.key }
+func (e CreateTransactionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTransactionRequestValidationError) ErrorName() string {
-	return "ListTransactionRequestValidationError"
+func (e CreateTransactionsRequestValidationError) ErrorName() string {
+	return "CreateTransactionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTransactionRequestValidationError) Error() string {
+func (e CreateTransactionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -288,14 +408,14 @@ func (e ListTransactionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTransactionRequest.%s: %s%s",
+		"invalid %sCreateTransactionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTransactionRequestValidationError{}
+var _ error = CreateTransactionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -303,47 +423,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTransactionRequestValidationError{}
+} = CreateTransactionsRequestValidationError{}
 
-// Validate checks the field values on ListTransactionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListTransactionResponse) Validate() error {
+func (m *CreateTransactionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListTransactionResponse with the
+// ValidateAll checks the field values on CreateTransactionsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListTransactionResponseMultiError, or nil if none found.
-func (m *ListTransactionResponse) ValidateAll() error {
+// CreateTransactionsResponseMultiError, or nil if none found.
+func (m *CreateTransactionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListTransactionResponse) validate(all bool) error {
+func (m *CreateTransactionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTransactions() {
+	for idx, item := range m.GetResults() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListTransactionResponseValidationError{
-						field:  fmt.Sprintf("Transactions[%v]", idx),
+					errors = append(errors, CreateTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListTransactionResponseValidationError{
-						field:  fmt.Sprintf("Transactions[%v]", idx),
+					errors = append(errors, CreateTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -351,8 +471,8 @@ func (m *ListTransactionResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListTransactionResponseValidationError{
-					field:  fmt.Sprintf("Transactions[%v]", idx),
+				return CreateTransactionsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -362,19 +482,19 @@ func (m *ListTransactionResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListTransactionResponseMultiError(errors)
+		return CreateTransactionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListTransactionResponseMultiError is an error wrapping multiple validation
-// errors returned by ListTransactionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListTransactionResponseMultiError []error
+// CreateTransactionsResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateTransactionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTransactionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListTransactionResponseMultiError) Error() string {
+func (m CreateTransactionsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -383,11 +503,11 @@ func (m ListTransactionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListTransactionResponseMultiError) AllErrors() []error { return m }
+func (m CreateTransactionsResponseMultiError) AllErrors() []error { return m }
 
-// ListTransactionResponseValidationError is the validation error returned by
-// ListTransactionResponse.Validate if the designated constraints aren't met.
-type ListTransactionResponseValidationError struct {
+// CreateTransactionsResponseValidationError is the validation error returned
+// by CreateTransactionsResponse.Validate if the designated constraints aren't met.
+type CreateTransactionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -395,24 +515,24 @@ type ListTransactionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTransactionResponseValidationError) Field() string { return e.field }
+func (e CreateTransactionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTransactionResponseValidationError) Reason() string { return e.reason }
+func (e CreateTransactionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTransactionResponseValidationError) Cause() error { return e.cause }
+func (e CreateTransactionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTransactionResponseValidationError) Key() bool { return e.key }
+func (e CreateTransactionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTransactionResponseValidationError) ErrorName() string {
-	return "ListTransactionResponseValidationError"
+func (e CreateTransactionsResponseValidationError) ErrorName() string {
+	return "CreateTransactionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTransactionResponseValidationError) Error() string {
+func (e CreateTransactionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -424,14 +544,14 @@ func (e ListTransactionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTransactionResponse.%s: %s%s",
+		"invalid %sCreateTransactionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTransactionResponseValidationError{}
+var _ error = CreateTransactionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -439,55 +559,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTransactionResponseValidationError{}
+} = CreateTransactionsResponseValidationError{}
 
-// Validate checks the field values on GetUserBalanceRequest with the rules
+// Validate checks the field values on CreateTransactionsResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserBalanceRequest) Validate() error {
+func (m *CreateTransactionsResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserBalanceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateTransactionsResult with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserBalanceRequestMultiError, or nil if none found.
-func (m *GetUserBalanceRequest) ValidateAll() error {
+// CreateTransactionsResultMultiError, or nil if none found.
+func (m *CreateTransactionsResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserBalanceRequest) validate(all bool) error {
+func (m *CreateTransactionsResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserId() < 1 {
-		err := GetUserBalanceRequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than or equal to 1",
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTransactionsResultValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTransactionsResultValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTransactionsResultValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for Code
+
+	// no validation rules for Message
+
 	if len(errors) > 0 {
-		return GetUserBalanceRequestMultiError(errors)
+		return CreateTransactionsResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserBalanceRequestMultiError is an error wrapping multiple validation
-// errors returned by GetUserBalanceRequest.ValidateAll() if the designated
+// CreateTransactionsResultMultiError is an error wrapping multiple validation
+// errors returned by CreateTransactionsResult.ValidateAll() if the designated
 // constraints aren't met.
-type GetUserBalanceRequestMultiError []error
+type CreateTransactionsResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserBalanceRequestMultiError) Error() string {
+func (m CreateTransactionsResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -496,11 +638,11 @@ func (m GetUserBalanceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserBalanceRequestMultiError) AllErrors() []error { return m }
+func (m CreateTransactionsResultMultiError) AllErrors() []error { return m }
 
-// GetUserBalanceRequestValidationError is the validation error returned by
-// GetUserBalanceRequest.Validate if the designated constraints aren't met.
-type GetUserBalanceRequestValidationError struct {
+// CreateTransactionsResultValidationError is the validation error returned by
+// CreateTransactionsResult.Validate if the designated constraints aren't met.
+type CreateTransactionsResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -508,24 +650,24 @@ type GetUserBalanceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserBalanceRequestValidationError) Field() string { return e.field }
+func (e CreateTransactionsResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserBalanceRequestValidationError) Reason() string { return e.reason }
+func (e CreateTransactionsResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserBalanceRequestValidationError) Cause() error { return e.cause }
+func (e CreateTransactionsResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserBalanceRequestValidationError) Key() bool { return e.key }
+func (e CreateTransactionsResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserBalanceRequestValidationError) ErrorName() string {
-	return "GetUserBalanceRequestValidationError"
+func (e CreateTransactionsResultValidationError) ErrorName() string {
+	return "CreateTransactionsResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUserBalanceRequestValidationError) Error() string {
+func (e CreateTransactionsResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -537,14 +679,14 @@ func (e GetUserBalanceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserBalanceRequest.%s: %s%s",
+		"invalid %sCreateTransactionsResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserBalanceRequestValidationError{}
+var _ error = CreateTransactionsResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -552,4 +694,4888 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserBalanceRequestValidationError{}
+} = CreateTransactionsResultValidationError{}
+
+// Validate checks the field values on ListTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTransactionRequestMultiError, or nil if none found.
+func (m *ListTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := ListTransactionRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartDatetime() == nil {
+		err := ListTransactionRequestValidationError{
+			field:  "StartDatetime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndDatetime() == nil {
+		err := ListTransactionRequestValidationError{
+			field:  "EndDatetime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListTransactionRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if _, ok := Bucket_name[int32(m.GetBucket())]; !ok {
+		err := ListTransactionRequestValidationError{
+			field:  "Bucket",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListTransactionRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := ListTransactionRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListTransactionRequest_QuoteCurrency_Pattern.MatchString(m.GetQuoteCurrency()) {
+		err := ListTransactionRequestValidationError{
+			field:  "QuoteCurrency",
+			reason: "value does not match regex pattern \"^([A-Z]{3})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TransactionType_name[int32(m.GetType())]; !ok {
+		err := ListTransactionRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetExternalRef()) > 256 {
+		err := ListTransactionRequestValidationError{
+			field:  "ExternalRef",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTransactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTransactionRequestMultiError) AllErrors() []error { return m }
+
+// ListTransactionRequestValidationError is the validation error returned by
+// ListTransactionRequest.Validate if the designated constraints aren't met.
+type ListTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTransactionRequestValidationError) ErrorName() string {
+	return "ListTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTransactionRequestValidationError{}
+
+var _ListTransactionRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+var _ListTransactionRequest_QuoteCurrency_Pattern = regexp.MustCompile("^([A-Z]{3})?$")
+
+// Validate checks the field values on ListTransactionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTransactionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTransactionResponseMultiError, or nil if none found.
+func (m *ListTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTransactionResponseValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTransactionResponseValidationError{
+						field:  fmt.Sprintf("Transactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTransactionResponseValidationError{
+					field:  fmt.Sprintf("Transactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTransactionResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTransactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTransactionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTransactionResponseMultiError) AllErrors() []error { return m }
+
+// ListTransactionResponseValidationError is the validation error returned by
+// ListTransactionResponse.Validate if the designated constraints aren't met.
+type ListTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTransactionResponseValidationError) ErrorName() string {
+	return "ListTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTransactionResponseValidationError{}
+
+// Validate checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionRequestMultiError, or nil if none found.
+func (m *GetTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetTransactionRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetTransactionRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTransactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionRequestMultiError) AllErrors() []error { return m }
+
+// GetTransactionRequestValidationError is the validation error returned by
+// GetTransactionRequest.Validate if the designated constraints aren't met.
+type GetTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionRequestValidationError) ErrorName() string {
+	return "GetTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionRequestValidationError{}
+
+// Validate checks the field values on ReverseTransactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReverseTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReverseTransactionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReverseTransactionRequestMultiError, or nil if none found.
+func (m *ReverseTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReverseTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ReverseTransactionRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 256 {
+		err := ReverseTransactionRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReverseTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReverseTransactionRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReverseTransactionRequestMultiError is an error wrapping multiple validation
+// errors returned by ReverseTransactionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReverseTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReverseTransactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReverseTransactionRequestMultiError) AllErrors() []error { return m }
+
+// ReverseTransactionRequestValidationError is the validation error returned by
+// ReverseTransactionRequest.Validate if the designated constraints aren't met.
+type ReverseTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReverseTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReverseTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReverseTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReverseTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReverseTransactionRequestValidationError) ErrorName() string {
+	return "ReverseTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReverseTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReverseTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReverseTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReverseTransactionRequestValidationError{}
+
+// Validate checks the field values on GetUserBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserBalanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserBalanceRequestMultiError, or nil if none found.
+func (m *GetUserBalanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserBalanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := GetUserBalanceRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserBalanceRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserBalanceRequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserBalanceRequestValidationError{
+				field:  "AsOf",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _GetUserBalanceRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := GetUserBalanceRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetUserBalanceRequest_QuoteCurrency_Pattern.MatchString(m.GetQuoteCurrency()) {
+		err := GetUserBalanceRequestValidationError{
+			field:  "QuoteCurrency",
+			reason: "value does not match regex pattern \"^([A-Z]{3})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserBalanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserBalanceRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserBalanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserBalanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserBalanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserBalanceRequestMultiError) AllErrors() []error { return m }
+
+// GetUserBalanceRequestValidationError is the validation error returned by
+// GetUserBalanceRequest.Validate if the designated constraints aren't met.
+type GetUserBalanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserBalanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserBalanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserBalanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserBalanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserBalanceRequestValidationError) ErrorName() string {
+	return "GetUserBalanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserBalanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserBalanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserBalanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserBalanceRequestValidationError{}
+
+var _GetUserBalanceRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+var _GetUserBalanceRequest_QuoteCurrency_Pattern = regexp.MustCompile("^([A-Z]{3})?$")
+
+// Validate checks the field values on GetBalanceHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBalanceHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceHistoryRequestMultiError, or nil if none found.
+func (m *GetBalanceHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBalanceHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := GetBalanceHistoryRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartDatetime() == nil {
+		err := GetBalanceHistoryRequestValidationError{
+			field:  "StartDatetime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndDatetime() == nil {
+		err := GetBalanceHistoryRequestValidationError{
+			field:  "EndDatetime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetBalanceHistoryRequest_Bucket_NotInLookup[m.GetBucket()]; ok {
+		err := GetBalanceHistoryRequestValidationError{
+			field:  "Bucket",
+			reason: "value must not be in list [1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Bucket_name[int32(m.GetBucket())]; !ok {
+		err := GetBalanceHistoryRequestValidationError{
+			field:  "Bucket",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetBalanceHistoryRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := GetBalanceHistoryRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBalanceHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBalanceHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBalanceHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBalanceHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBalanceHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBalanceHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetBalanceHistoryRequestValidationError is the validation error returned by
+// GetBalanceHistoryRequest.Validate if the designated constraints aren't met.
+type GetBalanceHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBalanceHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBalanceHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBalanceHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBalanceHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBalanceHistoryRequestValidationError) ErrorName() string {
+	return "GetBalanceHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBalanceHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBalanceHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBalanceHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBalanceHistoryRequestValidationError{}
+
+var _GetBalanceHistoryRequest_Bucket_NotInLookup = map[Bucket]struct{}{
+	1: {},
+}
+
+var _GetBalanceHistoryRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on GetBalanceHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBalanceHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBalanceHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBalanceHistoryResponseMultiError, or nil if none found.
+func (m *GetBalanceHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBalanceHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBalances() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetBalanceHistoryResponseValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetBalanceHistoryResponseValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetBalanceHistoryResponseValidationError{
+					field:  fmt.Sprintf("Balances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetBalanceHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBalanceHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBalanceHistoryResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetBalanceHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBalanceHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBalanceHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetBalanceHistoryResponseValidationError is the validation error returned by
+// GetBalanceHistoryResponse.Validate if the designated constraints aren't met.
+type GetBalanceHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBalanceHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBalanceHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBalanceHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBalanceHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBalanceHistoryResponseValidationError) ErrorName() string {
+	return "GetBalanceHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBalanceHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBalanceHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBalanceHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBalanceHistoryResponseValidationError{}
+
+// Validate checks the field values on GenerateStatementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateStatementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateStatementRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateStatementRequestMultiError, or nil if none found.
+func (m *GenerateStatementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateStatementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := GenerateStatementRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GenerateStatementRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := GenerateStatementRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartDatetime() == nil {
+		err := GenerateStatementRequestValidationError{
+			field:  "StartDatetime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndDatetime() == nil {
+		err := GenerateStatementRequestValidationError{
+			field:  "EndDatetime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := StatementFormat_name[int32(m.GetFormat())]; !ok {
+		err := GenerateStatementRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GenerateStatementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateStatementRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateStatementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateStatementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateStatementRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateStatementRequestMultiError) AllErrors() []error { return m }
+
+// GenerateStatementRequestValidationError is the validation error returned by
+// GenerateStatementRequest.Validate if the designated constraints aren't met.
+type GenerateStatementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateStatementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateStatementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateStatementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateStatementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateStatementRequestValidationError) ErrorName() string {
+	return "GenerateStatementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateStatementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateStatementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateStatementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateStatementRequestValidationError{}
+
+var _GenerateStatementRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on GenerateStatementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateStatementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateStatementResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateStatementResponseMultiError, or nil if none found.
+func (m *GenerateStatementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateStatementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateStatementResponseValidationError{
+					field:  "Statement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateStatementResponseValidationError{
+					field:  "Statement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateStatementResponseValidationError{
+				field:  "Statement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ContentType
+
+	// no validation rules for Document
+
+	if len(errors) > 0 {
+		return GenerateStatementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateStatementResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateStatementResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateStatementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateStatementResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateStatementResponseMultiError) AllErrors() []error { return m }
+
+// GenerateStatementResponseValidationError is the validation error returned by
+// GenerateStatementResponse.Validate if the designated constraints aren't met.
+type GenerateStatementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateStatementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateStatementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateStatementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateStatementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateStatementResponseValidationError) ErrorName() string {
+	return "GenerateStatementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateStatementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateStatementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateStatementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateStatementResponseValidationError{}
+
+// Validate checks the field values on WatchUserBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchUserBalanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchUserBalanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchUserBalanceRequestMultiError, or nil if none found.
+func (m *WatchUserBalanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchUserBalanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := WatchUserBalanceRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _WatchUserBalanceRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := WatchUserBalanceRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchUserBalanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchUserBalanceRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchUserBalanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchUserBalanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchUserBalanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchUserBalanceRequestMultiError) AllErrors() []error { return m }
+
+// WatchUserBalanceRequestValidationError is the validation error returned by
+// WatchUserBalanceRequest.Validate if the designated constraints aren't met.
+type WatchUserBalanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchUserBalanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchUserBalanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchUserBalanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchUserBalanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchUserBalanceRequestValidationError) ErrorName() string {
+	return "WatchUserBalanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchUserBalanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchUserBalanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchUserBalanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchUserBalanceRequestValidationError{}
+
+var _WatchUserBalanceRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on ImportTransactionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportTransactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportTransactionsRequestMultiError, or nil if none found.
+func (m *ImportTransactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportTransactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetImportId()); l < 1 || l > 64 {
+		err := ImportTransactionsRequestValidationError{
+			field:  "ImportId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSequence() < 1 {
+		err := ImportTransactionsRequestValidationError{
+			field:  "Sequence",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTransaction() == nil {
+		err := ImportTransactionsRequestValidationError{
+			field:  "Transaction",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportTransactionsRequestValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportTransactionsRequestValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportTransactionsRequestValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportTransactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportTransactionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportTransactionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ImportTransactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportTransactionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportTransactionsRequestMultiError) AllErrors() []error { return m }
+
+// ImportTransactionsRequestValidationError is the validation error returned by
+// ImportTransactionsRequest.Validate if the designated constraints aren't met.
+type ImportTransactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportTransactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportTransactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportTransactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportTransactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportTransactionsRequestValidationError) ErrorName() string {
+	return "ImportTransactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportTransactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportTransactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportTransactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportTransactionsRequestValidationError{}
+
+// Validate checks the field values on ImportTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportTransactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportTransactionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportTransactionsResponseMultiError, or nil if none found.
+func (m *ImportTransactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportTransactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCheckpoint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportTransactionsResponseValidationError{
+					field:  "Checkpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportTransactionsResponseValidationError{
+					field:  "Checkpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheckpoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportTransactionsResponseValidationError{
+				field:  "Checkpoint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Imported
+
+	// no validation rules for Skipped
+
+	// no validation rules for Rejected
+
+	for idx, item := range m.GetRejections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Rejections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Rejections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportTransactionsResponseValidationError{
+					field:  fmt.Sprintf("Rejections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportTransactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportTransactionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ImportTransactionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ImportTransactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportTransactionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportTransactionsResponseMultiError) AllErrors() []error { return m }
+
+// ImportTransactionsResponseValidationError is the validation error returned
+// by ImportTransactionsResponse.Validate if the designated constraints aren't met.
+type ImportTransactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportTransactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportTransactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportTransactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportTransactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportTransactionsResponseValidationError) ErrorName() string {
+	return "ImportTransactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportTransactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportTransactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportTransactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportTransactionsResponseValidationError{}
+
+// Validate checks the field values on ImportRejection with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportRejection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRejection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportRejectionMultiError, or nil if none found.
+func (m *ImportRejection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRejection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sequence
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ImportRejectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRejectionMultiError is an error wrapping multiple validation errors
+// returned by ImportRejection.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRejectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRejectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRejectionMultiError) AllErrors() []error { return m }
+
+// ImportRejectionValidationError is the validation error returned by
+// ImportRejection.Validate if the designated constraints aren't met.
+type ImportRejectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRejectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRejectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRejectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRejectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRejectionValidationError) ErrorName() string { return "ImportRejectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRejectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRejection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRejectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRejectionValidationError{}
+
+// Validate checks the field values on GetImportCheckpointRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetImportCheckpointRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetImportCheckpointRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetImportCheckpointRequestMultiError, or nil if none found.
+func (m *GetImportCheckpointRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetImportCheckpointRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetImportId()); l < 1 || l > 64 {
+		err := GetImportCheckpointRequestValidationError{
+			field:  "ImportId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetImportCheckpointRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetImportCheckpointRequestMultiError is an error wrapping multiple
+// validation errors returned by GetImportCheckpointRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetImportCheckpointRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetImportCheckpointRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetImportCheckpointRequestMultiError) AllErrors() []error { return m }
+
+// GetImportCheckpointRequestValidationError is the validation error returned
+// by GetImportCheckpointRequest.Validate if the designated constraints aren't met.
+type GetImportCheckpointRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetImportCheckpointRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetImportCheckpointRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetImportCheckpointRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetImportCheckpointRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetImportCheckpointRequestValidationError) ErrorName() string {
+	return "GetImportCheckpointRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetImportCheckpointRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetImportCheckpointRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetImportCheckpointRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetImportCheckpointRequestValidationError{}
+
+// Validate checks the field values on ExportTransactionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportTransactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportTransactionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportTransactionsRequestMultiError, or nil if none found.
+func (m *ExportTransactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportTransactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 0 {
+		err := ExportTransactionsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartDatetime() == nil {
+		err := ExportTransactionsRequestValidationError{
+			field:  "StartDatetime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndDatetime() == nil {
+		err := ExportTransactionsRequestValidationError{
+			field:  "EndDatetime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ExportTransactionsRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := ExportTransactionsRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TransactionType_name[int32(m.GetType())]; !ok {
+		err := ExportTransactionsRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetExternalRef()) > 256 {
+		err := ExportTransactionsRequestValidationError{
+			field:  "ExternalRef",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportTransactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportTransactionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportTransactionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ExportTransactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportTransactionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportTransactionsRequestMultiError) AllErrors() []error { return m }
+
+// ExportTransactionsRequestValidationError is the validation error returned by
+// ExportTransactionsRequest.Validate if the designated constraints aren't met.
+type ExportTransactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportTransactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportTransactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportTransactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportTransactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportTransactionsRequestValidationError) ErrorName() string {
+	return "ExportTransactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportTransactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportTransactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportTransactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportTransactionsRequestValidationError{}
+
+var _ExportTransactionsRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on TransferRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransferRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferRequestMultiError, or nil if none found.
+func (m *TransferRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFromUserId() < 1 {
+		err := TransferRequestValidationError{
+			field:  "FromUserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToUserId() < 1 {
+		err := TransferRequestValidationError{
+			field:  "ToUserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDatetime() == nil {
+		err := TransferRequestValidationError{
+			field:  "Datetime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmountSats() < 1 {
+		err := TransferRequestValidationError{
+			field:  "AmountSats",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 64 {
+		err := TransferRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _TransferRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := TransferRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TransferRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferRequestMultiError is an error wrapping multiple validation errors
+// returned by TransferRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TransferRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferRequestMultiError) AllErrors() []error { return m }
+
+// TransferRequestValidationError is the validation error returned by
+// TransferRequest.Validate if the designated constraints aren't met.
+type TransferRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferRequestValidationError) ErrorName() string { return "TransferRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransferRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferRequestValidationError{}
+
+var _TransferRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on TransferResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TransferResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferResponseMultiError, or nil if none found.
+func (m *TransferResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetDebit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferResponseValidationError{
+					field:  "Debit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferResponseValidationError{
+					field:  "Debit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDebit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferResponseValidationError{
+				field:  "Debit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCredit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferResponseValidationError{
+					field:  "Credit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferResponseValidationError{
+					field:  "Credit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCredit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferResponseValidationError{
+				field:  "Credit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransferResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferResponseMultiError is an error wrapping multiple validation errors
+// returned by TransferResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TransferResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferResponseMultiError) AllErrors() []error { return m }
+
+// TransferResponseValidationError is the validation error returned by
+// TransferResponse.Validate if the designated constraints aren't met.
+type TransferResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferResponseValidationError) ErrorName() string { return "TransferResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransferResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferResponseValidationError{}
+
+// Validate checks the field values on CreateHoldRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateHoldRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateHoldRequestMultiError, or nil if none found.
+func (m *CreateHoldRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateHoldRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := CreateHoldRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmountSats() < 1 {
+		err := CreateHoldRequestValidationError{
+			field:  "AmountSats",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateHoldRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := CreateHoldRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateHoldRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateHoldRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateHoldRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1024 {
+		err := CreateHoldRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetExternalRef()) > 256 {
+		err := CreateHoldRequestValidationError{
+			field:  "ExternalRef",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateHoldRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateHoldRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateHoldRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateHoldRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateHoldRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateHoldRequestMultiError) AllErrors() []error { return m }
+
+// CreateHoldRequestValidationError is the validation error returned by
+// CreateHoldRequest.Validate if the designated constraints aren't met.
+type CreateHoldRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateHoldRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateHoldRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateHoldRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateHoldRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateHoldRequestValidationError) ErrorName() string {
+	return "CreateHoldRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateHoldRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateHoldRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateHoldRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateHoldRequestValidationError{}
+
+var _CreateHoldRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on GetHoldRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetHoldRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetHoldRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetHoldRequestMultiError,
+// or nil if none found.
+func (m *GetHoldRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetHoldRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetHoldRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetHoldRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetHoldRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetHoldRequestMultiError is an error wrapping multiple validation errors
+// returned by GetHoldRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetHoldRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetHoldRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetHoldRequestMultiError) AllErrors() []error { return m }
+
+// GetHoldRequestValidationError is the validation error returned by
+// GetHoldRequest.Validate if the designated constraints aren't met.
+type GetHoldRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHoldRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHoldRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHoldRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHoldRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHoldRequestValidationError) ErrorName() string { return "GetHoldRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetHoldRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHoldRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHoldRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHoldRequestValidationError{}
+
+// Validate checks the field values on CaptureHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CaptureHoldRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CaptureHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CaptureHoldRequestMultiError, or nil if none found.
+func (m *CaptureHoldRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CaptureHoldRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = CaptureHoldRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmountSats() < 0 {
+		err := CaptureHoldRequestValidationError{
+			field:  "AmountSats",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CaptureHoldRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CaptureHoldRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CaptureHoldRequestMultiError is an error wrapping multiple validation errors
+// returned by CaptureHoldRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CaptureHoldRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CaptureHoldRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CaptureHoldRequestMultiError) AllErrors() []error { return m }
+
+// CaptureHoldRequestValidationError is the validation error returned by
+// CaptureHoldRequest.Validate if the designated constraints aren't met.
+type CaptureHoldRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptureHoldRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptureHoldRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptureHoldRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptureHoldRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptureHoldRequestValidationError) ErrorName() string {
+	return "CaptureHoldRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CaptureHoldRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptureHoldRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptureHoldRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptureHoldRequestValidationError{}
+
+// Validate checks the field values on CaptureHoldResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CaptureHoldResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CaptureHoldResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CaptureHoldResponseMultiError, or nil if none found.
+func (m *CaptureHoldResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CaptureHoldResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetHold()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CaptureHoldResponseValidationError{
+					field:  "Hold",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CaptureHoldResponseValidationError{
+					field:  "Hold",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHold()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CaptureHoldResponseValidationError{
+				field:  "Hold",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CaptureHoldResponseValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CaptureHoldResponseValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CaptureHoldResponseValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CaptureHoldResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CaptureHoldResponseMultiError is an error wrapping multiple validation
+// errors returned by CaptureHoldResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CaptureHoldResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CaptureHoldResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CaptureHoldResponseMultiError) AllErrors() []error { return m }
+
+// CaptureHoldResponseValidationError is the validation error returned by
+// CaptureHoldResponse.Validate if the designated constraints aren't met.
+type CaptureHoldResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptureHoldResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptureHoldResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptureHoldResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptureHoldResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptureHoldResponseValidationError) ErrorName() string {
+	return "CaptureHoldResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CaptureHoldResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptureHoldResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptureHoldResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptureHoldResponseValidationError{}
+
+// Validate checks the field values on ReleaseHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseHoldRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseHoldRequestMultiError, or nil if none found.
+func (m *ReleaseHoldRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseHoldRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = ReleaseHoldRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReleaseHoldRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReleaseHoldRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReleaseHoldRequestMultiError is an error wrapping multiple validation errors
+// returned by ReleaseHoldRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseHoldRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseHoldRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseHoldRequestMultiError) AllErrors() []error { return m }
+
+// ReleaseHoldRequestValidationError is the validation error returned by
+// ReleaseHoldRequest.Validate if the designated constraints aren't met.
+type ReleaseHoldRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseHoldRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseHoldRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseHoldRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseHoldRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseHoldRequestValidationError) ErrorName() string {
+	return "ReleaseHoldRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseHoldRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseHoldRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseHoldRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseHoldRequestValidationError{}
+
+// Validate checks the field values on SetTransactionLimitsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetTransactionLimitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTransactionLimitsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTransactionLimitsRequestMultiError, or nil if none found.
+func (m *SetTransactionLimitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTransactionLimitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 0 {
+		err := SetTransactionLimitsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetTransactionLimitsRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := SetTransactionLimitsRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxAmountSats() < 0 {
+		err := SetTransactionLimitsRequestValidationError{
+			field:  "MaxAmountSats",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxDailyOutflowSats() < 0 {
+		err := SetTransactionLimitsRequestValidationError{
+			field:  "MaxDailyOutflowSats",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxCount() < 0 {
+		err := SetTransactionLimitsRequestValidationError{
+			field:  "MaxCount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetCountWindowSeconds(); val < 0 || val > 2592000 {
+		err := SetTransactionLimitsRequestValidationError{
+			field:  "CountWindowSeconds",
+			reason: "value must be inside range [0, 2592000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetTransactionLimitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTransactionLimitsRequestMultiError is an error wrapping multiple
+// validation errors returned by SetTransactionLimitsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetTransactionLimitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTransactionLimitsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTransactionLimitsRequestMultiError) AllErrors() []error { return m }
+
+// SetTransactionLimitsRequestValidationError is the validation error returned
+// by SetTransactionLimitsRequest.Validate if the designated constraints
+// aren't met.
+type SetTransactionLimitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTransactionLimitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTransactionLimitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTransactionLimitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTransactionLimitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTransactionLimitsRequestValidationError) ErrorName() string {
+	return "SetTransactionLimitsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetTransactionLimitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTransactionLimitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTransactionLimitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTransactionLimitsRequestValidationError{}
+
+var _SetTransactionLimitsRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on GetTransactionLimitsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransactionLimitsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransactionLimitsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransactionLimitsRequestMultiError, or nil if none found.
+func (m *GetTransactionLimitsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransactionLimitsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 0 {
+		err := GetTransactionLimitsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetTransactionLimitsRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := GetTransactionLimitsRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTransactionLimitsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransactionLimitsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetTransactionLimitsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetTransactionLimitsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransactionLimitsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransactionLimitsRequestMultiError) AllErrors() []error { return m }
+
+// GetTransactionLimitsRequestValidationError is the validation error returned
+// by GetTransactionLimitsRequest.Validate if the designated constraints
+// aren't met.
+type GetTransactionLimitsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransactionLimitsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransactionLimitsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransactionLimitsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransactionLimitsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransactionLimitsRequestValidationError) ErrorName() string {
+	return "GetTransactionLimitsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransactionLimitsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransactionLimitsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransactionLimitsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransactionLimitsRequestValidationError{}
+
+var _GetTransactionLimitsRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on CreateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateScheduleRequestMultiError, or nil if none found.
+func (m *CreateScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := CreateScheduleRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateScheduleRequest_AmountSats_NotInLookup[m.GetAmountSats()]; ok {
+		err := CreateScheduleRequestValidationError{
+			field:  "AmountSats",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateScheduleRequest_Asset_InLookup[m.GetAsset()]; !ok {
+		err := CreateScheduleRequestValidationError{
+			field:  "Asset",
+			reason: "value must be in list [ BTC ETH USDT]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TransactionType_name[int32(m.GetType())]; !ok {
+		err := CreateScheduleRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1024 {
+		err := CreateScheduleRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLabels()) > 16 {
+		err := CreateScheduleRequestValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 16 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 63 {
+				err := CreateScheduleRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be between 1 and 63 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 256 {
+				err := CreateScheduleRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 256 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if _, ok := ScheduleInterval_name[int32(m.GetInterval())]; !ok {
+		err := CreateScheduleRequestValidationError{
+			field:  "Interval",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartAt() == nil {
+		err := CreateScheduleRequestValidationError{
+			field:  "StartAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateScheduleRequestValidationError{
+					field:  "EndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateScheduleRequestValidationError{
+					field:  "EndAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateScheduleRequestValidationError{
+				field:  "EndAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateScheduleRequestMultiError) AllErrors() []error { return m }
+
+// CreateScheduleRequestValidationError is the validation error returned by
+// CreateScheduleRequest.Validate if the designated constraints aren't met.
+type CreateScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScheduleRequestValidationError) ErrorName() string {
+	return "CreateScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScheduleRequestValidationError{}
+
+var _CreateScheduleRequest_AmountSats_NotInLookup = map[int64]struct{}{
+	0: {},
+}
+
+var _CreateScheduleRequest_Asset_InLookup = map[string]struct{}{
+	"":     {},
+	"BTC":  {},
+	"ETH":  {},
+	"USDT": {},
+}
+
+// Validate checks the field values on ListSchedulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSchedulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSchedulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSchedulesRequestMultiError, or nil if none found.
+func (m *ListSchedulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSchedulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 0 {
+		err := ListSchedulesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ScheduleStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListSchedulesRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListSchedulesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListSchedulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSchedulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSchedulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSchedulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSchedulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSchedulesRequestMultiError) AllErrors() []error { return m }
+
+// ListSchedulesRequestValidationError is the validation error returned by
+// ListSchedulesRequest.Validate if the designated constraints aren't met.
+type ListSchedulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSchedulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSchedulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSchedulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSchedulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSchedulesRequestValidationError) ErrorName() string {
+	return "ListSchedulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSchedulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSchedulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSchedulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSchedulesRequestValidationError{}
+
+// Validate checks the field values on ListSchedulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSchedulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSchedulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSchedulesResponseMultiError, or nil if none found.
+func (m *ListSchedulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSchedulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchedules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSchedulesResponseValidationError{
+					field:  fmt.Sprintf("Schedules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListSchedulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSchedulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSchedulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSchedulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSchedulesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSchedulesResponseMultiError) AllErrors() []error { return m }
+
+// ListSchedulesResponseValidationError is the validation error returned by
+// ListSchedulesResponse.Validate if the designated constraints aren't met.
+type ListSchedulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSchedulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSchedulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSchedulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSchedulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSchedulesResponseValidationError) ErrorName() string {
+	return "ListSchedulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSchedulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSchedulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSchedulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSchedulesResponseValidationError{}
+
+// Validate checks the field values on CancelScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelScheduleRequestMultiError, or nil if none found.
+func (m *CancelScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = CancelScheduleRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CancelScheduleRequest) _validateUuid(uuid string) error {
+	if matched := _service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CancelScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduleRequestMultiError) AllErrors() []error { return m }
+
+// CancelScheduleRequestValidationError is the validation error returned by
+// CancelScheduleRequest.Validate if the designated constraints aren't met.
+type CancelScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduleRequestValidationError) ErrorName() string {
+	return "CancelScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduleRequestValidationError{}
+
+// Validate checks the field values on CreateUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUserRequestMultiError, or nil if none found.
+func (m *CreateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetExternalRef()) > 64 {
+		err := CreateUserRequestValidationError{
+			field:  "ExternalRef",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUserRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserRequestMultiError) AllErrors() []error { return m }
+
+// CreateUserRequestValidationError is the validation error returned by
+// CreateUserRequest.Validate if the designated constraints aren't met.
+type CreateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUserRequestValidationError) ErrorName() string {
+	return "CreateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUserRequestValidationError{}
+
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := GetUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
+
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRequestValidationError{}
+
+// Validate checks the field values on ListUsersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersRequestMultiError, or nil if none found.
+func (m *ListUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 1000 {
+		err := ListUsersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if _, ok := UserStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListUsersRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListUsersRequestValidationError is the validation error returned by
+// ListUsersRequest.Validate if the designated constraints aren't met.
+type ListUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersRequestValidationError) ErrorName() string { return "ListUsersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersRequestValidationError{}
+
+// Validate checks the field values on ListUsersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersResponseMultiError, or nil if none found.
+func (m *ListUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListUsersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListUsersResponseValidationError is the validation error returned by
+// ListUsersResponse.Validate if the designated constraints aren't met.
+type ListUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersResponseValidationError) ErrorName() string {
+	return "ListUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersResponseValidationError{}
+
+// Validate checks the field values on DeactivateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeactivateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeactivateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeactivateUserRequestMultiError, or nil if none found.
+func (m *DeactivateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeactivateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := DeactivateUserRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeactivateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeactivateUserRequestMultiError is an error wrapping multiple validation
+// errors returned by DeactivateUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeactivateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeactivateUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeactivateUserRequestMultiError) AllErrors() []error { return m }
+
+// DeactivateUserRequestValidationError is the validation error returned by
+// DeactivateUserRequest.Validate if the designated constraints aren't met.
+type DeactivateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeactivateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeactivateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeactivateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeactivateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeactivateUserRequestValidationError) ErrorName() string {
+	return "DeactivateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeactivateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeactivateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeactivateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeactivateUserRequestValidationError{}
@@ -24,12 +24,94 @@ const _ = grpc.SupportPackageIsVersion7
 type BTCServiceClient interface {
 	// CreateTransaction creates a new record for BTC transaction.
 	// Only single transaction will create by this RPC for a specific User.
+	// A debit that overdraws the balance beyond the overdraft limit of the User is rejected with FailedPrecondition.
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// CreateTransactions creates many records for BTC transaction in a single database transaction.
+	// The result of each entry is reported in the same order as the request.
+	// By default the batch is all-or-nothing, the best_effort creates the valid entries and reports the rejected ones.
+	CreateTransactions(ctx context.Context, in *CreateTransactionsRequest, opts ...grpc.CallOption) (*CreateTransactionsResponse, error)
 	// ListTransaction get the list of records for BTC transaction.
 	// The record can be filtered by specific User.
 	ListTransaction(ctx context.Context, in *ListTransactionRequest, opts ...grpc.CallOption) (*ListTransactionResponse, error)
+	// GetTransaction get a single record of BTC transaction by the ID.
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
+	// ReverseTransaction creates a compensating transaction with the opposite amount of a transaction.
+	// The reversal references the original transaction, and a transaction can only be reversed once.
+	// The reversal and the transactions of a transfer can't be reversed.
+	ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*Transaction, error)
 	// GetUserBalance get the latest balance for a specific User.
+	// The balance at a specific point in time can be get by the as_of.
 	GetUserBalance(ctx context.Context, in *GetUserBalanceRequest, opts ...grpc.CallOption) (*UserBalance, error)
+	// GetBalanceHistory get the closing balance at the end of each bucket for a specific User.
+	// The buckets without transactions are omitted, since the balance is unchanged.
+	GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error)
+	// GenerateStatement generates the account statement of a User for an asset and a period,
+	// with the opening balance, every transaction, the totals in and out, and the closing balance.
+	// The statement is returned as structured data and as a rendered plain text or HTML document.
+	GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*GenerateStatementResponse, error)
+	// CreateHold reserves an amount of a User until it's captured, released or expired.
+	// The pending hold reduces the available balance of the User, but not the balance,
+	// the hold that exceeds the available balance beyond the overdraft limit of the User is rejected with FailedPrecondition.
+	CreateHold(ctx context.Context, in *CreateHoldRequest, opts ...grpc.CallOption) (*Hold, error)
+	// GetHold get a single Hold by the ID.
+	GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*Hold, error)
+	// CaptureHold settles a pending hold by a withdrawal transaction of the captured amount.
+	// The captured amount can be less than the reserved amount, the rest is released.
+	// The settled or expired hold is rejected with FailedPrecondition.
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	// ReleaseHold cancels a pending hold without a debit.
+	// The settled or expired hold is rejected with FailedPrecondition.
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*Hold, error)
+	// SetTransactionLimits sets the limits of the debits of a User for an asset, the user_id of 0 sets the default limits.
+	// The limits are enforced on the debits of CreateTransaction, CreateTransactions, ImportTransactions, Transfer
+	// and the captured amount of CaptureHold,
+	// the debit that exceeds the max_amount_sats is rejected with FailedPrecondition,
+	// and the debit that exceeds the max_daily_outflow_sats or the max_count is rejected with ResourceExhausted.
+	// The debit of a batch or an import that exceeds a limit is rejected the same way as its other rejected entries.
+	SetTransactionLimits(ctx context.Context, in *SetTransactionLimitsRequest, opts ...grpc.CallOption) (*TransactionLimits, error)
+	// GetTransactionLimits get the effective limits of the debits of a User for an asset,
+	// the limits that aren't set for the User are the default limits.
+	GetTransactionLimits(ctx context.Context, in *GetTransactionLimitsRequest, opts ...grpc.CallOption) (*TransactionLimits, error)
+	// CreateSchedule creates a transaction of a User that is created by the scheduler at the start_at, once or recurring.
+	// Each run is created once by CreateTransaction with the datetime of the run, even with many replicas of the service.
+	// The run that is rejected, e.g. by the insufficient balance, is skipped and reported by the last_error of the schedule.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	// ListSchedules get the list of schedules ordered by the created time and the ID.
+	// The schedules can be filtered by the User and the status.
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// CancelSchedule cancels an active schedule, thus the next runs aren't created.
+	// Cancelling an already cancelled schedule returns the schedule as is, while the completed schedule is rejected with FailedPrecondition.
+	CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	// Transfer moves BTC from a User to another User.
+	// The debit and credit transactions are created in a single database transaction,
+	// the debit that overdraws the balance beyond the overdraft limit of the User is rejected with FailedPrecondition.
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	// CreateUser creates a new User.
+	// The User is active once created.
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	// GetUser get a single User by the ID.
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	// ListUsers get the list of Users ordered by the ID.
+	// The Users can be filtered by the status.
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// DeactivateUser deactivates a User, thus the User can't create transactions anymore.
+	// Deactivating an already deactivated User returns the User as is.
+	DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*User, error)
+	// WatchUserBalance streams the balance for a specific User.
+	// The latest balance is sent first, then a new balance whenever a transaction for the User is committed.
+	// The gateway exposes it as a chunked HTTP response of newline-delimited JSON.
+	WatchUserBalance(ctx context.Context, in *WatchUserBalanceRequest, opts ...grpc.CallOption) (BTCService_WatchUserBalanceClient, error)
+	// ImportTransactions creates the records for BTC transaction streamed by the client, e.g. the history of another system.
+	// The rows are written in chunks, each chunk is committed together with the balances and the checkpoint of the import.
+	// A broken import is resumed by streaming the rows after the checkpoint with the same import_id,
+	// the rows up to the checkpoint are skipped.
+	ImportTransactions(ctx context.Context, opts ...grpc.CallOption) (BTCService_ImportTransactionsClient, error)
+	// GetImportCheckpoint get the checkpoint of an import, to resume a broken import.
+	GetImportCheckpoint(ctx context.Context, in *GetImportCheckpointRequest, opts ...grpc.CallOption) (*ImportCheckpoint, error)
+	// ExportTransactions streams the un-aggregated records of BTC transaction, ordered by the datetime and the ID.
+	// The records are read from the database in batches, thus any date range can be exported with constant memory.
+	// The gateway serves it as a CSV or newline-delimited JSON download on GET /v1/transactions/export.
+	ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (BTCService_ExportTransactionsClient, error)
 }
 
 type bTCServiceClient struct {
@@ -49,6 +131,15 @@ func (c *bTCServiceClient) CreateTransaction(ctx context.Context, in *CreateTran
 	return out, nil
 }
 
+func (c *bTCServiceClient) CreateTransactions(ctx context.Context, in *CreateTransactionsRequest, opts ...grpc.CallOption) (*CreateTransactionsResponse, error) {
+	out := new(CreateTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/BTCService/CreateTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bTCServiceClient) ListTransaction(ctx context.Context, in *ListTransactionRequest, opts ...grpc.CallOption) (*ListTransactionResponse, error) {
 	out := new(ListTransactionResponse)
 	err := c.cc.Invoke(ctx, "/BTCService/ListTransaction", in, out, opts...)
@@ -58,6 +149,24 @@ func (c *bTCServiceClient) ListTransaction(ctx context.Context, in *ListTransact
 	return out, nil
 }
 
+func (c *bTCServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/BTCService/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*Transaction, error) {
+	out := new(Transaction)
+	err := c.cc.Invoke(ctx, "/BTCService/ReverseTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bTCServiceClient) GetUserBalance(ctx context.Context, in *GetUserBalanceRequest, opts ...grpc.CallOption) (*UserBalance, error) {
 	out := new(UserBalance)
 	err := c.cc.Invoke(ctx, "/BTCService/GetUserBalance", in, out, opts...)
@@ -67,18 +176,351 @@ func (c *bTCServiceClient) GetUserBalance(ctx context.Context, in *GetUserBalanc
 	return out, nil
 }
 
+func (c *bTCServiceClient) GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error) {
+	out := new(GetBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/BTCService/GetBalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*GenerateStatementResponse, error) {
+	out := new(GenerateStatementResponse)
+	err := c.cc.Invoke(ctx, "/BTCService/GenerateStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) CreateHold(ctx context.Context, in *CreateHoldRequest, opts ...grpc.CallOption) (*Hold, error) {
+	out := new(Hold)
+	err := c.cc.Invoke(ctx, "/BTCService/CreateHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*Hold, error) {
+	out := new(Hold)
+	err := c.cc.Invoke(ctx, "/BTCService/GetHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, "/BTCService/CaptureHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*Hold, error) {
+	out := new(Hold)
+	err := c.cc.Invoke(ctx, "/BTCService/ReleaseHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) SetTransactionLimits(ctx context.Context, in *SetTransactionLimitsRequest, opts ...grpc.CallOption) (*TransactionLimits, error) {
+	out := new(TransactionLimits)
+	err := c.cc.Invoke(ctx, "/BTCService/SetTransactionLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) GetTransactionLimits(ctx context.Context, in *GetTransactionLimitsRequest, opts ...grpc.CallOption) (*TransactionLimits, error) {
+	out := new(TransactionLimits)
+	err := c.cc.Invoke(ctx, "/BTCService/GetTransactionLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, "/BTCService/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/BTCService/ListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, "/BTCService/CancelSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, "/BTCService/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/BTCService/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/BTCService/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/BTCService/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) DeactivateUser(ctx context.Context, in *DeactivateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/BTCService/DeactivateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) WatchUserBalance(ctx context.Context, in *WatchUserBalanceRequest, opts ...grpc.CallOption) (BTCService_WatchUserBalanceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BTCService_ServiceDesc.Streams[0], "/BTCService/WatchUserBalance", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bTCServiceWatchUserBalanceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BTCService_WatchUserBalanceClient interface {
+	Recv() (*UserBalance, error)
+	grpc.ClientStream
+}
+
+type bTCServiceWatchUserBalanceClient struct {
+	grpc.ClientStream
+}
+
+func (x *bTCServiceWatchUserBalanceClient) Recv() (*UserBalance, error) {
+	m := new(UserBalance)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bTCServiceClient) ImportTransactions(ctx context.Context, opts ...grpc.CallOption) (BTCService_ImportTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BTCService_ServiceDesc.Streams[1], "/BTCService/ImportTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bTCServiceImportTransactionsClient{stream}
+	return x, nil
+}
+
+type BTCService_ImportTransactionsClient interface {
+	Send(*ImportTransactionsRequest) error
+	CloseAndRecv() (*ImportTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type bTCServiceImportTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bTCServiceImportTransactionsClient) Send(m *ImportTransactionsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bTCServiceImportTransactionsClient) CloseAndRecv() (*ImportTransactionsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bTCServiceClient) GetImportCheckpoint(ctx context.Context, in *GetImportCheckpointRequest, opts ...grpc.CallOption) (*ImportCheckpoint, error) {
+	out := new(ImportCheckpoint)
+	err := c.cc.Invoke(ctx, "/BTCService/GetImportCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bTCServiceClient) ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (BTCService_ExportTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BTCService_ServiceDesc.Streams[2], "/BTCService/ExportTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bTCServiceExportTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BTCService_ExportTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type bTCServiceExportTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bTCServiceExportTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BTCServiceServer is the server API for BTCService service.
 // All implementations must embed UnimplementedBTCServiceServer
 // for forward compatibility
 type BTCServiceServer interface {
 	// CreateTransaction creates a new record for BTC transaction.
 	// Only single transaction will create by this RPC for a specific User.
+	// A debit that overdraws the balance beyond the overdraft limit of the User is rejected with FailedPrecondition.
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error)
+	// CreateTransactions creates many records for BTC transaction in a single database transaction.
+	// The result of each entry is reported in the same order as the request.
+	// By default the batch is all-or-nothing, the best_effort creates the valid entries and reports the rejected ones.
+	CreateTransactions(context.Context, *CreateTransactionsRequest) (*CreateTransactionsResponse, error)
 	// ListTransaction get the list of records for BTC transaction.
 	// The record can be filtered by specific User.
 	ListTransaction(context.Context, *ListTransactionRequest) (*ListTransactionResponse, error)
+	// GetTransaction get a single record of BTC transaction by the ID.
+	GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error)
+	// ReverseTransaction creates a compensating transaction with the opposite amount of a transaction.
+	// The reversal references the original transaction, and a transaction can only be reversed once.
+	// The reversal and the transactions of a transfer can't be reversed.
+	ReverseTransaction(context.Context, *ReverseTransactionRequest) (*Transaction, error)
 	// GetUserBalance get the latest balance for a specific User.
+	// The balance at a specific point in time can be get by the as_of.
 	GetUserBalance(context.Context, *GetUserBalanceRequest) (*UserBalance, error)
+	// GetBalanceHistory get the closing balance at the end of each bucket for a specific User.
+	// The buckets without transactions are omitted, since the balance is unchanged.
+	GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error)
+	// GenerateStatement generates the account statement of a User for an asset and a period,
+	// with the opening balance, every transaction, the totals in and out, and the closing balance.
+	// The statement is returned as structured data and as a rendered plain text or HTML document.
+	GenerateStatement(context.Context, *GenerateStatementRequest) (*GenerateStatementResponse, error)
+	// CreateHold reserves an amount of a User until it's captured, released or expired.
+	// The pending hold reduces the available balance of the User, but not the balance,
+	// the hold that exceeds the available balance beyond the overdraft limit of the User is rejected with FailedPrecondition.
+	CreateHold(context.Context, *CreateHoldRequest) (*Hold, error)
+	// GetHold get a single Hold by the ID.
+	GetHold(context.Context, *GetHoldRequest) (*Hold, error)
+	// CaptureHold settles a pending hold by a withdrawal transaction of the captured amount.
+	// The captured amount can be less than the reserved amount, the rest is released.
+	// The settled or expired hold is rejected with FailedPrecondition.
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	// ReleaseHold cancels a pending hold without a debit.
+	// The settled or expired hold is rejected with FailedPrecondition.
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*Hold, error)
+	// SetTransactionLimits sets the limits of the debits of a User for an asset, the user_id of 0 sets the default limits.
+	// The limits are enforced on the debits of CreateTransaction, CreateTransactions, ImportTransactions, Transfer
+	// and the captured amount of CaptureHold,
+	// the debit that exceeds the max_amount_sats is rejected with FailedPrecondition,
+	// and the debit that exceeds the max_daily_outflow_sats or the max_count is rejected with ResourceExhausted.
+	// The debit of a batch or an import that exceeds a limit is rejected the same way as its other rejected entries.
+	SetTransactionLimits(context.Context, *SetTransactionLimitsRequest) (*TransactionLimits, error)
+	// GetTransactionLimits get the effective limits of the debits of a User for an asset,
+	// the limits that aren't set for the User are the default limits.
+	GetTransactionLimits(context.Context, *GetTransactionLimitsRequest) (*TransactionLimits, error)
+	// CreateSchedule creates a transaction of a User that is created by the scheduler at the start_at, once or recurring.
+	// Each run is created once by CreateTransaction with the datetime of the run, even with many replicas of the service.
+	// The run that is rejected, e.g. by the insufficient balance, is skipped and reported by the last_error of the schedule.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error)
+	// ListSchedules get the list of schedules ordered by the created time and the ID.
+	// The schedules can be filtered by the User and the status.
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// CancelSchedule cancels an active schedule, thus the next runs aren't created.
+	// Cancelling an already cancelled schedule returns the schedule as is, while the completed schedule is rejected with FailedPrecondition.
+	CancelSchedule(context.Context, *CancelScheduleRequest) (*Schedule, error)
+	// Transfer moves BTC from a User to another User.
+	// The debit and credit transactions are created in a single database transaction,
+	// the debit that overdraws the balance beyond the overdraft limit of the User is rejected with FailedPrecondition.
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	// CreateUser creates a new User.
+	// The User is active once created.
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	// GetUser get a single User by the ID.
+	GetUser(context.Context, *GetUserRequest) (*User, error)
+	// ListUsers get the list of Users ordered by the ID.
+	// The Users can be filtered by the status.
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// DeactivateUser deactivates a User, thus the User can't create transactions anymore.
+	// Deactivating an already deactivated User returns the User as is.
+	DeactivateUser(context.Context, *DeactivateUserRequest) (*User, error)
+	// WatchUserBalance streams the balance for a specific User.
+	// The latest balance is sent first, then a new balance whenever a transaction for the User is committed.
+	// The gateway exposes it as a chunked HTTP response of newline-delimited JSON.
+	WatchUserBalance(*WatchUserBalanceRequest, BTCService_WatchUserBalanceServer) error
+	// ImportTransactions creates the records for BTC transaction streamed by the client, e.g. the history of another system.
+	// The rows are written in chunks, each chunk is committed together with the balances and the checkpoint of the import.
+	// A broken import is resumed by streaming the rows after the checkpoint with the same import_id,
+	// the rows up to the checkpoint are skipped.
+	ImportTransactions(BTCService_ImportTransactionsServer) error
+	// GetImportCheckpoint get the checkpoint of an import, to resume a broken import.
+	GetImportCheckpoint(context.Context, *GetImportCheckpointRequest) (*ImportCheckpoint, error)
+	// ExportTransactions streams the un-aggregated records of BTC transaction, ordered by the datetime and the ID.
+	// The records are read from the database in batches, thus any date range can be exported with constant memory.
+	// The gateway serves it as a CSV or newline-delimited JSON download on GET /v1/transactions/export.
+	ExportTransactions(*ExportTransactionsRequest, BTCService_ExportTransactionsServer) error
 	mustEmbedUnimplementedBTCServiceServer()
 }
 
@@ -89,12 +531,81 @@ type UnimplementedBTCServiceServer struct {
 func (UnimplementedBTCServiceServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*Transaction, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
 }
+func (UnimplementedBTCServiceServer) CreateTransactions(context.Context, *CreateTransactionsRequest) (*CreateTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransactions not implemented")
+}
 func (UnimplementedBTCServiceServer) ListTransaction(context.Context, *ListTransactionRequest) (*ListTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTransaction not implemented")
 }
+func (UnimplementedBTCServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedBTCServiceServer) ReverseTransaction(context.Context, *ReverseTransactionRequest) (*Transaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransaction not implemented")
+}
 func (UnimplementedBTCServiceServer) GetUserBalance(context.Context, *GetUserBalanceRequest) (*UserBalance, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserBalance not implemented")
 }
+func (UnimplementedBTCServiceServer) GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceHistory not implemented")
+}
+func (UnimplementedBTCServiceServer) GenerateStatement(context.Context, *GenerateStatementRequest) (*GenerateStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateStatement not implemented")
+}
+func (UnimplementedBTCServiceServer) CreateHold(context.Context, *CreateHoldRequest) (*Hold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHold not implemented")
+}
+func (UnimplementedBTCServiceServer) GetHold(context.Context, *GetHoldRequest) (*Hold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHold not implemented")
+}
+func (UnimplementedBTCServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedBTCServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*Hold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedBTCServiceServer) SetTransactionLimits(context.Context, *SetTransactionLimitsRequest) (*TransactionLimits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransactionLimits not implemented")
+}
+func (UnimplementedBTCServiceServer) GetTransactionLimits(context.Context, *GetTransactionLimitsRequest) (*TransactionLimits, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionLimits not implemented")
+}
+func (UnimplementedBTCServiceServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedBTCServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedBTCServiceServer) CancelSchedule(context.Context, *CancelScheduleRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchedule not implemented")
+}
+func (UnimplementedBTCServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedBTCServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedBTCServiceServer) GetUser(context.Context, *GetUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedBTCServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedBTCServiceServer) DeactivateUser(context.Context, *DeactivateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateUser not implemented")
+}
+func (UnimplementedBTCServiceServer) WatchUserBalance(*WatchUserBalanceRequest, BTCService_WatchUserBalanceServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUserBalance not implemented")
+}
+func (UnimplementedBTCServiceServer) ImportTransactions(BTCService_ImportTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportTransactions not implemented")
+}
+func (UnimplementedBTCServiceServer) GetImportCheckpoint(context.Context, *GetImportCheckpointRequest) (*ImportCheckpoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportCheckpoint not implemented")
+}
+func (UnimplementedBTCServiceServer) ExportTransactions(*ExportTransactionsRequest, BTCService_ExportTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTransactions not implemented")
+}
 func (UnimplementedBTCServiceServer) mustEmbedUnimplementedBTCServiceServer() {}
 
 // UnsafeBTCServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -126,6 +637,24 @@ func _BTCService_CreateTransaction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BTCService_CreateTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).CreateTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/CreateTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).CreateTransactions(ctx, req.(*CreateTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BTCService_ListTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTransactionRequest)
 	if err := dec(in); err != nil {
@@ -144,6 +673,42 @@ func _BTCService_ListTransaction_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BTCService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_ReverseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).ReverseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/ReverseTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).ReverseTransaction(ctx, req.(*ReverseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BTCService_GetUserBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserBalanceRequest)
 	if err := dec(in); err != nil {
@@ -162,26 +727,496 @@ func _BTCService_GetUserBalance_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// BTCService_ServiceDesc is the grpc.ServiceDesc for BTCService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var BTCService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "BTCService",
-	HandlerType: (*BTCServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTransaction",
-			Handler:    _BTCService_CreateTransaction_Handler,
-		},
-		{
-			MethodName: "ListTransaction",
-			Handler:    _BTCService_ListTransaction_Handler,
-		},
-		{
-			MethodName: "GetUserBalance",
-			Handler:    _BTCService_GetUserBalance_Handler,
+func _BTCService_GetBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).GetBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/GetBalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).GetBalanceHistory(ctx, req.(*GetBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_GenerateStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).GenerateStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/GenerateStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).GenerateStatement(ctx, req.(*GenerateStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_CreateHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).CreateHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/CreateHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).CreateHold(ctx, req.(*CreateHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_GetHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).GetHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/GetHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).GetHold(ctx, req.(*GetHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/CaptureHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/ReleaseHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_SetTransactionLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).SetTransactionLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/SetTransactionLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).SetTransactionLimits(ctx, req.(*SetTransactionLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_GetTransactionLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).GetTransactionLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/GetTransactionLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).GetTransactionLimits(ctx, req.(*GetTransactionLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/ListSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_CancelSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).CancelSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/CancelSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).CancelSchedule(ctx, req.(*CancelScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).DeactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/DeactivateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).DeactivateUser(ctx, req.(*DeactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_WatchUserBalance_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUserBalanceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BTCServiceServer).WatchUserBalance(m, &bTCServiceWatchUserBalanceServer{stream})
+}
+
+type BTCService_WatchUserBalanceServer interface {
+	Send(*UserBalance) error
+	grpc.ServerStream
+}
+
+type bTCServiceWatchUserBalanceServer struct {
+	grpc.ServerStream
+}
+
+func (x *bTCServiceWatchUserBalanceServer) Send(m *UserBalance) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BTCService_ImportTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BTCServiceServer).ImportTransactions(&bTCServiceImportTransactionsServer{stream})
+}
+
+type BTCService_ImportTransactionsServer interface {
+	SendAndClose(*ImportTransactionsResponse) error
+	Recv() (*ImportTransactionsRequest, error)
+	grpc.ServerStream
+}
+
+type bTCServiceImportTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bTCServiceImportTransactionsServer) SendAndClose(m *ImportTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *bTCServiceImportTransactionsServer) Recv() (*ImportTransactionsRequest, error) {
+	m := new(ImportTransactionsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BTCService_GetImportCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BTCServiceServer).GetImportCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BTCService/GetImportCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BTCServiceServer).GetImportCheckpoint(ctx, req.(*GetImportCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BTCService_ExportTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BTCServiceServer).ExportTransactions(m, &bTCServiceExportTransactionsServer{stream})
+}
+
+type BTCService_ExportTransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type bTCServiceExportTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bTCServiceExportTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// BTCService_ServiceDesc is the grpc.ServiceDesc for BTCService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BTCService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "BTCService",
+	HandlerType: (*BTCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTransaction",
+			Handler:    _BTCService_CreateTransaction_Handler,
+		},
+		{
+			MethodName: "CreateTransactions",
+			Handler:    _BTCService_CreateTransactions_Handler,
+		},
+		{
+			MethodName: "ListTransaction",
+			Handler:    _BTCService_ListTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _BTCService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "ReverseTransaction",
+			Handler:    _BTCService_ReverseTransaction_Handler,
+		},
+		{
+			MethodName: "GetUserBalance",
+			Handler:    _BTCService_GetUserBalance_Handler,
+		},
+		{
+			MethodName: "GetBalanceHistory",
+			Handler:    _BTCService_GetBalanceHistory_Handler,
+		},
+		{
+			MethodName: "GenerateStatement",
+			Handler:    _BTCService_GenerateStatement_Handler,
+		},
+		{
+			MethodName: "CreateHold",
+			Handler:    _BTCService_CreateHold_Handler,
+		},
+		{
+			MethodName: "GetHold",
+			Handler:    _BTCService_GetHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _BTCService_CaptureHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _BTCService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "SetTransactionLimits",
+			Handler:    _BTCService_SetTransactionLimits_Handler,
+		},
+		{
+			MethodName: "GetTransactionLimits",
+			Handler:    _BTCService_GetTransactionLimits_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _BTCService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _BTCService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "CancelSchedule",
+			Handler:    _BTCService_CancelSchedule_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _BTCService_Transfer_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _BTCService_CreateUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _BTCService_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _BTCService_ListUsers_Handler,
+		},
+		{
+			MethodName: "DeactivateUser",
+			Handler:    _BTCService_DeactivateUser_Handler,
+		},
+		{
+			MethodName: "GetImportCheckpoint",
+			Handler:    _BTCService_GetImportCheckpoint_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchUserBalance",
+			Handler:       _BTCService_WatchUserBalance_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportTransactions",
+			Handler:       _BTCService_ImportTransactions_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportTransactions",
+			Handler:       _BTCService_ExportTransactions_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/service.proto",
 }
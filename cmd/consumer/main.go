@@ -18,9 +18,11 @@ import (
 const maxRetries = 3
 
 type Transaction struct {
-	UserID   int64     `json:"user_id"`
-	Amount   float64   `json:"amount"`
-	Datetime time.Time `json:"datetime"`
+	UserID     int64     `json:"user_id"`
+	Amount     float64   `json:"amount"`
+	AmountSats int64     `json:"amount_sats"`
+	Datetime   time.Time `json:"datetime"`
+	Asset      string    `json:"asset"`
 }
 
 const (
@@ -96,7 +98,8 @@ func main() { //nolint: funlen
 				continue
 			}
 
-			err = createTransaction(trx)
+			// The AppId is kept on redelivery, thus it can be used as the idempotency key.
+			err = createTransaction(trx, d.AppId)
 			if err != nil {
 				log.Printf("Failed to create transcation: %v", err)
 
@@ -113,7 +116,7 @@ func main() { //nolint: funlen
 	<-forever
 }
 
-func createTransaction(trx *Transaction) error {
+func createTransaction(trx *Transaction, idempotencyKey string) error {
 	// Dial gRPC server connection.
 	conn, err := grpc.Dial(os.Getenv("GRPC_SERVER"), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -125,9 +128,12 @@ func createTransaction(trx *Transaction) error {
 
 	// Call CreateTransaction RPC.
 	transaction, err := client.CreateTransaction(context.Background(), &rpc.CreateTransactionRequest{
-		UserId:   trx.UserID,
-		Datetime: timestamppb.New(trx.Datetime),
-		Amount:   trx.Amount,
+		UserId:         trx.UserID,
+		Datetime:       timestamppb.New(trx.Datetime),
+		Amount:         trx.Amount,
+		AmountSats:     trx.AmountSats,
+		IdempotencyKey: idempotencyKey,
+		Asset:          trx.Asset,
 	})
 	if err != nil {
 		return err
@@ -17,6 +17,8 @@ func main() {
 
 	server := iDI.GetBTCGRPCServer()
 	gateway := iDI.GetBTCGatewayServer()
+	scheduler := iDI.GetBTCScheduler()
+	outboxRelay := iDI.GetBTCOutboxRelay()
 
 	logger.Info("BTC service is ready")
 
@@ -34,6 +36,20 @@ func main() {
 		}
 	}()
 
+	go func() {
+		// Run() keeps its process until the graceful stop
+		if err := scheduler.Run(); err != nil {
+			logger.Fatal("failed to run Scheduler", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		// Run() keeps its process until the graceful stop
+		if err := outboxRelay.Run(); err != nil {
+			logger.Fatal("failed to run Outbox Relay", zap.Error(err))
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
 
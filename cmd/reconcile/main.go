@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+)
+
+// Mismatch is a row of the reconciliation report.
+type Mismatch struct {
+	UserID     int64  `json:"user_id"`
+	Asset      string `json:"asset"`
+	Balance    string `json:"balance"`
+	Expected   string `json:"expected"`
+	Difference string `json:"difference"`
+	Repaired   bool   `json:"repaired"`
+	Error      string `json:"error,omitempty"`
+}
+
+func main() {
+	var (
+		format = flag.String("format", "json", "The format of the report, json or csv.")
+		output = flag.String("output", "", "The file of the report, defaults to stdout.")
+		repair = flag.Bool("repair", false, "Rewrite the mismatched balances into the sum of the transactions.")
+	)
+
+	flag.Parse()
+
+	if *format != "json" && *format != "csv" {
+		log.Fatalf("Unknown format: %s", *format)
+	}
+
+	report, err := reconcile(context.Background(), di.GetReconcileRepo(), *repair)
+	if err != nil {
+		log.Fatalf("Failed to reconcile the balances: %v", err)
+	}
+
+	err = writeReport(report, *format, *output)
+	if err != nil {
+		log.Fatalf("Failed to write the report: %v", err)
+	}
+
+	var unrepaired int
+
+	for _, m := range report {
+		if !m.Repaired {
+			unrepaired++
+		}
+	}
+
+	log.Printf("Found %d balance mismatches, %d are left unrepaired", len(report), unrepaired)
+
+	// The unrepaired mismatches fail the job, thus a scheduler can alert on it.
+	if unrepaired > 0 {
+		os.Exit(1)
+	}
+}
+
+// reconcile finds the balance mismatches and repairs them one by one if it's requested.
+// A failed repair is reported in the row instead of aborting the others.
+func reconcile(ctx context.Context, repo repository.ReconcileRepo, repair bool) ([]*Mismatch, error) {
+	mismatches, err := repo.FindBalanceMismatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]*Mismatch, 0, len(mismatches))
+
+	for _, m := range mismatches {
+		row := &Mismatch{
+			UserID:     m.UserID,
+			Asset:      m.Asset,
+			Balance:    m.Balance,
+			Expected:   m.Expected,
+			Difference: m.Difference,
+		}
+
+		if repair {
+			// The balance is checked again under lock, it may already agree because of a concurrent transaction.
+			repaired, err := repo.RepairBalance(ctx, m.UserID, m.Asset)
+			if err != nil {
+				row.Error = err.Error()
+			} else {
+				row.Repaired = true
+
+				if repaired != nil {
+					row.Balance, row.Expected, row.Difference = repaired.Balance, repaired.Expected, repaired.Difference
+				}
+			}
+		}
+
+		report = append(report, row)
+	}
+
+	return report, nil
+}
+
+// writeReport writes the report in the format into the output file, or stdout if the output is empty.
+// The error of closing the output file is returned, since the report may not be written until it's closed.
+func writeReport(report []*Mismatch, format, output string) (err error) {
+	w := io.Writer(os.Stdout)
+
+	if output != "" {
+		f, errCreate := os.Create(output)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		defer func() {
+			if errClose := f.Close(); err == nil {
+				err = errClose
+			}
+		}()
+
+		w = f
+	}
+
+	if format == "csv" {
+		return writeCSV(w, report)
+	}
+
+	return writeJSON(w, report)
+}
+
+// writeJSON writes the report as a JSON array.
+func writeJSON(w io.Writer, report []*Mismatch) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(report)
+}
+
+// writeCSV writes the report as CSV with a header row.
+func writeCSV(w io.Writer, report []*Mismatch) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write([]string{"user_id", "asset", "balance", "expected", "difference", "repaired", "error"})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range report {
+		err = cw.Write([]string{
+			strconv.FormatInt(m.UserID, 10), m.Asset, m.Balance, m.Expected, m.Difference, strconv.FormatBool(m.Repaired), m.Error,
+		})
+		if err != nil {
+			return fmt.Errorf("user id: %d: %w", m.UserID, err)
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
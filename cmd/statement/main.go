@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/pkg/statement"
+)
+
+// monthLayout is the layout of the month flag.
+const monthLayout = "2006-01"
+
+// formats is the format of the document by the format flag.
+var formats = map[string]rpc.StatementFormat{
+	"text": rpc.StatementFormat_STATEMENT_FORMAT_TEXT,
+	"html": rpc.StatementFormat_STATEMENT_FORMAT_HTML,
+}
+
+func main() {
+	now := time.Now().UTC()
+
+	// The previous month is counted from the first day, since the 31st minus a month may still be in the same month.
+	previous := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC)
+
+	var (
+		month  = flag.String("month", previous.Format(monthLayout), "The month of the statements, defaults to the previous month.")
+		userID = flag.Int64("user_id", 0, "The ID of User, defaults to all Users.")
+		code   = flag.String("asset", "", "The code of the asset, defaults to all assets.")
+		format = flag.String("format", "text", "The format of the documents, text or html.")
+		output = flag.String("output", ".", "The directory of the documents.")
+	)
+
+	flag.Parse()
+
+	f, ok := formats[*format]
+	if !ok {
+		log.Fatalf("Unknown format: %s", *format)
+	}
+
+	start, err := time.Parse(monthLayout, *month)
+	if err != nil {
+		log.Fatalf("Invalid month: %s: %v", *month, err)
+	}
+
+	// The period is the whole month in UTC, the end is the exclusive start of the next month.
+	end := start.AddDate(0, 1, 0)
+
+	err = os.MkdirAll(*output, 0o755)
+	if err != nil {
+		log.Fatalf("Failed to create the output directory: %v", err)
+	}
+
+	ctx := context.Background()
+	repo := di.GetBTCRepo()
+
+	accounts, err := repo.ListStatementAccounts(ctx, end)
+	if err != nil {
+		log.Fatalf("Failed to list the accounts: %v", err)
+	}
+
+	var generated, failed int
+
+	for _, account := range accounts {
+		if (*userID != 0 && account.UserID != *userID) || (*code != "" && account.Asset != *code) {
+			continue
+		}
+
+		// A failed statement is logged instead of aborting the others.
+		path, err := generate(ctx, repo, account, start, end, f, *output)
+		if err != nil {
+			log.Printf("Failed to generate the statement of user id: %d asset: %s: %v", account.UserID, account.Asset, err)
+
+			failed++
+
+			continue
+		}
+
+		log.Printf("Generated %s", path)
+
+		generated++
+	}
+
+	log.Printf("Generated %d statements of %s, %d failed", generated, *month, failed)
+
+	// The failed statements fail the job, thus a scheduler can alert on it.
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// generate writes the statement of the account for the period into a file in the output directory.
+// Returns the path of the file.
+func generate(
+	ctx context.Context, repo repository.BTCRepo, account *repository.StatementAccount,
+	start, end time.Time, format rpc.StatementFormat, output string,
+) (string, error) {
+	s, err := repo.GetStatement(ctx, &repository.GetStatementParams{
+		UserID:        account.UserID,
+		StartDatetime: start,
+		EndDatetime:   end,
+		Asset:         account.Asset,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(output, fmt.Sprintf("statement-%d-%s-%s.%s",
+		account.UserID, account.Asset, start.Format(monthLayout), statement.Extension(format),
+	))
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+
+	err = statement.Render(f, s, format)
+	if err != nil {
+		_ = f.Close()
+		return "", err
+	}
+
+	return path, f.Close()
+}
@@ -1,14 +1,24 @@
 package grpchandler
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+	"unicode/utf8"
 
 	rpc "github.com/moemoe89/btc/api/go/grpc"
 	"github.com/moemoe89/btc/internal/entities/repository"
 	"github.com/moemoe89/btc/internal/usecases"
+	"github.com/moemoe89/btc/pkg/asset"
 	"github.com/moemoe89/btc/pkg/grpchealth"
+	"github.com/moemoe89/btc/pkg/statement"
 
 	health "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
 // BTCServiceServer is BTC service server contract.
@@ -34,24 +44,796 @@ type btcHandler struct {
 // CreateTransaction creates a new record for BTC transaction.
 // Only single transaction will create by this RPC for a specific User.
 func (h *btcHandler) CreateTransaction(ctx context.Context, req *rpc.CreateTransactionRequest) (*rpc.Transaction, error) {
-	return h.uc.CreateTransaction(ctx, &repository.CreateTransactionParams{
-		UserID:   req.GetUserId(),
-		Datetime: req.GetDatetime().AsTime(),
-		Amount:   req.GetAmount(),
+	params, err := requestTransaction(req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	transaction, err := h.uc.CreateTransaction(ctx, params)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return transaction, nil
+}
+
+// maxBatchSize is the maximum number of entries of CreateTransactions.
+const maxBatchSize = 1000
+
+// CreateTransactions creates many records for BTC transaction in a single database transaction.
+// The result of each entry is reported in the same order as the request.
+func (h *btcHandler) CreateTransactions(
+	ctx context.Context, req *rpc.CreateTransactionsRequest,
+) (*rpc.CreateTransactionsResponse, error) {
+	if len(req.GetTransactions()) == 0 || len(req.GetTransactions()) > maxBatchSize {
+		return nil, toStatusError(fmt.Errorf("%w: transactions should have 1 to %d entries", repository.ErrInvalidBatch, maxBatchSize))
+	}
+
+	var (
+		results = make([]*rpc.CreateTransactionsResult, len(req.GetTransactions()))
+		params  = &repository.CreateTransactionsParams{BestEffort: req.GetBestEffort()}
+		// indexes maps the entry of the params back to the entry of the request,
+		// since the invalid entries are left out in best-effort mode.
+		indexes []int
+	)
+
+	for i, entry := range req.GetTransactions() {
+		p, err := requestTransaction(entry)
+		if err != nil {
+			err = fmt.Errorf("transactions[%d]: %w", i, err)
+
+			if !params.BestEffort {
+				return nil, toStatusError(err)
+			}
+
+			results[i] = toBatchResult(nil, err)
+
+			continue
+		}
+
+		params.Transactions = append(params.Transactions, p)
+
+		indexes = append(indexes, i)
+	}
+
+	if len(params.Transactions) > 0 {
+		created, err := h.uc.CreateTransactions(ctx, params)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+
+		for j, result := range created {
+			err = result.Err
+			if err != nil {
+				err = fmt.Errorf("transactions[%d]: %w", indexes[j], err)
+			}
+
+			results[indexes[j]] = toBatchResult(result.Transaction, err)
+		}
+	}
+
+	return &rpc.CreateTransactionsResponse{
+		Results: results,
+	}, nil
+}
+
+// Transfer moves an asset from a User to another User.
+// The debit and credit transactions are created in a single database transaction.
+func (h *btcHandler) Transfer(ctx context.Context, req *rpc.TransferRequest) (*rpc.TransferResponse, error) {
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	if req.GetAmountSats() <= 0 {
+		return nil, toStatusError(fmt.Errorf("%w: amount_sats should greater than 0", repository.ErrInvalidAmount))
+	}
+
+	if req.GetFromUserId() == req.GetToUserId() {
+		return nil, toStatusError(fmt.Errorf("%w: from_user_id and to_user_id should be different", repository.ErrInvalidTransfer))
+	}
+
+	transfer, err := h.uc.Transfer(ctx, &repository.TransferParams{
+		FromUserID:     req.GetFromUserId(),
+		ToUserID:       req.GetToUserId(),
+		Datetime:       req.GetDatetime().AsTime(),
+		AmountSats:     req.GetAmountSats(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Asset:          a.Code,
 	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return transfer, nil
 }
 
 // ListTransaction get the list of records for BTC transaction.
 // The record can be filtered by specific User.
 func (h *btcHandler) ListTransaction(ctx context.Context, req *rpc.ListTransactionRequest) (*rpc.ListTransactionResponse, error) {
-	return h.uc.ListTransaction(ctx, &repository.ListTransactionParams{
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	if err = validateQuoteCurrency(req.GetQuoteCurrency()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	list, err := h.uc.ListTransaction(ctx, &repository.ListTransactionParams{
 		UserID:        req.GetUserId(),
 		StartDatetime: req.GetStartDatetime().AsTime(),
 		EndDatetime:   req.GetEndDatetime().AsTime(),
+		PageSize:      req.GetPageSize(),
+		PageToken:     req.GetPageToken(),
+		Bucket:        req.GetBucket(),
+		Asset:         a.Code,
+		QuoteCurrency: req.GetQuoteCurrency(),
+		Type:          req.GetType(),
+		ExternalRef:   req.GetExternalRef(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return list, nil
+}
+
+// ExportTransactions streams the un-aggregated records of BTC transaction, ordered by the datetime and the ID.
+// The records can be filtered by specific User and asset, all Users and assets are exported by default.
+func (h *btcHandler) ExportTransactions(
+	req *rpc.ExportTransactionsRequest, stream rpc.BTCService_ExportTransactionsServer,
+) error {
+	var assetCode string
+
+	// The empty asset exports all assets, instead of the default asset.
+	if req.GetAsset() != "" {
+		a, err := requestAsset(req.GetAsset())
+		if err != nil {
+			return toStatusError(err)
+		}
+
+		assetCode = a.Code
+	}
+
+	if utf8.RuneCountInString(req.GetExternalRef()) > maxExternalRefLength {
+		return toStatusError(
+			fmt.Errorf("%w: external_ref should have at most %d characters", repository.ErrInvalidMetadata, maxExternalRefLength),
+		)
+	}
+
+	err := h.uc.ExportTransactions(stream.Context(), &repository.ExportTransactionsParams{
+		StartDatetime: req.GetStartDatetime().AsTime(),
+		EndDatetime:   req.GetEndDatetime().AsTime(),
+		UserID:        req.GetUserId(),
+		Asset:         assetCode,
+		Type:          req.GetType(),
+		ExternalRef:   req.GetExternalRef(),
+	}, stream.Send)
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	return nil
+}
+
+const (
+	// importChunkSize is the number of rows of ImportTransactions written within a single database transaction.
+	importChunkSize = 1000
+	// maxImportIDLength is the maximum length of the ID of an import.
+	maxImportIDLength = 64
+	// maxImportRejections is the maximum number of rejected rows reported by ImportTransactions.
+	maxImportRejections = 1000
+)
+
+// ImportTransactions creates the records for BTC transaction of the rows received from the stream.
+// The rows are written in chunks, and the checkpoint of the import is committed with each chunk,
+// thus a broken import is resumed by sending the rows again with the same import ID.
+func (h *btcHandler) ImportTransactions(stream rpc.BTCService_ImportTransactionsServer) error {
+	var (
+		resp     = new(rpc.ImportTransactionsResponse)
+		params   = new(repository.ImportTransactionsParams)
+		importID string
+		sequence int64
+	)
+
+	flush := func() error {
+		if len(params.Rows) == 0 {
+			return nil
+		}
+
+		result, err := h.uc.ImportTransactions(stream.Context(), params)
+		if err != nil {
+			return err
+		}
+
+		resp.Checkpoint = result.Checkpoint
+		resp.Imported += result.Imported
+		resp.Skipped += result.Skipped
+		resp.Rejected += int64(len(result.Rejections))
+
+		for _, rejection := range result.Rejections {
+			if len(resp.Rejections) < maxImportRejections {
+				resp.Rejections = append(resp.Rejections, toImportRejection(rejection))
+			}
+		}
+
+		params = &repository.ImportTransactionsParams{ImportID: importID}
+
+		return nil
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// The import ID and the sequence identify the row in the checkpoint, thus the invalid ones abort the import.
+		if err = validateImportRow(req, importID, sequence); err != nil {
+			return toStatusError(err)
+		}
+
+		importID, sequence = req.GetImportId(), req.GetSequence()
+		params.ImportID = importID
+
+		row := &repository.ImportRow{Sequence: sequence}
+		row.Transaction, row.Err = requestImportTransaction(req.GetTransaction())
+
+		params.Rows = append(params.Rows, row)
+
+		if len(params.Rows) == importChunkSize {
+			if err = flush(); err != nil {
+				return toStatusError(err)
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return toStatusError(err)
+	}
+
+	if importID == "" {
+		return toStatusError(fmt.Errorf("%w: the stream should have at least 1 row", repository.ErrInvalidImport))
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// GetImportCheckpoint get the checkpoint of an import by the ID.
+// The sequence of the checkpoint is the last row committed, thus a broken import is resumed from the next row.
+func (h *btcHandler) GetImportCheckpoint(
+	ctx context.Context, req *rpc.GetImportCheckpointRequest,
+) (*rpc.ImportCheckpoint, error) {
+	if err := validateImportID(req.GetImportId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	checkpoint, err := h.uc.GetImportCheckpoint(ctx, req.GetImportId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return checkpoint, nil
+}
+
+// GetTransaction get a single record of BTC transaction by the ID.
+func (h *btcHandler) GetTransaction(ctx context.Context, req *rpc.GetTransactionRequest) (*rpc.Transaction, error) {
+	transaction, err := h.uc.GetTransaction(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return transaction, nil
+}
+
+// maxReasonLength is the maximum length of the reason of ReverseTransaction.
+const maxReasonLength = 256
+
+// ReverseTransaction creates a compensating transaction with the opposite amount of a transaction.
+// The reversal references the original transaction, and a transaction can only be reversed once.
+func (h *btcHandler) ReverseTransaction(ctx context.Context, req *rpc.ReverseTransactionRequest) (*rpc.Transaction, error) {
+	if req.GetReason() == "" || utf8.RuneCountInString(req.GetReason()) > maxReasonLength {
+		return nil, toStatusError(fmt.Errorf("%w: reason should have 1 to %d characters", repository.ErrInvalidReason, maxReasonLength))
+	}
+
+	reversal, err := h.uc.ReverseTransaction(ctx, &repository.ReverseTransactionParams{
+		ID:     req.GetId(),
+		Reason: req.GetReason(),
 	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return reversal, nil
+}
+
+const (
+	// defaultHoldDuration is the duration of a hold without the deadline.
+	defaultHoldDuration = 24 * time.Hour
+	// maxHoldDuration is the maximum duration of a hold.
+	maxHoldDuration = 30 * 24 * time.Hour
+)
+
+// CreateHold reserves an amount of a User until it's captured, released or expired.
+// The pending hold reduces the available balance of the User, but not the balance.
+func (h *btcHandler) CreateHold(ctx context.Context, req *rpc.CreateHoldRequest) (*rpc.Hold, error) {
+	params, err := requestHold(req, time.Now())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	hold, err := h.uc.CreateHold(ctx, params)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return hold, nil
+}
+
+// GetHold get a single Hold by the ID.
+func (h *btcHandler) GetHold(ctx context.Context, req *rpc.GetHoldRequest) (*rpc.Hold, error) {
+	hold, err := h.uc.GetHold(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return hold, nil
+}
+
+// CaptureHold settles a pending hold by a withdrawal transaction of the captured amount.
+// The captured amount can be less than the reserved amount, the rest is released.
+func (h *btcHandler) CaptureHold(ctx context.Context, req *rpc.CaptureHoldRequest) (*rpc.CaptureHoldResponse, error) {
+	if req.GetAmountSats() < 0 {
+		return nil, toStatusError(fmt.Errorf("%w: amount_sats should not be negative", repository.ErrInvalidAmount))
+	}
+
+	captured, err := h.uc.CaptureHold(ctx, &repository.CaptureHoldParams{
+		ID:         req.GetId(),
+		AmountSats: req.GetAmountSats(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return captured, nil
+}
+
+// ReleaseHold cancels a pending hold without a debit.
+func (h *btcHandler) ReleaseHold(ctx context.Context, req *rpc.ReleaseHoldRequest) (*rpc.Hold, error) {
+	hold, err := h.uc.ReleaseHold(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return hold, nil
+}
+
+// maxCountWindow is the maximum window of the max count of the limits.
+const maxCountWindow = 30 * 24 * time.Hour
+
+// SetTransactionLimits sets the limits of the debits of a User for an asset, the User ID of 0 sets the default limits.
+// The limit of 0 isn't set, thus it falls back to the default limit.
+func (h *btcHandler) SetTransactionLimits(
+	ctx context.Context, req *rpc.SetTransactionLimitsRequest,
+) (*rpc.TransactionLimits, error) {
+	params, err := requestLimits(req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	limits, err := h.uc.SetTransactionLimits(ctx, params)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return limits, nil
+}
+
+// GetTransactionLimits get the effective limits of the debits of a User for an asset,
+// the limits that aren't set for the User are the default limits.
+func (h *btcHandler) GetTransactionLimits(
+	ctx context.Context, req *rpc.GetTransactionLimitsRequest,
+) (*rpc.TransactionLimits, error) {
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	limits, err := h.uc.GetTransactionLimits(ctx, req.GetUserId(), a.Code)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return limits, nil
 }
 
 // GetUserBalance get the latest balance for a specific User.
+// The balance at a specific point in time can be get by the as_of.
 func (h *btcHandler) GetUserBalance(ctx context.Context, req *rpc.GetUserBalanceRequest) (*rpc.UserBalance, error) {
-	return h.uc.GetUserBalance(ctx, req.GetUserId())
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	if err = validateQuoteCurrency(req.GetQuoteCurrency()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	params := &repository.GetUserBalanceParams{
+		UserID:        req.GetUserId(),
+		Asset:         a.Code,
+		QuoteCurrency: req.GetQuoteCurrency(),
+	}
+
+	if req.GetAsOf() != nil {
+		params.AsOf = req.GetAsOf().AsTime()
+	}
+
+	balance, err := h.uc.GetUserBalance(ctx, params)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return balance, nil
+}
+
+// GetBalanceHistory get the closing balance at the end of each bucket for a specific User.
+// The buckets without transactions are omitted, since the balance is unchanged.
+func (h *btcHandler) GetBalanceHistory(
+	ctx context.Context, req *rpc.GetBalanceHistoryRequest,
+) (*rpc.GetBalanceHistoryResponse, error) {
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	history, err := h.uc.GetBalanceHistory(ctx, &repository.GetBalanceHistoryParams{
+		UserID:        req.GetUserId(),
+		StartDatetime: req.GetStartDatetime().AsTime(),
+		EndDatetime:   req.GetEndDatetime().AsTime(),
+		Bucket:        req.GetBucket(),
+		Asset:         a.Code,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return history, nil
+}
+
+// GenerateStatement generates the account statement of a User for an asset and a period,
+// and renders the document of the statement in the requested format.
+func (h *btcHandler) GenerateStatement(
+	ctx context.Context, req *rpc.GenerateStatementRequest,
+) (*rpc.GenerateStatementResponse, error) {
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	if !req.GetEndDatetime().AsTime().After(req.GetStartDatetime().AsTime()) {
+		return nil, toStatusError(fmt.Errorf("%w: end_datetime should be after start_datetime", repository.ErrInvalidStatement))
+	}
+
+	if _, ok := rpc.StatementFormat_name[int32(req.GetFormat())]; !ok {
+		return nil, toStatusError(fmt.Errorf("%w: format: %s is unknown", repository.ErrInvalidStatement, req.GetFormat()))
+	}
+
+	s, err := h.uc.GetStatement(ctx, &repository.GetStatementParams{
+		UserID:        req.GetUserId(),
+		StartDatetime: req.GetStartDatetime().AsTime(),
+		EndDatetime:   req.GetEndDatetime().AsTime(),
+		Asset:         a.Code,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	var document bytes.Buffer
+
+	if err = statement.Render(&document, s, req.GetFormat()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &rpc.GenerateStatementResponse{
+		Statement:   s,
+		ContentType: statement.ContentType(req.GetFormat()),
+		Document:    document.Bytes(),
+	}, nil
+}
+
+// WatchUserBalance streams the balance of an asset for a specific User.
+// The latest balance is sent first, then a new balance whenever a transaction of the asset for the User is committed.
+func (h *btcHandler) WatchUserBalance(req *rpc.WatchUserBalanceRequest, stream rpc.BTCService_WatchUserBalanceServer) error {
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	err = h.uc.WatchUserBalance(stream.Context(), req.GetUserId(), a.Code, stream.Send)
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	return nil
+}
+
+// toBatchResult converts the result of an entry of CreateTransactions into proto message.
+// The error is reported with the same gRPC status code as a single CreateTransaction.
+func toBatchResult(transaction *rpc.Transaction, err error) *rpc.CreateTransactionsResult {
+	if err != nil {
+		st := status.Convert(toStatusError(err))
+
+		return &rpc.CreateTransactionsResult{
+			Code:    int32(st.Code()),
+			Message: st.Message(),
+		}
+	}
+
+	return &rpc.CreateTransactionsResult{
+		Transaction: transaction,
+	}
+}
+
+// toImportRejection converts the rejected row of an import into the status of the error.
+func toImportRejection(rejection *repository.ImportRejection) *rpc.ImportRejection {
+	st := status.Convert(toStatusError(rejection.Err))
+
+	return &rpc.ImportRejection{
+		Sequence: rejection.Sequence,
+		Code:     int32(st.Code()),
+		Message:  st.Message(),
+	}
+}
+
+// validateImportID validates the ID of an import.
+func validateImportID(importID string) error {
+	if importID == "" || utf8.RuneCountInString(importID) > maxImportIDLength {
+		return fmt.Errorf("%w: import_id should have 1 to %d characters", repository.ErrInvalidImport, maxImportIDLength)
+	}
+
+	return nil
+}
+
+// validateImportRow validates the import ID and the sequence of a row against the previous row of the stream.
+// All the rows of the stream belong to the same import, and the sequence is strictly increasing.
+func validateImportRow(req *rpc.ImportTransactionsRequest, importID string, sequence int64) error {
+	if err := validateImportID(req.GetImportId()); err != nil {
+		return err
+	}
+
+	if importID != "" && req.GetImportId() != importID {
+		return fmt.Errorf("%w: import_id: %s should be %s for all the rows", repository.ErrInvalidImport, req.GetImportId(), importID)
+	}
+
+	if req.GetSequence() <= sequence {
+		return fmt.Errorf("%w: sequence: %d should be greater than %d", repository.ErrInvalidImport, req.GetSequence(), sequence)
+	}
+
+	return nil
+}
+
+// requestImportTransaction returns the params of the transaction of an import row.
+// The row is deduplicated by the sequence, thus the idempotency key isn't supported.
+func requestImportTransaction(req *rpc.CreateTransactionRequest) (*repository.CreateTransactionParams, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: transaction is required", repository.ErrInvalidImport)
+	}
+
+	if req.GetIdempotencyKey() != "" {
+		return nil, fmt.Errorf("%w: idempotency_key isn't supported by the import", repository.ErrInvalidImport)
+	}
+
+	return requestTransaction(req)
+}
+
+// requestAsset returns the registered asset of the request, the empty code is the default asset.
+func requestAsset(code string) (asset.Asset, error) {
+	a, err := asset.Lookup(code)
+	if err != nil {
+		return asset.Asset{}, fmt.Errorf("%w: %s", repository.ErrInvalidAsset, err)
+	}
+
+	return a, nil
+}
+
+// requestHold returns the params of the hold with the asset and the deadline,
+// the missing deadline is the default duration after the now.
+func requestHold(req *rpc.CreateHoldRequest, now time.Time) (*repository.CreateHoldParams, error) {
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAmountSats() <= 0 {
+		return nil, fmt.Errorf("%w: amount_sats should be greater than 0", repository.ErrInvalidAmount)
+	}
+
+	expiresAt := now.Add(defaultHoldDuration)
+
+	if req.GetExpiresAt() != nil {
+		expiresAt = req.GetExpiresAt().AsTime()
+
+		if !expiresAt.After(now) || expiresAt.Sub(now) > maxHoldDuration {
+			return nil, fmt.Errorf("%w: expires_at should be in the future and at most 30 days ahead", repository.ErrInvalidHold)
+		}
+	}
+
+	if utf8.RuneCountInString(req.GetDescription()) > maxDescriptionLength {
+		return nil, fmt.Errorf("%w: description should have at most %d characters", repository.ErrInvalidMetadata, maxDescriptionLength)
+	}
+
+	if utf8.RuneCountInString(req.GetExternalRef()) > maxExternalRefLength {
+		return nil, fmt.Errorf("%w: external_ref should have at most %d characters", repository.ErrInvalidMetadata, maxExternalRefLength)
+	}
+
+	return &repository.CreateHoldParams{
+		UserID:      req.GetUserId(),
+		AmountSats:  req.GetAmountSats(),
+		ExpiresAt:   expiresAt,
+		Asset:       a.Code,
+		Description: req.GetDescription(),
+		ExternalRef: req.GetExternalRef(),
+	}, nil
+}
+
+// requestLimits returns the params of the limits with the asset and the count window.
+func requestLimits(req *rpc.SetTransactionLimitsRequest) (*repository.SetTransactionLimitsParams, error) {
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() < 0 {
+		return nil, fmt.Errorf("%w: user_id should not be negative", repository.ErrInvalidLimits)
+	}
+
+	if req.GetMaxAmountSats() < 0 || req.GetMaxDailyOutflowSats() < 0 || req.GetMaxCount() < 0 {
+		return nil, fmt.Errorf("%w: limits should not be negative", repository.ErrInvalidLimits)
+	}
+
+	countWindow := time.Duration(req.GetCountWindowSeconds()) * time.Second
+
+	if countWindow < 0 || countWindow > maxCountWindow {
+		return nil, fmt.Errorf("%w: count_window_seconds should be between 0 and 30 days", repository.ErrInvalidLimits)
+	}
+
+	return &repository.SetTransactionLimitsParams{
+		UserID:              req.GetUserId(),
+		Asset:               a.Code,
+		MaxAmountSats:       req.GetMaxAmountSats(),
+		MaxDailyOutflowSats: req.GetMaxDailyOutflowSats(),
+		MaxCount:            req.GetMaxCount(),
+		CountWindow:         countWindow,
+	}, nil
+}
+
+// quoteCurrencyPattern is the pattern of the ISO 4217 currency code.
+var quoteCurrencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// validateQuoteCurrency validates the optional quote currency of the request.
+func validateQuoteCurrency(code string) error {
+	if code != "" && !quoteCurrencyPattern.MatchString(code) {
+		return fmt.Errorf("%w: quote_currency should be an ISO 4217 code, e.g. USD", repository.ErrInvalidQuoteCurrency)
+	}
+
+	return nil
+}
+
+// requestTransaction returns the params of the transaction with the asset and the exact amount.
+func requestTransaction(req *rpc.CreateTransactionRequest) (*repository.CreateTransactionParams, error) {
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, err
+	}
+
+	amountSats, err := requestAmountSats(req, a)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateMetadata(req, amountSats); err != nil {
+		return nil, err
+	}
+
+	return &repository.CreateTransactionParams{
+		UserID:         req.GetUserId(),
+		Datetime:       req.GetDatetime().AsTime(),
+		AmountSats:     amountSats,
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Asset:          a.Code,
+		Type:           req.GetType(),
+		Description:    req.GetDescription(),
+		ExternalRef:    req.GetExternalRef(),
+		Labels:         req.GetLabels(),
+	}, nil
+}
+
+const (
+	// maxDescriptionLength is the maximum length of the description of a transaction.
+	maxDescriptionLength = 1024
+	// maxExternalRefLength is the maximum length of the external reference of a transaction.
+	maxExternalRefLength = 256
+	// maxLabels is the maximum number of labels of a transaction.
+	maxLabels = 16
+	// maxLabelKeyLength is the maximum length of the key of a label.
+	maxLabelKeyLength = 63
+	// maxLabelValueLength is the maximum length of the value of a label.
+	maxLabelValueLength = 256
+)
+
+// validateMetadata validates the type, description, external reference and labels of the transaction.
+// The sign of the amount should match the type, while the adjustment can be either a credit or a debit.
+func validateMetadata(req *rpc.CreateTransactionRequest, amountSats int64) error {
+	switch req.GetType() {
+	case rpc.TransactionType_TRANSACTION_TYPE_UNSPECIFIED, rpc.TransactionType_TRANSACTION_TYPE_ADJUSTMENT:
+	case rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT:
+		if amountSats < 0 {
+			return fmt.Errorf("%w: the amount of a deposit should be positive", repository.ErrInvalidMetadata)
+		}
+	case rpc.TransactionType_TRANSACTION_TYPE_WITHDRAWAL, rpc.TransactionType_TRANSACTION_TYPE_FEE:
+		if amountSats > 0 {
+			return fmt.Errorf("%w: the amount of a %s should be negative", repository.ErrInvalidMetadata, req.GetType())
+		}
+	default:
+		return fmt.Errorf("%w: type: %s is unknown", repository.ErrInvalidMetadata, req.GetType())
+	}
+
+	if utf8.RuneCountInString(req.GetDescription()) > maxDescriptionLength {
+		return fmt.Errorf("%w: description should have at most %d characters", repository.ErrInvalidMetadata, maxDescriptionLength)
+	}
+
+	if utf8.RuneCountInString(req.GetExternalRef()) > maxExternalRefLength {
+		return fmt.Errorf("%w: external_ref should have at most %d characters", repository.ErrInvalidMetadata, maxExternalRefLength)
+	}
+
+	if len(req.GetLabels()) > maxLabels {
+		return fmt.Errorf("%w: labels should have at most %d entries", repository.ErrInvalidMetadata, maxLabels)
+	}
+
+	for key, value := range req.GetLabels() {
+		if key == "" || utf8.RuneCountInString(key) > maxLabelKeyLength {
+			return fmt.Errorf("%w: label key should have 1 to %d characters", repository.ErrInvalidMetadata, maxLabelKeyLength)
+		}
+
+		if utf8.RuneCountInString(value) > maxLabelValueLength {
+			return fmt.Errorf("%w: label value should have at most %d characters", repository.ErrInvalidMetadata, maxLabelValueLength)
+		}
+	}
+
+	return nil
+}
+
+// requestAmountSats returns the exact amount of the transaction in the minor unit of the asset.
+// The amount_sats takes precedence, while the deprecated double amount is only used as a fallback.
+func requestAmountSats(req *rpc.CreateTransactionRequest, a asset.Asset) (int64, error) {
+	var amountSats int64
+
+	if req.GetAmount() != 0 {
+		sats, err := a.FromUnits(req.GetAmount())
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", repository.ErrInvalidAmount, err)
+		}
+
+		amountSats = sats
+	}
+
+	if req.GetAmountSats() != 0 {
+		if amountSats != 0 && amountSats != req.GetAmountSats() {
+			return 0, fmt.Errorf("%w: amount and amount_sats are different", repository.ErrInvalidAmount)
+		}
+
+		amountSats = req.GetAmountSats()
+	}
+
+	if amountSats == 0 {
+		return 0, fmt.Errorf("%w: amount should not be 0", repository.ErrInvalidAmount)
+	}
+
+	return amountSats, nil
 }
@@ -3,7 +3,10 @@ package grpchandler_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"testing"
+	"time"
 
 	rpc "github.com/moemoe89/btc/api/go/grpc"
 	"github.com/moemoe89/btc/internal/adapters/grpchandler"
@@ -12,6 +15,11 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -55,15 +63,17 @@ func TestBTCServer_CreateTransaction(t *testing.T) {
 			}
 
 			want := &rpc.Transaction{
-				UserId:   args.req.UserId,
-				Datetime: args.req.Datetime,
-				Amount:   args.req.Amount,
+				UserId:     args.req.UserId,
+				Datetime:   args.req.Datetime,
+				Amount:     args.req.Amount,
+				AmountSats: 10_000_000_000,
 			}
 
 			params := &repository.CreateTransactionParams{
-				UserID:   args.req.UserId,
-				Datetime: args.req.Datetime.AsTime(),
-				Amount:   args.req.Amount,
+				UserID:     args.req.UserId,
+				Datetime:   args.req.Datetime.AsTime(),
+				AmountSats: 10_000_000_000,
+				Asset:      "BTC",
 			}
 
 			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
@@ -94,9 +104,10 @@ func TestBTCServer_CreateTransaction(t *testing.T) {
 			}
 
 			params := &repository.CreateTransactionParams{
-				UserID:   args.req.UserId,
-				Datetime: args.req.Datetime.AsTime(),
-				Amount:   args.req.Amount,
+				UserID:     args.req.UserId,
+				Datetime:   args.req.Datetime.AsTime(),
+				AmountSats: 10_000_000_000,
+				Asset:      "BTC",
 			}
 
 			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
@@ -110,6 +121,2110 @@ func TestBTCServer_CreateTransaction(t *testing.T) {
 				wantErr: errors.New("error"),
 			}
 		},
+		"Given valid request of Create Transaction with used Idempotency key, When UC returns conflict error, Return AlreadyExists error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					Amount:         100,
+					IdempotencyKey: "key-1",
+				},
+			}
+
+			params := &repository.CreateTransactionParams{
+				UserID:         args.req.UserId,
+				Datetime:       args.req.Datetime.AsTime(),
+				AmountSats:     10_000_000_000,
+				IdempotencyKey: args.req.IdempotencyKey,
+				Asset:          "BTC",
+			}
+
+			errConflict := fmt.Errorf("idempotency key: %s: %w", args.req.IdempotencyKey, repository.ErrIdempotencyKeyConflict)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateTransaction(args.ctx, params).Return(nil, errConflict)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.AlreadyExists, errConflict.Error()),
+			}
+		},
+		"Given valid request of Create Transaction with Amount in satoshis, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					AmountSats: 1,
+				},
+			}
+
+			want := &rpc.Transaction{
+				UserId:     args.req.UserId,
+				Datetime:   args.req.Datetime,
+				Amount:     0.00000001,
+				AmountSats: args.req.AmountSats,
+			}
+
+			params := &repository.CreateTransactionParams{
+				UserID:     args.req.UserId,
+				Datetime:   args.req.Datetime.AsTime(),
+				AmountSats: args.req.AmountSats,
+				Asset:      "BTC",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateTransaction(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Create Transaction with debit overdraws the balance, When UC returns insufficient balance error, Return FailedPrecondition error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					AmountSats: -100,
+				},
+			}
+
+			params := &repository.CreateTransactionParams{
+				UserID:     args.req.UserId,
+				Datetime:   args.req.Datetime.AsTime(),
+				AmountSats: args.req.AmountSats,
+				Asset:      "BTC",
+			}
+
+			errInsufficient := fmt.Errorf("user id: %d: %w", args.req.UserId, repository.ErrInsufficientBalance)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateTransaction(args.ctx, params).Return(nil, errInsufficient)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.FailedPrecondition, errInsufficient.Error()),
+			}
+		},
+		"Given request of Create Transaction with sub-satoshi Amount, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					Amount: 0.000000001,
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args,
+				wantErr: status.Error(codes.InvalidArgument,
+					"invalid amount: amount: 0.000000001: amount has more precision than the minor unit of the asset"),
+			}
+		},
+		"Given request of Create Transaction with different Amount and Amount in satoshis, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					Amount:     100,
+					AmountSats: 100,
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid amount: amount and amount_sats are different"),
+			}
+		},
+		"Given request of Create Transaction without Amount, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid amount: amount should not be 0"),
+			}
+		},
+		"Given valid request of Create Transaction with Amount in ETH, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					Amount: 1.000000001,
+					Asset:  "ETH",
+				},
+			}
+
+			want := &rpc.Transaction{
+				UserId:     args.req.UserId,
+				Datetime:   args.req.Datetime,
+				Amount:     args.req.Amount,
+				AmountSats: 1_000_000_001,
+				Asset:      "ETH",
+			}
+
+			params := &repository.CreateTransactionParams{
+				UserID:     args.req.UserId,
+				Datetime:   args.req.Datetime.AsTime(),
+				AmountSats: 1_000_000_001,
+				Asset:      "ETH",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateTransaction(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args: args,
+				want: want,
+			}
+		},
+		"Given request of Create Transaction with unknown Asset, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					Amount: 100,
+					Asset:  "DOGE",
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid asset: asset: DOGE: unknown asset"),
+			}
+		},
+		"Given valid request of Create Transaction with metadata, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					AmountSats:  -1000,
+					Type:        rpc.TransactionType_TRANSACTION_TYPE_FEE,
+					Description: "Monthly fee",
+					ExternalRef: "invoice-1988",
+					Labels:      map[string]string{"channel": "mobile"},
+				},
+			}
+
+			want := &rpc.Transaction{
+				UserId:      args.req.UserId,
+				Datetime:    args.req.Datetime,
+				Amount:      -0.00001,
+				AmountSats:  args.req.AmountSats,
+				Asset:       "BTC",
+				Type:        args.req.Type,
+				Description: args.req.Description,
+				ExternalRef: args.req.ExternalRef,
+				Labels:      args.req.Labels,
+			}
+
+			params := &repository.CreateTransactionParams{
+				UserID:      args.req.UserId,
+				Datetime:    args.req.Datetime.AsTime(),
+				AmountSats:  args.req.AmountSats,
+				Asset:       "BTC",
+				Type:        args.req.Type,
+				Description: args.req.Description,
+				ExternalRef: args.req.ExternalRef,
+				Labels:      args.req.Labels,
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateTransaction(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args: args,
+				want: want,
+			}
+		},
+		"Given request of Create Transaction of a deposit with negative Amount, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					AmountSats: -1000,
+					Type:       rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid transaction metadata: the amount of a deposit should be positive"),
+			}
+		},
+		"Given request of Create Transaction with empty label key, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionRequest{
+					UserId: 1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					AmountSats: 1000,
+					Labels:     map[string]string{"": "mobile"},
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid transaction metadata: label key should have 1 to 63 characters"),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.CreateTransaction(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_ListTransaction(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.ListTransactionRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.ListTransactionResponse
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of List Transaction, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.ListTransactionRequest{
+					UserId: 1,
+					StartDatetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					EndDatetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					PageSize:  10,
+					PageToken: "page-token",
+					Bucket:    rpc.Bucket_BUCKET_DAY,
+				},
+			}
+
+			want := &rpc.ListTransactionResponse{
+				Transactions: []*rpc.Transaction{
+					{
+						UserId:   args.req.UserId,
+						Datetime: args.req.StartDatetime,
+						Amount:   100,
+					},
+				},
+			}
+
+			params := &repository.ListTransactionParams{
+				UserID:        args.req.UserId,
+				StartDatetime: args.req.StartDatetime.AsTime(),
+				EndDatetime:   args.req.EndDatetime.AsTime(),
+				PageSize:      args.req.PageSize,
+				PageToken:     args.req.PageToken,
+				Bucket:        args.req.Bucket,
+				Asset:         "BTC",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ListTransaction(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of List Transaction, When UC failed to executed, Return error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.ListTransactionRequest{
+					UserId: 1,
+					StartDatetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					EndDatetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+				},
+			}
+
+			params := &repository.ListTransactionParams{
+				UserID:        args.req.UserId,
+				StartDatetime: args.req.StartDatetime.AsTime(),
+				EndDatetime:   args.req.EndDatetime.AsTime(),
+				Asset:         "BTC",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ListTransaction(args.ctx, params).Return(nil, errors.New("error"))
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: errors.New("error"),
+			}
+		},
+		"Given valid request of List Transaction filtered by type and external reference, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.ListTransactionRequest{
+					UserId: 1,
+					StartDatetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					EndDatetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					Bucket:      rpc.Bucket_BUCKET_RAW,
+					Type:        rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+					ExternalRef: "invoice-1988",
+				},
+			}
+
+			want := &rpc.ListTransactionResponse{
+				Transactions: []*rpc.Transaction{
+					{
+						UserId:      args.req.UserId,
+						Datetime:    args.req.StartDatetime,
+						Amount:      100,
+						Type:        args.req.Type,
+						ExternalRef: args.req.ExternalRef,
+					},
+				},
+			}
+
+			params := &repository.ListTransactionParams{
+				UserID:        args.req.UserId,
+				StartDatetime: args.req.StartDatetime.AsTime(),
+				EndDatetime:   args.req.EndDatetime.AsTime(),
+				Bucket:        args.req.Bucket,
+				Asset:         "BTC",
+				Type:          args.req.Type,
+				ExternalRef:   args.req.ExternalRef,
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ListTransaction(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.ListTransaction(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_GetTransaction(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.GetTransactionRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.Transaction
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Get Transaction, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetTransactionRequest{
+					Id: "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+				},
+			}
+
+			want := &rpc.Transaction{
+				Id:     args.req.Id,
+				UserId: 1,
+				Datetime: &timestamppb.Timestamp{
+					Seconds: 1676169338,
+					Nanos:   0,
+				},
+				Amount: 100,
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetTransaction(args.ctx, args.req.GetId()).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Get Transaction, When UC returns not found error, Return NotFound error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetTransactionRequest{
+					Id: "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+				},
+			}
+
+			errNotFound := fmt.Errorf("transaction id: %s not found: %w", args.req.Id, repository.ErrNotFound)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetTransaction(args.ctx, args.req.GetId()).Return(nil, errNotFound)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.NotFound, errNotFound.Error()),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.GetTransaction(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_GetUserBalance(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.GetUserBalanceRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.UserBalance
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Get User Balance, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetUserBalanceRequest{
+					UserId: 1,
+				},
+			}
+
+			want := &rpc.UserBalance{
+				Balance: 100,
+			}
+
+			params := &repository.GetUserBalanceParams{
+				UserID: args.req.GetUserId(),
+				Asset:  "BTC",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetUserBalance(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Get User Balance as of a point in time, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetUserBalanceRequest{
+					UserId: 1,
+					AsOf: &timestamppb.Timestamp{
+						Seconds: 1676246399,
+						Nanos:   0,
+					},
+				},
+			}
+
+			want := &rpc.UserBalance{
+				Balance: 100,
+			}
+
+			params := &repository.GetUserBalanceParams{
+				UserID: args.req.GetUserId(),
+				AsOf:   args.req.GetAsOf().AsTime(),
+				Asset:  "BTC",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetUserBalance(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of List Get User Balance, When UC failed to executed, Return error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetUserBalanceRequest{
+					UserId: 1,
+				},
+			}
+
+			params := &repository.GetUserBalanceParams{
+				UserID: args.req.GetUserId(),
+				Asset:  "BTC",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetUserBalance(args.ctx, params).Return(nil, errors.New("error"))
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: errors.New("error"),
+			}
+		},
+		"Given valid request of Get User Balance in USD, When UC returns price not found error, Return FailedPrecondition error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetUserBalanceRequest{
+					UserId:        1,
+					QuoteCurrency: "USD",
+				},
+			}
+
+			params := &repository.GetUserBalanceParams{
+				UserID:        args.req.GetUserId(),
+				Asset:         "BTC",
+				QuoteCurrency: "USD",
+			}
+
+			errPriceNotFound := fmt.Errorf("BTC/USD: %w", repository.ErrPriceNotFound)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetUserBalance(args.ctx, params).Return(nil, errPriceNotFound)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.FailedPrecondition, errPriceNotFound.Error()),
+			}
+		},
+		"Given request of Get User Balance with invalid quote currency, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				ctx: context.Background(),
+				req: &rpc.GetUserBalanceRequest{
+					UserId:        1,
+					QuoteCurrency: "usd",
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args,
+				wantErr: status.Error(codes.InvalidArgument,
+					"invalid quote currency: quote_currency should be an ISO 4217 code, e.g. USD"),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.GetUserBalance(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_GetBalanceHistory(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.GetBalanceHistoryRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.GetBalanceHistoryResponse
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Get Balance History, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetBalanceHistoryRequest{
+					UserId: 1,
+					StartDatetime: &timestamppb.Timestamp{
+						Seconds: 1676160000,
+						Nanos:   0,
+					},
+					EndDatetime: &timestamppb.Timestamp{
+						Seconds: 1676419200,
+						Nanos:   0,
+					},
+					Bucket: rpc.Bucket_BUCKET_DAY,
+				},
+			}
+
+			want := &rpc.GetBalanceHistoryResponse{
+				Balances: []*rpc.BalancePoint{
+					{
+						Datetime:    args.req.StartDatetime,
+						Balance:     100,
+						BalanceSats: 10_000_000_000,
+					},
+				},
+			}
+
+			params := &repository.GetBalanceHistoryParams{
+				UserID:        args.req.UserId,
+				StartDatetime: args.req.StartDatetime.AsTime(),
+				EndDatetime:   args.req.EndDatetime.AsTime(),
+				Bucket:        args.req.Bucket,
+				Asset:         "BTC",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetBalanceHistory(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given request of Get Balance History with raw bucket, When UC returns invalid bucket error, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetBalanceHistoryRequest{
+					UserId: 1,
+					StartDatetime: &timestamppb.Timestamp{
+						Seconds: 1676160000,
+						Nanos:   0,
+					},
+					EndDatetime: &timestamppb.Timestamp{
+						Seconds: 1676419200,
+						Nanos:   0,
+					},
+					Bucket: rpc.Bucket_BUCKET_RAW,
+				},
+			}
+
+			params := &repository.GetBalanceHistoryParams{
+				UserID:        args.req.UserId,
+				StartDatetime: args.req.StartDatetime.AsTime(),
+				EndDatetime:   args.req.EndDatetime.AsTime(),
+				Bucket:        args.req.Bucket,
+				Asset:         "BTC",
+			}
+
+			errBucket := fmt.Errorf("bucket: %s: %w", args.req.Bucket, repository.ErrInvalidBucket)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetBalanceHistory(args.ctx, params).Return(nil, errBucket)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, errBucket.Error()),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.GetBalanceHistory(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// watchUserBalanceStream is a fake server stream of WatchUserBalance, which records the sent balances.
+type watchUserBalanceStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*rpc.UserBalance
+}
+
+func (s *watchUserBalanceStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchUserBalanceStream) Send(balance *rpc.UserBalance) error {
+	s.sent = append(s.sent, balance)
+
+	return nil
+}
+
+// exportTransactionsStream is a fake server stream of ExportTransactions, which records the sent transactions.
+type exportTransactionsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*rpc.Transaction
+}
+
+func (s *exportTransactionsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportTransactionsStream) Send(transaction *rpc.Transaction) error {
+	s.sent = append(s.sent, transaction)
+
+	return nil
+}
+
+func TestBTCServer_ExportTransactions(t *testing.T) {
+	type args struct {
+		req    *rpc.ExportTransactionsRequest
+		stream *exportTransactionsStream
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    []*rpc.Transaction
+		wantErr error
+	}
+
+	startDatetime := timestamppb.New(time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC))
+	endDatetime := timestamppb.New(time.Date(2023, 2, 13, 0, 0, 0, 0, time.UTC))
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Export transactions without asset, When UC sends the transactions, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				req: &rpc.ExportTransactionsRequest{
+					StartDatetime: startDatetime,
+					EndDatetime:   endDatetime,
+				},
+				stream: &exportTransactionsStream{
+					ctx: context.Background(),
+				},
+			}
+
+			want := []*rpc.Transaction{
+				{Id: "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a", UserId: 1, Amount: 100, AmountSats: 10_000_000_000, Asset: "BTC"},
+				{Id: "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3b", UserId: 2, Amount: 1.5, AmountSats: 1_500_000_000, Asset: "ETH"},
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ExportTransactions(args.stream.ctx, &repository.ExportTransactionsParams{
+				StartDatetime: startDatetime.AsTime(),
+				EndDatetime:   endDatetime.AsTime(),
+			}, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ *repository.ExportTransactionsParams, send func(*rpc.Transaction) error) error {
+					for _, transaction := range want {
+						if err := send(transaction); err != nil {
+							return err
+						}
+					}
+
+					return nil
+				})
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Export transactions with filters, When UC returns invalid metadata error, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				req: &rpc.ExportTransactionsRequest{
+					UserId:        1,
+					StartDatetime: startDatetime,
+					EndDatetime:   endDatetime,
+					Asset:         "ETH",
+					Type:          rpc.TransactionType(99),
+					ExternalRef:   "bank-1",
+				},
+				stream: &exportTransactionsStream{
+					ctx: context.Background(),
+				},
+			}
+
+			errInvalidMetadata := fmt.Errorf("type: %s: %w", args.req.GetType(), repository.ErrInvalidMetadata)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ExportTransactions(args.stream.ctx, &repository.ExportTransactionsParams{
+				StartDatetime: startDatetime.AsTime(),
+				EndDatetime:   endDatetime.AsTime(),
+				UserID:        1,
+				Asset:         "ETH",
+				Type:          args.req.GetType(),
+				ExternalRef:   "bank-1",
+			}, gomock.Any()).Return(errInvalidMetadata)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, errInvalidMetadata.Error()),
+			}
+		},
+		"Given invalid asset of Export transactions, When validating the request, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				req: &rpc.ExportTransactionsRequest{
+					StartDatetime: startDatetime,
+					EndDatetime:   endDatetime,
+					Asset:         "DOGE",
+				},
+				stream: &exportTransactionsStream{
+					ctx: context.Background(),
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args,
+				wantErr: status.Error(codes.InvalidArgument,
+					"invalid asset: asset: DOGE: unknown asset",
+				),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			err := sut.ExportTransactions(tt.args.req, tt.args.stream)
+			assert.Equal(t, tt.want, tt.args.stream.sent)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_WatchUserBalance(t *testing.T) {
+	type args struct {
+		req    *rpc.WatchUserBalanceRequest
+		stream *watchUserBalanceStream
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    []*rpc.UserBalance
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Watch User Balance, When UC sends the balances, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				req: &rpc.WatchUserBalanceRequest{
+					UserId: 1,
+				},
+				stream: &watchUserBalanceStream{
+					ctx: context.Background(),
+				},
+			}
+
+			want := []*rpc.UserBalance{
+				{
+					Balance:     100,
+					BalanceSats: 10_000_000_000,
+				},
+				{
+					Balance:     100.5,
+					BalanceSats: 10_050_000_000,
+				},
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().WatchUserBalance(args.stream.ctx, args.req.GetUserId(), "BTC", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ int64, _ string, send func(*rpc.UserBalance) error) error {
+					for _, balance := range want {
+						if err := send(balance); err != nil {
+							return err
+						}
+					}
+
+					return nil
+				})
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Watch User Balance, When UC returns not found error, Return NotFound error": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				req: &rpc.WatchUserBalanceRequest{
+					UserId: 1,
+				},
+				stream: &watchUserBalanceStream{
+					ctx: context.Background(),
+				},
+			}
+
+			errNotFound := fmt.Errorf("user id: %d not found: %w", args.req.GetUserId(), repository.ErrNotFound)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().WatchUserBalance(args.stream.ctx, args.req.GetUserId(), "BTC", gomock.Any()).Return(errNotFound)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.NotFound, errNotFound.Error()),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			err := sut.WatchUserBalance(tt.args.req, tt.args.stream)
+			assert.Equal(t, tt.want, tt.args.stream.sent)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_Transfer(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.TransferRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.TransferResponse
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Transfer, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.TransferRequest{
+					FromUserId: 1,
+					ToUserId:   2,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					AmountSats:     10_000_000_000,
+					IdempotencyKey: "key-1",
+				},
+			}
+
+			want := &rpc.TransferResponse{
+				Id: "7f0b9d3e-2c4a-4e8b-9a1d-5c6e7f8a9b0c",
+				Debit: &rpc.Transaction{
+					UserId:     args.req.FromUserId,
+					Datetime:   args.req.Datetime,
+					Amount:     -100,
+					AmountSats: -args.req.AmountSats,
+					TransferId: "7f0b9d3e-2c4a-4e8b-9a1d-5c6e7f8a9b0c",
+				},
+				Credit: &rpc.Transaction{
+					UserId:     args.req.ToUserId,
+					Datetime:   args.req.Datetime,
+					Amount:     100,
+					AmountSats: args.req.AmountSats,
+					TransferId: "7f0b9d3e-2c4a-4e8b-9a1d-5c6e7f8a9b0c",
+				},
+			}
+
+			params := &repository.TransferParams{
+				FromUserID:     args.req.FromUserId,
+				ToUserID:       args.req.ToUserId,
+				Datetime:       args.req.Datetime.AsTime(),
+				AmountSats:     args.req.AmountSats,
+				IdempotencyKey: args.req.IdempotencyKey,
+				Asset:          "BTC",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().Transfer(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Transfer, When UC returns insufficient balance error, Return FailedPrecondition error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.TransferRequest{
+					FromUserId: 1,
+					ToUserId:   2,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					AmountSats: 10_000_000_000,
+				},
+			}
+
+			params := &repository.TransferParams{
+				FromUserID: args.req.FromUserId,
+				ToUserID:   args.req.ToUserId,
+				Datetime:   args.req.Datetime.AsTime(),
+				AmountSats: args.req.AmountSats,
+				Asset:      "BTC",
+			}
+
+			errInsufficient := fmt.Errorf("user id: %d: %w", args.req.FromUserId, repository.ErrInsufficientBalance)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().Transfer(args.ctx, params).Return(nil, errInsufficient)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.FailedPrecondition, errInsufficient.Error()),
+			}
+		},
+		"Given request of Transfer with non-positive Amount, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.TransferRequest{
+					FromUserId: 1,
+					ToUserId:   2,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					AmountSats: -1,
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid amount: amount_sats should greater than 0"),
+			}
+		},
+		"Given request of Transfer to the same User, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.TransferRequest{
+					FromUserId: 1,
+					ToUserId:   1,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676169338,
+						Nanos:   0,
+					},
+					AmountSats: 10_000_000_000,
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid transfer: from_user_id and to_user_id should be different"),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.Transfer(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_CreateTransactions(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.CreateTransactionsRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.CreateTransactionsResponse
+		wantErr error
+	}
+
+	datetime := &timestamppb.Timestamp{
+		Seconds: 1676169338,
+		Nanos:   0,
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of CreateTransactions, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionsRequest{
+					Transactions: []*rpc.CreateTransactionRequest{
+						{UserId: 1, Datetime: datetime, AmountSats: 10_000_000_000, IdempotencyKey: "key-1"},
+						{UserId: 2, Datetime: datetime, Amount: 1.5},
+					},
+				},
+			}
+
+			transactions := []*rpc.Transaction{
+				{
+					Id:         "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+					UserId:     1,
+					Datetime:   datetime,
+					Amount:     100,
+					AmountSats: 10_000_000_000,
+				},
+				{
+					Id:         "6e7f8a9b-0c1d-4e2f-a3b4-c5d6e7f8a9b0",
+					UserId:     2,
+					Datetime:   datetime,
+					Amount:     1.5,
+					AmountSats: 150_000_000,
+				},
+			}
+
+			params := &repository.CreateTransactionsParams{
+				Transactions: []*repository.CreateTransactionParams{
+					{UserID: 1, Datetime: datetime.AsTime(), AmountSats: 10_000_000_000, IdempotencyKey: "key-1", Asset: "BTC"},
+					{UserID: 2, Datetime: datetime.AsTime(), AmountSats: 150_000_000, Asset: "BTC"},
+				},
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateTransactions(args.ctx, params).Return([]*repository.CreateTransactionsResult{
+				{Transaction: transactions[0]},
+				{Transaction: transactions[1]},
+			}, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args: args,
+				want: &rpc.CreateTransactionsResponse{
+					Results: []*rpc.CreateTransactionsResult{
+						{Transaction: transactions[0]},
+						{Transaction: transactions[1]},
+					},
+				},
+				wantErr: nil,
+			}
+		},
+		"Given request of CreateTransactions in best-effort mode with rejected entries, When UC executed successfully, Return the result of each entry": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionsRequest{
+					Transactions: []*rpc.CreateTransactionRequest{
+						{UserId: 1, Datetime: datetime, AmountSats: 0},
+						{UserId: 1, Datetime: datetime, AmountSats: 10_000_000_000},
+						{UserId: 2, Datetime: datetime, AmountSats: -10_000_000_000},
+					},
+					BestEffort: true,
+				},
+			}
+
+			transaction := &rpc.Transaction{
+				Id:         "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+				UserId:     1,
+				Datetime:   datetime,
+				Amount:     100,
+				AmountSats: 10_000_000_000,
+			}
+
+			// The invalid entry is left out, thus the index of the params is shifted.
+			params := &repository.CreateTransactionsParams{
+				Transactions: []*repository.CreateTransactionParams{
+					{UserID: 1, Datetime: datetime.AsTime(), AmountSats: 10_000_000_000, Asset: "BTC"},
+					{UserID: 2, Datetime: datetime.AsTime(), AmountSats: -10_000_000_000, Asset: "BTC"},
+				},
+				BestEffort: true,
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateTransactions(args.ctx, params).Return([]*repository.CreateTransactionsResult{
+				{Transaction: transaction},
+				{Err: fmt.Errorf("user id: 2: %w", repository.ErrInsufficientBalance)},
+			}, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args: args,
+				want: &rpc.CreateTransactionsResponse{
+					Results: []*rpc.CreateTransactionsResult{
+						{Code: int32(codes.InvalidArgument), Message: "transactions[0]: invalid amount: amount should not be 0"},
+						{Transaction: transaction},
+						{Code: int32(codes.FailedPrecondition), Message: "transactions[2]: user id: 2: insufficient balance"},
+					},
+				},
+				wantErr: nil,
+			}
+		},
+		"Given request of CreateTransactions with invalid entry, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionsRequest{
+					Transactions: []*rpc.CreateTransactionRequest{
+						{UserId: 1, Datetime: datetime, AmountSats: 10_000_000_000},
+						{UserId: 1, Datetime: datetime, Amount: 0.000000001},
+					},
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args,
+				wantErr: status.Error(codes.InvalidArgument,
+					"transactions[1]: invalid amount: amount: 0.000000001: amount has more precision than the minor unit of the asset"),
+			}
+		},
+		"Given empty request of CreateTransactions, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionsRequest{},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid batch: transactions should have 1 to 1000 entries"),
+			}
+		},
+		"Given valid request of CreateTransactions, When UC returns insufficient balance error, Return FailedPrecondition error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateTransactionsRequest{
+					Transactions: []*rpc.CreateTransactionRequest{
+						{UserId: 2, Datetime: datetime, AmountSats: -10_000_000_000},
+					},
+				},
+			}
+
+			params := &repository.CreateTransactionsParams{
+				Transactions: []*repository.CreateTransactionParams{
+					{UserID: 2, Datetime: datetime.AsTime(), AmountSats: -10_000_000_000, Asset: "BTC"},
+				},
+			}
+
+			errInsufficient := fmt.Errorf("transactions[0]: user id: 2: %w", repository.ErrInsufficientBalance)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateTransactions(args.ctx, params).Return(nil, errInsufficient)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.FailedPrecondition, errInsufficient.Error()),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.CreateTransactions(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_ReverseTransaction(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.ReverseTransactionRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.Transaction
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of ReverseTransaction, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.ReverseTransactionRequest{
+					Id:     "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+					Reason: "duplicated deposit",
+				},
+			}
+
+			want := &rpc.Transaction{
+				Id:     "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+				UserId: 1,
+				Datetime: &timestamppb.Timestamp{
+					Seconds: 1676169338,
+					Nanos:   0,
+				},
+				Amount:         -100,
+				AmountSats:     -10_000_000_000,
+				ReversedId:     args.req.Id,
+				ReversalReason: args.req.Reason,
+			}
+
+			params := &repository.ReverseTransactionParams{
+				ID:     args.req.Id,
+				Reason: args.req.Reason,
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ReverseTransaction(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of ReverseTransaction, When UC returns already reversed error, Return AlreadyExists error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.ReverseTransactionRequest{
+					Id:     "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+					Reason: "duplicated deposit",
+				},
+			}
+
+			params := &repository.ReverseTransactionParams{
+				ID:     args.req.Id,
+				Reason: args.req.Reason,
+			}
+
+			errReversed := fmt.Errorf("transaction id: %s: %w", args.req.Id, repository.ErrAlreadyReversed)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ReverseTransaction(args.ctx, params).Return(nil, errReversed)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.AlreadyExists, errReversed.Error()),
+			}
+		},
+		"Given request of ReverseTransaction without reason, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.ReverseTransactionRequest{
+					Id: "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid reason: reason should have 1 to 256 characters"),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.ReverseTransaction(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+// importTransactionsStream is a fake server stream of ImportTransactions,
+// which receives the requests and then io.EOF, and records the response.
+type importTransactionsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*rpc.ImportTransactionsRequest
+	resp *rpc.ImportTransactionsResponse
+}
+
+func (s *importTransactionsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importTransactionsStream) Recv() (*rpc.ImportTransactionsRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+
+	return req, nil
+}
+
+func (s *importTransactionsStream) SendAndClose(resp *rpc.ImportTransactionsResponse) error {
+	s.resp = resp
+
+	return nil
+}
+
+func TestBTCServer_ImportTransactions(t *testing.T) {
+	type args struct {
+		stream *importTransactionsStream
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.ImportTransactionsResponse
+		wantErr error
+	}
+
+	importID := "import-1"
+	datetime := timestamppb.New(time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC))
+
+	row := func(sequence int64, transaction *rpc.CreateTransactionRequest) *rpc.ImportTransactionsRequest {
+		return &rpc.ImportTransactionsRequest{ImportId: importID, Sequence: sequence, Transaction: transaction}
+	}
+
+	checkpoint := &rpc.ImportCheckpoint{ImportId: importID, Sequence: 3, Imported: 1, Rejected: 2, UpdatedAt: datetime}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid stream of Import transactions, When UC imports the rows, Return the summary with the rejections": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				stream: &importTransactionsStream{
+					ctx: context.Background(),
+					reqs: []*rpc.ImportTransactionsRequest{
+						row(1, &rpc.CreateTransactionRequest{UserId: 1, Datetime: datetime, Amount: 10}),
+						row(2, &rpc.CreateTransactionRequest{UserId: 1, Datetime: datetime, Amount: 10, IdempotencyKey: "key-1"}),
+						row(3, &rpc.CreateTransactionRequest{UserId: 1, Datetime: datetime, Amount: -100}),
+					},
+				},
+			}
+
+			errInsufficientBalance := fmt.Errorf("user id: 1: %w", repository.ErrInsufficientBalance)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ImportTransactions(args.stream.ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, params *repository.ImportTransactionsParams) (*repository.ImportTransactionsResult, error) {
+					assert.Equal(t, importID, params.ImportID)
+					assert.Len(t, params.Rows, 3)
+					assert.Equal(t, int64(1_000_000_000), params.Rows[0].Transaction.AmountSats)
+					assert.ErrorIs(t, params.Rows[1].Err, repository.ErrInvalidImport)
+					assert.Nil(t, params.Rows[2].Err)
+
+					return &repository.ImportTransactionsResult{
+						Checkpoint: checkpoint,
+						Imported:   1,
+						Rejections: []*repository.ImportRejection{
+							{Sequence: 2, Err: params.Rows[1].Err},
+							{Sequence: 3, Err: errInsufficientBalance},
+						},
+					}, nil
+				})
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args: args,
+				want: &rpc.ImportTransactionsResponse{
+					Checkpoint: checkpoint,
+					Imported:   1,
+					Rejected:   2,
+					Rejections: []*rpc.ImportRejection{
+						{
+							Sequence: 2,
+							Code:     int32(codes.InvalidArgument),
+							Message:  "invalid import: idempotency_key isn't supported by the import",
+						},
+						{
+							Sequence: 3,
+							Code:     int32(codes.FailedPrecondition),
+							Message:  errInsufficientBalance.Error(),
+						},
+					},
+				},
+				wantErr: nil,
+			}
+		},
+		"Given stream of Import transactions with decreasing sequence, When validating the rows, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args{
+					stream: &importTransactionsStream{
+						ctx: context.Background(),
+						reqs: []*rpc.ImportTransactionsRequest{
+							row(2, &rpc.CreateTransactionRequest{UserId: 1, Datetime: datetime, Amount: 10}),
+							row(1, &rpc.CreateTransactionRequest{UserId: 1, Datetime: datetime, Amount: 10}),
+						},
+					},
+				},
+				wantErr: status.Error(codes.InvalidArgument, "invalid import: sequence: 1 should be greater than 2"),
+			}
+		},
+		"Given stream of Import transactions with different import IDs, When validating the rows, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args{
+					stream: &importTransactionsStream{
+						ctx: context.Background(),
+						reqs: []*rpc.ImportTransactionsRequest{
+							row(1, &rpc.CreateTransactionRequest{UserId: 1, Datetime: datetime, Amount: 10}),
+							{ImportId: "import-2", Sequence: 2, Transaction: &rpc.CreateTransactionRequest{UserId: 1, Datetime: datetime, Amount: 10}},
+						},
+					},
+				},
+				wantErr: status.Error(codes.InvalidArgument, "invalid import: import_id: import-2 should be import-1 for all the rows"),
+			}
+		},
+		"Given empty stream of Import transactions, When the stream is closed, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args{
+					stream: &importTransactionsStream{
+						ctx: context.Background(),
+					},
+				},
+				wantErr: status.Error(codes.InvalidArgument, "invalid import: the stream should have at least 1 row"),
+			}
+		},
+		"Given valid stream of Import transactions, When UC returns error, Return the error": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				stream: &importTransactionsStream{
+					ctx: context.Background(),
+					reqs: []*rpc.ImportTransactionsRequest{
+						row(1, &rpc.CreateTransactionRequest{UserId: 1, Datetime: datetime, Amount: 10}),
+					},
+				},
+			}
+
+			errInternal := errors.New("error")
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ImportTransactions(args.stream.ctx, gomock.Any()).Return(nil, errInternal)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			err := sut.ImportTransactions(tt.args.stream)
+			assert.Equal(t, tt.want, tt.args.stream.resp)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_GetImportCheckpoint(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.GetImportCheckpointRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.ImportCheckpoint
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Get import checkpoint, When UC executed successfully, Return the checkpoint": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				ctx: context.Background(),
+				req: &rpc.GetImportCheckpointRequest{ImportId: "import-1"},
+			}
+
+			want := &rpc.ImportCheckpoint{ImportId: "import-1", Sequence: 1000, Imported: 990, Rejected: 10}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetImportCheckpoint(args.ctx, "import-1").Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Get import checkpoint, When UC returns not found error, Return NotFound error": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				ctx: context.Background(),
+				req: &rpc.GetImportCheckpointRequest{ImportId: "import-1"},
+			}
+
+			errNotFound := fmt.Errorf("import id: import-1 not found: %w", repository.ErrNotFound)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetImportCheckpoint(args.ctx, "import-1").Return(nil, errNotFound)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: status.Error(codes.NotFound, errNotFound.Error()),
+			}
+		},
+		"Given empty import ID of Get import checkpoint, When validating the request, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args{
+					ctx: context.Background(),
+					req: &rpc.GetImportCheckpointRequest{},
+				},
+				want:    nil,
+				wantErr: status.Error(codes.InvalidArgument, "invalid import: import_id should have 1 to 64 characters"),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.GetImportCheckpoint(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_GenerateStatement(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.GenerateStatementRequest
+	}
+
+	type test struct {
+		fields          fields
+		args            args
+		want            *rpc.Statement
+		wantContentType string
+		wantErr         error
+	}
+
+	startDatetime := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	endDatetime := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Generate statement in HTML, When UC executed successfully, Return the statement and the document": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				ctx: context.Background(),
+				req: &rpc.GenerateStatementRequest{
+					UserId:        1,
+					Asset:         "ETH",
+					StartDatetime: timestamppb.New(startDatetime),
+					EndDatetime:   timestamppb.New(endDatetime),
+					Format:        rpc.StatementFormat_STATEMENT_FORMAT_HTML,
+				},
+			}
+
+			want := &rpc.Statement{
+				UserId:             1,
+				Asset:              "ETH",
+				StartDatetime:      timestamppb.New(startDatetime),
+				EndDatetime:        timestamppb.New(endDatetime),
+				ClosingBalance:     1.5,
+				ClosingBalanceSats: 1_500_000_000,
+				TotalIn:            1.5,
+				TotalInSats:        1_500_000_000,
+				Transactions: []*rpc.Transaction{
+					{Id: "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a", UserId: 1, Amount: 1.5, AmountSats: 1_500_000_000, Asset: "ETH"},
+				},
+				GeneratedAt: timestamppb.New(endDatetime),
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetStatement(args.ctx, &repository.GetStatementParams{
+				UserID:        1,
+				StartDatetime: startDatetime,
+				EndDatetime:   endDatetime,
+				Asset:         "ETH",
+			}).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:            args,
+				want:            want,
+				wantContentType: "text/html; charset=utf-8",
+				wantErr:         nil,
+			}
+		},
+		"Given valid request of Generate statement, When UC returns not found error, Return NotFound error": func(t *testing.T, ctrl *gomock.Controller) test {
+			args := args{
+				ctx: context.Background(),
+				req: &rpc.GenerateStatementRequest{
+					UserId:        1,
+					StartDatetime: timestamppb.New(startDatetime),
+					EndDatetime:   timestamppb.New(endDatetime),
+				},
+			}
+
+			errNotFound := fmt.Errorf("user id: 1 not found: %w", repository.ErrNotFound)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetStatement(args.ctx, &repository.GetStatementParams{
+				UserID:        1,
+				StartDatetime: startDatetime,
+				EndDatetime:   endDatetime,
+				Asset:         "BTC",
+			}).Return(nil, errNotFound)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.NotFound, errNotFound.Error()),
+			}
+		},
+		"Given the end before the start of Generate statement, When validating the request, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args{
+					ctx: context.Background(),
+					req: &rpc.GenerateStatementRequest{
+						UserId:        1,
+						StartDatetime: timestamppb.New(endDatetime),
+						EndDatetime:   timestamppb.New(startDatetime),
+					},
+				},
+				wantErr: status.Error(codes.InvalidArgument, "invalid statement: end_datetime should be after start_datetime"),
+			}
+		},
+		"Given unknown format of Generate statement, When validating the request, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args{
+					ctx: context.Background(),
+					req: &rpc.GenerateStatementRequest{
+						UserId:        1,
+						StartDatetime: timestamppb.New(startDatetime),
+						EndDatetime:   timestamppb.New(endDatetime),
+						Format:        rpc.StatementFormat(99),
+					},
+				},
+				wantErr: status.Error(codes.InvalidArgument, "invalid statement: format: 99 is unknown"),
+			}
+		},
 	}
 
 	for name, testFn := range tests {
@@ -121,63 +2236,219 @@ func TestBTCServer_CreateTransaction(t *testing.T) {
 
 			sut := sut(tt.fields)
 
-			got, err := sut.CreateTransaction(tt.args.ctx, tt.args.req)
-			assert.Equal(t, tt.want, got)
+			got, err := sut.GenerateStatement(tt.args.ctx, tt.args.req)
 			assert.Equal(t, tt.wantErr, err)
+
+			if err != nil {
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.Equal(t, tt.want, got.GetStatement())
+			assert.Equal(t, tt.wantContentType, got.GetContentType())
+			assert.Contains(t, string(got.GetDocument()), "<td>1.5</td>")
 		})
 	}
 }
 
-func TestBTCServer_ListTransaction(t *testing.T) {
+func TestBTCServer_CreateHold(t *testing.T) {
 	type args struct {
 		ctx context.Context
-		req *rpc.ListTransactionRequest
+		req *rpc.CreateHoldRequest
 	}
 
 	type test struct {
 		fields  fields
 		args    args
-		want    *rpc.ListTransactionResponse
+		want    *rpc.Hold
 		wantErr error
 	}
 
+	expiresAt := time.Now().Add(time.Hour).UTC()
+
 	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
-		"Given valid request of List Transaction, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+		"Given valid request of CreateHold, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
 			ctx := context.Background()
 
 			args := args{
 				ctx: ctx,
-				req: &rpc.ListTransactionRequest{
+				req: &rpc.CreateHoldRequest{
+					UserId:      1,
+					AmountSats:  2_000_000_000,
+					ExpiresAt:   timestamppb.New(expiresAt),
+					ExternalRef: "onchain-1",
+				},
+			}
+
+			want := &rpc.Hold{
+				Id:          "3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7",
+				UserId:      1,
+				Asset:       "BTC",
+				AmountSats:  2_000_000_000,
+				Status:      rpc.HoldStatus_HOLD_STATUS_PENDING,
+				ExpiresAt:   args.req.ExpiresAt,
+				ExternalRef: args.req.ExternalRef,
+			}
+
+			params := &repository.CreateHoldParams{
+				UserID:      1,
+				AmountSats:  2_000_000_000,
+				ExpiresAt:   expiresAt,
+				Asset:       "BTC",
+				ExternalRef: args.req.ExternalRef,
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateHold(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of CreateHold, When UC returns insufficient balance error, Return FailedPrecondition error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateHoldRequest{
+					UserId:     1,
+					AmountSats: 2_000_000_000,
+					ExpiresAt:  timestamppb.New(expiresAt),
+				},
+			}
+
+			params := &repository.CreateHoldParams{
+				UserID:     1,
+				AmountSats: 2_000_000_000,
+				ExpiresAt:  expiresAt,
+				Asset:      "BTC",
+			}
+
+			errInsufficient := fmt.Errorf("user id: 1: %w", repository.ErrInsufficientBalance)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateHold(args.ctx, params).Return(nil, errInsufficient)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.FailedPrecondition, errInsufficient.Error()),
+			}
+		},
+		"Given request of CreateHold with past deadline, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateHoldRequest{
+					UserId:     1,
+					AmountSats: 2_000_000_000,
+					ExpiresAt:  timestamppb.New(expiresAt.Add(-2 * time.Hour)),
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid hold: expires_at should be in the future and at most 30 days ahead"),
+			}
+		},
+		"Given request of CreateHold with 0 amount, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateHoldRequest{
 					UserId: 1,
-					StartDatetime: &timestamppb.Timestamp{
-						Seconds: 1676169338,
-						Nanos:   0,
-					},
-					EndDatetime: &timestamppb.Timestamp{
-						Seconds: 1676169338,
-						Nanos:   0,
-					},
 				},
 			}
 
-			want := &rpc.ListTransactionResponse{
-				Transactions: []*rpc.Transaction{
-					{
-						UserId:   args.req.UserId,
-						Datetime: args.req.StartDatetime,
-						Amount:   100,
-					},
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
 				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid amount: amount_sats should be greater than 0"),
 			}
+		},
+	}
 
-			params := &repository.ListTransactionParams{
-				UserID:        args.req.UserId,
-				StartDatetime: args.req.StartDatetime.AsTime(),
-				EndDatetime:   args.req.EndDatetime.AsTime(),
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.CreateHold(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_CaptureHold(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.CaptureHoldRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.CaptureHoldResponse
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of CaptureHold, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CaptureHoldRequest{
+					Id:         "3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7",
+					AmountSats: 1_500_000_000,
+				},
+			}
+
+			want := &rpc.CaptureHoldResponse{
+				Hold: &rpc.Hold{
+					Id:            args.req.Id,
+					UserId:        1,
+					Asset:         "BTC",
+					AmountSats:    2_000_000_000,
+					Status:        rpc.HoldStatus_HOLD_STATUS_CAPTURED,
+					TransactionId: "4f5a6b7c-8d9e-4f0a-b1c2-d3e4f5a6b7c8",
+				},
+				Transaction: &rpc.Transaction{
+					Id:         "4f5a6b7c-8d9e-4f0a-b1c2-d3e4f5a6b7c8",
+					UserId:     1,
+					Amount:     -15,
+					AmountSats: -1_500_000_000,
+					Asset:      "BTC",
+					Type:       rpc.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+				},
+			}
+
+			params := &repository.CaptureHoldParams{
+				ID:         args.req.Id,
+				AmountSats: args.req.AmountSats,
 			}
 
 			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
-			ucMock.EXPECT().ListTransaction(args.ctx, params).Return(want, nil)
+			ucMock.EXPECT().CaptureHold(args.ctx, params).Return(want, nil)
 
 			return test{
 				fields: fields{
@@ -188,39 +2459,50 @@ func TestBTCServer_ListTransaction(t *testing.T) {
 				wantErr: nil,
 			}
 		},
-		"Given valid request of List Transaction, When UC failed to executed, Return error": func(t *testing.T, ctrl *gomock.Controller) test {
+		"Given valid request of CaptureHold, When UC returns hold settled error, Return FailedPrecondition error": func(t *testing.T, ctrl *gomock.Controller) test {
 			ctx := context.Background()
 
 			args := args{
 				ctx: ctx,
-				req: &rpc.ListTransactionRequest{
-					UserId: 1,
-					StartDatetime: &timestamppb.Timestamp{
-						Seconds: 1676169338,
-						Nanos:   0,
-					},
-					EndDatetime: &timestamppb.Timestamp{
-						Seconds: 1676169338,
-						Nanos:   0,
-					},
+				req: &rpc.CaptureHoldRequest{
+					Id: "3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7",
 				},
 			}
 
-			params := &repository.ListTransactionParams{
-				UserID:        args.req.UserId,
-				StartDatetime: args.req.StartDatetime.AsTime(),
-				EndDatetime:   args.req.EndDatetime.AsTime(),
+			params := &repository.CaptureHoldParams{
+				ID: args.req.Id,
 			}
 
+			errSettled := fmt.Errorf("hold id: %s is released: %w", args.req.Id, repository.ErrHoldSettled)
+
 			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
-			ucMock.EXPECT().ListTransaction(args.ctx, params).Return(nil, errors.New("error"))
+			ucMock.EXPECT().CaptureHold(args.ctx, params).Return(nil, errSettled)
 
 			return test{
 				fields: fields{
 					uc: ucMock,
 				},
 				args:    args,
-				wantErr: errors.New("error"),
+				wantErr: status.Error(codes.FailedPrecondition, errSettled.Error()),
+			}
+		},
+		"Given request of CaptureHold with negative amount, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CaptureHoldRequest{
+					Id:         "3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7",
+					AmountSats: -1,
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid amount: amount_sats should not be negative"),
 			}
 		},
 	}
@@ -234,43 +2516,61 @@ func TestBTCServer_ListTransaction(t *testing.T) {
 
 			sut := sut(tt.fields)
 
-			got, err := sut.ListTransaction(tt.args.ctx, tt.args.req)
+			got, err := sut.CaptureHold(tt.args.ctx, tt.args.req)
 			assert.Equal(t, tt.want, got)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
 }
 
-func TestBTCServer_GetUserBalance(t *testing.T) {
+func TestBTCServer_SetTransactionLimits(t *testing.T) {
 	type args struct {
 		ctx context.Context
-		req *rpc.GetUserBalanceRequest
+		req *rpc.SetTransactionLimitsRequest
 	}
 
 	type test struct {
 		fields  fields
 		args    args
-		want    *rpc.UserBalance
+		want    *rpc.TransactionLimits
 		wantErr error
 	}
 
 	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
-		"Given valid request of Get User Balance, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+		"Given valid request of SetTransactionLimits, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
 			ctx := context.Background()
 
 			args := args{
 				ctx: ctx,
-				req: &rpc.GetUserBalanceRequest{
-					UserId: 1,
+				req: &rpc.SetTransactionLimitsRequest{
+					UserId:              1,
+					MaxAmountSats:       1_000_000_000,
+					MaxDailyOutflowSats: 5_000_000_000,
+					MaxCount:            10,
+					CountWindowSeconds:  3600,
 				},
 			}
 
-			want := &rpc.UserBalance{
-				Balance: 100,
+			want := &rpc.TransactionLimits{
+				UserId:              1,
+				Asset:               "BTC",
+				MaxAmountSats:       1_000_000_000,
+				MaxDailyOutflowSats: 5_000_000_000,
+				MaxCount:            10,
+				CountWindowSeconds:  3600,
+			}
+
+			params := &repository.SetTransactionLimitsParams{
+				UserID:              1,
+				Asset:               "BTC",
+				MaxAmountSats:       1_000_000_000,
+				MaxDailyOutflowSats: 5_000_000_000,
+				MaxCount:            10,
+				CountWindow:         time.Hour,
 			}
 
 			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
-			ucMock.EXPECT().GetUserBalance(args.ctx, args.req.GetUserId()).Return(want, nil)
+			ucMock.EXPECT().SetTransactionLimits(args.ctx, params).Return(want, nil)
 
 			return test{
 				fields: fields{
@@ -281,25 +2581,73 @@ func TestBTCServer_GetUserBalance(t *testing.T) {
 				wantErr: nil,
 			}
 		},
-		"Given valid request of List Get User Balance, When UC failed to executed, Return error": func(t *testing.T, ctrl *gomock.Controller) test {
+		"Given request of SetTransactionLimits with count window over 30 days, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
 			ctx := context.Background()
 
 			args := args{
 				ctx: ctx,
-				req: &rpc.GetUserBalanceRequest{
-					UserId: 1,
+				req: &rpc.SetTransactionLimitsRequest{
+					MaxCount:           10,
+					CountWindowSeconds: 2_592_001,
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args,
+				wantErr: status.Error(codes.InvalidArgument,
+					"invalid transaction limits: count_window_seconds should be between 0 and 30 days"),
+			}
+		},
+		"Given request of SetTransactionLimits with negative limit, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.SetTransactionLimitsRequest{
+					UserId:        1,
+					MaxAmountSats: -1,
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid transaction limits: limits should not be negative"),
+			}
+		},
+		"Given valid request of SetTransactionLimits, When UC returns not found error, Return NotFound error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.SetTransactionLimitsRequest{
+					UserId:   99999,
+					MaxCount: 10,
 				},
 			}
 
+			params := &repository.SetTransactionLimitsParams{
+				UserID:   99999,
+				Asset:    "BTC",
+				MaxCount: 10,
+			}
+
+			errNotFound := fmt.Errorf("user id: 99999 not found: %w", repository.ErrNotFound)
+
 			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
-			ucMock.EXPECT().GetUserBalance(args.ctx, args.req.GetUserId()).Return(nil, errors.New("error"))
+			ucMock.EXPECT().SetTransactionLimits(args.ctx, params).Return(nil, errNotFound)
 
 			return test{
 				fields: fields{
 					uc: ucMock,
 				},
 				args:    args,
-				wantErr: errors.New("error"),
+				wantErr: status.Error(codes.NotFound, errNotFound.Error()),
 			}
 		},
 	}
@@ -313,9 +2661,107 @@ func TestBTCServer_GetUserBalance(t *testing.T) {
 
 			sut := sut(tt.fields)
 
-			got, err := sut.GetUserBalance(tt.args.ctx, tt.args.req)
+			got, err := sut.SetTransactionLimits(tt.args.ctx, tt.args.req)
 			assert.Equal(t, tt.want, got)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
 }
+
+func TestBTCServer_CreateTransaction_LimitExceeded(t *testing.T) {
+	type test struct {
+		err      *repository.LimitError
+		wantCode codes.Code
+		wantInfo *errdetails.ErrorInfo
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given debit exceeds the max amount, When UC returns the limit error, Return FailedPrecondition error with the limit": func(t *testing.T) test {
+			return test{
+				err: &repository.LimitError{
+					Limit:  repository.LimitMaxAmount,
+					UserID: 1,
+					Asset:  "BTC",
+					Value:  5_000_000_000,
+				},
+				wantCode: codes.FailedPrecondition,
+				wantInfo: &errdetails.ErrorInfo{
+					Reason: "MAX_AMOUNT_SATS_EXCEEDED",
+					Domain: "btc.moemoe89.github.com",
+					Metadata: map[string]string{
+						"limit":   "max_amount_sats",
+						"user_id": "1",
+						"asset":   "BTC",
+						"value":   "5000000000",
+					},
+				},
+			}
+		},
+		"Given debit exceeds the max daily outflow, When UC returns the limit error, Return ResourceExhausted error with the limit": func(t *testing.T) test {
+			return test{
+				err: &repository.LimitError{
+					Limit:  repository.LimitMaxDailyOutflow,
+					UserID: 1,
+					Asset:  "BTC",
+					Value:  5_000_000_000,
+				},
+				wantCode: codes.ResourceExhausted,
+				wantInfo: &errdetails.ErrorInfo{
+					Reason: "MAX_DAILY_OUTFLOW_SATS_EXCEEDED",
+					Domain: "btc.moemoe89.github.com",
+					Metadata: map[string]string{
+						"limit":   "max_daily_outflow_sats",
+						"user_id": "1",
+						"asset":   "BTC",
+						"value":   "5000000000",
+					},
+				},
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t)
+
+			req := &rpc.CreateTransactionRequest{
+				UserId: 1,
+				Datetime: &timestamppb.Timestamp{
+					Seconds: 1676169338,
+					Nanos:   0,
+				},
+				Amount: -100,
+			}
+
+			params := &repository.CreateTransactionParams{
+				UserID:     req.UserId,
+				Datetime:   req.Datetime.AsTime(),
+				AmountSats: -10_000_000_000,
+				Asset:      "BTC",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateTransaction(context.Background(), params).Return(nil, tt.err)
+
+			sut := sut(fields{uc: ucMock})
+
+			got, err := sut.CreateTransaction(context.Background(), req)
+			assert.Nil(t, got)
+
+			st, ok := status.FromError(err)
+			if !assert.True(t, ok) {
+				return
+			}
+
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.Equal(t, tt.err.Error(), st.Message())
+
+			if assert.Len(t, st.Details(), 1) {
+				assert.True(t, proto.Equal(tt.wantInfo, st.Details()[0].(*errdetails.ErrorInfo)))
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package grpchandler
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError converts the known domain errors into gRPC status error.
+// The other errors are returned as is.
+func toStatusError(err error) error {
+	var limitErr *repository.LimitError
+	if errors.As(err, &limitErr) {
+		return limitStatusError(limitErr)
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrIdempotencyKeyConflict), errors.Is(err, repository.ErrAlreadyExists),
+		errors.Is(err, repository.ErrAlreadyReversed):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrInvalidPageToken), errors.Is(err, repository.ErrInvalidBucket),
+		errors.Is(err, repository.ErrInvalidAmount), errors.Is(err, repository.ErrInvalidTransfer),
+		errors.Is(err, repository.ErrInvalidBatch), errors.Is(err, repository.ErrInvalidUserStatus),
+		errors.Is(err, repository.ErrInvalidReason), errors.Is(err, repository.ErrInvalidAsset),
+		errors.Is(err, repository.ErrInvalidQuoteCurrency), errors.Is(err, repository.ErrInvalidMetadata),
+		errors.Is(err, repository.ErrInvalidImport), errors.Is(err, repository.ErrInvalidStatement),
+		errors.Is(err, repository.ErrInvalidHold), errors.Is(err, repository.ErrInvalidLimits),
+		errors.Is(err, repository.ErrInvalidSchedule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrInsufficientBalance), errors.Is(err, repository.ErrUserDeactivated),
+		errors.Is(err, repository.ErrInvalidReversal), errors.Is(err, repository.ErrPriceNotFound),
+		errors.Is(err, repository.ErrHoldSettled), errors.Is(err, repository.ErrHoldExpired),
+		errors.Is(err, repository.ErrScheduleFinished):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+// limitDomain is the domain of the error details of the exceeded limits.
+const limitDomain = "btc.moemoe89.github.com"
+
+// limitStatusError converts the exceeded limit into gRPC status error, with the details naming the limit.
+// The max amount can't be passed by retrying the same debit, thus it's FailedPrecondition,
+// while the limits of the windows are ResourceExhausted until the window ends.
+func limitStatusError(err *repository.LimitError) error {
+	code := codes.ResourceExhausted
+	if err.Limit == repository.LimitMaxAmount {
+		code = codes.FailedPrecondition
+	}
+
+	st, errDetails := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: strings.ToUpper(err.Limit) + "_EXCEEDED",
+		Domain: limitDomain,
+		Metadata: map[string]string{
+			"limit":   err.Limit,
+			"user_id": strconv.FormatInt(err.UserID, 10),
+			"asset":   err.Asset,
+			"value":   strconv.FormatInt(err.Value, 10),
+		},
+	})
+	if errDetails != nil {
+		return status.Error(code, err.Error())
+	}
+
+	return st.Err()
+}
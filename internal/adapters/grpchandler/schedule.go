@@ -0,0 +1,124 @@
+package grpchandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+)
+
+// maxScheduleStart is the maximum duration until the first run of a schedule.
+const maxScheduleStart = 365 * 24 * time.Hour
+
+// CreateSchedule creates a transaction of a User that is created by the scheduler at the start_at, once or recurring.
+func (h *btcHandler) CreateSchedule(ctx context.Context, req *rpc.CreateScheduleRequest) (*rpc.Schedule, error) {
+	params, err := requestSchedule(req, time.Now())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	schedule, err := h.uc.CreateSchedule(ctx, params)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return schedule, nil
+}
+
+// ListSchedules get the list of schedules ordered by the created time and the ID.
+// The schedules can be filtered by the User and the status.
+func (h *btcHandler) ListSchedules(ctx context.Context, req *rpc.ListSchedulesRequest) (*rpc.ListSchedulesResponse, error) {
+	list, err := h.uc.ListSchedules(ctx, &repository.ListSchedulesParams{
+		UserID:    req.GetUserId(),
+		Status:    req.GetStatus(),
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return list, nil
+}
+
+// CancelSchedule cancels an active schedule, thus the next runs aren't created.
+// Cancelling an already cancelled schedule returns the schedule as is.
+func (h *btcHandler) CancelSchedule(ctx context.Context, req *rpc.CancelScheduleRequest) (*rpc.Schedule, error) {
+	schedule, err := h.uc.CancelSchedule(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return schedule, nil
+}
+
+// requestSchedule returns the params of the schedule with the asset and the interval,
+// the start should be after the now.
+func requestSchedule(req *rpc.CreateScheduleRequest, now time.Time) (*repository.CreateScheduleParams, error) {
+	a, err := requestAsset(req.GetAsset())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetAmountSats() == 0 {
+		return nil, fmt.Errorf("%w: amount_sats should not be 0", repository.ErrInvalidAmount)
+	}
+
+	// The runs are created by CreateTransaction, thus the metadata is validated the same way.
+	err = validateMetadata(&rpc.CreateTransactionRequest{
+		Type:        req.GetType(),
+		Description: req.GetDescription(),
+		Labels:      req.GetLabels(),
+	}, req.GetAmountSats())
+	if err != nil {
+		return nil, err
+	}
+
+	interval := req.GetInterval()
+
+	switch interval {
+	case rpc.ScheduleInterval_SCHEDULE_INTERVAL_UNSPECIFIED:
+		interval = rpc.ScheduleInterval_SCHEDULE_INTERVAL_ONCE
+	case rpc.ScheduleInterval_SCHEDULE_INTERVAL_ONCE, rpc.ScheduleInterval_SCHEDULE_INTERVAL_DAILY,
+		rpc.ScheduleInterval_SCHEDULE_INTERVAL_WEEKLY, rpc.ScheduleInterval_SCHEDULE_INTERVAL_MONTHLY:
+	default:
+		return nil, fmt.Errorf("%w: interval: %s is unknown", repository.ErrInvalidSchedule, interval)
+	}
+
+	if req.GetStartAt() == nil {
+		return nil, fmt.Errorf("%w: start_at is required", repository.ErrInvalidSchedule)
+	}
+
+	startAt := req.GetStartAt().AsTime()
+
+	if !startAt.After(now) || startAt.Sub(now) > maxScheduleStart {
+		return nil, fmt.Errorf("%w: start_at should be in the future and at most a year ahead", repository.ErrInvalidSchedule)
+	}
+
+	params := &repository.CreateScheduleParams{
+		UserID:      req.GetUserId(),
+		AmountSats:  req.GetAmountSats(),
+		Interval:    interval,
+		StartAt:     startAt,
+		Asset:       a.Code,
+		Type:        req.GetType(),
+		Description: req.GetDescription(),
+		Labels:      req.GetLabels(),
+	}
+
+	if req.GetEndAt() != nil {
+		if interval == rpc.ScheduleInterval_SCHEDULE_INTERVAL_ONCE {
+			return nil, fmt.Errorf("%w: end_at is only supported by the recurring schedule", repository.ErrInvalidSchedule)
+		}
+
+		params.EndAt = req.GetEndAt().AsTime()
+
+		if !params.EndAt.After(startAt) {
+			return nil, fmt.Errorf("%w: end_at should be after the start_at", repository.ErrInvalidSchedule)
+		}
+	}
+
+	return params, nil
+}
@@ -0,0 +1,250 @@
+package grpchandler_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/usecases"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBTCServer_CreateSchedule(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.CreateScheduleRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.Schedule
+		wantErr error
+	}
+
+	startAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of CreateSchedule without interval, When UC executed successfully, Return the schedule run once": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateScheduleRequest{
+					UserId:      1,
+					AmountSats:  1_000_000,
+					Type:        rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+					Description: "savings",
+					StartAt:     timestamppb.New(startAt),
+				},
+			}
+
+			want := &rpc.Schedule{
+				Id:          "6b7c8d9e-0000-4000-8000-000000000001",
+				UserId:      1,
+				Asset:       "BTC",
+				AmountSats:  1_000_000,
+				Type:        rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+				Description: "savings",
+				Interval:    rpc.ScheduleInterval_SCHEDULE_INTERVAL_ONCE,
+				StartAt:     args.req.StartAt,
+				NextRunAt:   args.req.StartAt,
+				Status:      rpc.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+			}
+
+			params := &repository.CreateScheduleParams{
+				UserID:      1,
+				AmountSats:  1_000_000,
+				Interval:    rpc.ScheduleInterval_SCHEDULE_INTERVAL_ONCE,
+				StartAt:     startAt,
+				Asset:       "BTC",
+				Type:        rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+				Description: "savings",
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateSchedule(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given request of CreateSchedule with start_at in the past, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateScheduleRequest{
+					UserId:     1,
+					AmountSats: 1_000_000,
+					Interval:   rpc.ScheduleInterval_SCHEDULE_INTERVAL_WEEKLY,
+					StartAt:    timestamppb.New(time.Now().Add(-time.Minute)),
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args,
+				wantErr: status.Error(codes.InvalidArgument,
+					"invalid schedule: start_at should be in the future and at most a year ahead"),
+			}
+		},
+		"Given request of CreateSchedule with end_at before start_at, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateScheduleRequest{
+					UserId:     1,
+					AmountSats: 1_000_000,
+					Interval:   rpc.ScheduleInterval_SCHEDULE_INTERVAL_WEEKLY,
+					StartAt:    timestamppb.New(startAt),
+					EndAt:      timestamppb.New(startAt.Add(-time.Second)),
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, "invalid schedule: end_at should be after the start_at"),
+			}
+		},
+		"Given request of CreateSchedule of a deposit with negative amount, When validated, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateScheduleRequest{
+					UserId:     1,
+					AmountSats: -1_000_000,
+					Type:       rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+					StartAt:    timestamppb.New(startAt),
+				},
+			}
+
+			return test{
+				fields: fields{
+					uc: usecases.NewGoMockBTCUsecase(ctrl),
+				},
+				args: args,
+				wantErr: status.Error(codes.InvalidArgument,
+					"invalid transaction metadata: the amount of a deposit should be positive"),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.CreateSchedule(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_CancelSchedule(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.CancelScheduleRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.Schedule
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of CancelSchedule, When UC executed successfully, Return the cancelled schedule": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CancelScheduleRequest{
+					Id: "6b7c8d9e-0000-4000-8000-000000000001",
+				},
+			}
+
+			want := &rpc.Schedule{
+				Id:     args.req.Id,
+				UserId: 1,
+				Status: rpc.ScheduleStatus_SCHEDULE_STATUS_CANCELLED,
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CancelSchedule(args.ctx, args.req.Id).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of CancelSchedule, When UC returns schedule finished error, Return FailedPrecondition error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CancelScheduleRequest{
+					Id: "6b7c8d9e-0000-4000-8000-000000000001",
+				},
+			}
+
+			errFinished := fmt.Errorf("schedule id: %s is completed: %w", args.req.Id, repository.ErrScheduleFinished)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CancelSchedule(args.ctx, args.req.Id).Return(nil, errFinished)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.FailedPrecondition, errFinished.Error()),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.CancelSchedule(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
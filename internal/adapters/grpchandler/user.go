@@ -0,0 +1,57 @@
+package grpchandler
+
+import (
+	"context"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+)
+
+// CreateUser creates a new User.
+// The User is active once created.
+func (h *btcHandler) CreateUser(ctx context.Context, req *rpc.CreateUserRequest) (*rpc.User, error) {
+	user, err := h.uc.CreateUser(ctx, &repository.CreateUserParams{
+		ExternalRef: req.GetExternalRef(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return user, nil
+}
+
+// GetUser get a single User by the ID.
+func (h *btcHandler) GetUser(ctx context.Context, req *rpc.GetUserRequest) (*rpc.User, error) {
+	user, err := h.uc.GetUser(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return user, nil
+}
+
+// ListUsers get the list of Users ordered by the ID.
+// The Users can be filtered by the status.
+func (h *btcHandler) ListUsers(ctx context.Context, req *rpc.ListUsersRequest) (*rpc.ListUsersResponse, error) {
+	list, err := h.uc.ListUsers(ctx, &repository.ListUsersParams{
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+		Status:    req.GetStatus(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return list, nil
+}
+
+// DeactivateUser deactivates a User, thus the User can't create transactions anymore.
+// Deactivating an already deactivated User returns the User as is.
+func (h *btcHandler) DeactivateUser(ctx context.Context, req *rpc.DeactivateUserRequest) (*rpc.User, error) {
+	user, err := h.uc.DeactivateUser(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,375 @@
+package grpchandler_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/usecases"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBTCServer_CreateUser(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.CreateUserRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.User
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of CreateUser, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateUserRequest{
+					ExternalRef: "ref-1",
+				},
+			}
+
+			want := &rpc.User{
+				Id:          6,
+				ExternalRef: args.req.ExternalRef,
+				Status:      rpc.UserStatus_USER_STATUS_ACTIVE,
+				CreatedAt: &timestamppb.Timestamp{
+					Seconds: 1676169338,
+					Nanos:   0,
+				},
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateUser(args.ctx, &repository.CreateUserParams{ExternalRef: "ref-1"}).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of CreateUser with used external ref, When UC returns already exists error, Return AlreadyExists error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.CreateUserRequest{
+					ExternalRef: "ref-1",
+				},
+			}
+
+			errExists := fmt.Errorf("external ref: %s: %w", args.req.ExternalRef, repository.ErrAlreadyExists)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().CreateUser(args.ctx, &repository.CreateUserParams{ExternalRef: "ref-1"}).Return(nil, errExists)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.AlreadyExists, errExists.Error()),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.CreateUser(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_GetUser(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.GetUserRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.User
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of GetUser, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetUserRequest{
+					Id: 1,
+				},
+			}
+
+			want := &rpc.User{
+				Id:     1,
+				Status: rpc.UserStatus_USER_STATUS_ACTIVE,
+				CreatedAt: &timestamppb.Timestamp{
+					Seconds: 1676169338,
+					Nanos:   0,
+				},
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetUser(args.ctx, args.req.GetId()).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of GetUser, When UC returns not found error, Return NotFound error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.GetUserRequest{
+					Id: 999,
+				},
+			}
+
+			errNotFound := fmt.Errorf("user id: %d not found: %w", args.req.Id, repository.ErrNotFound)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().GetUser(args.ctx, args.req.GetId()).Return(nil, errNotFound)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.NotFound, errNotFound.Error()),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.GetUser(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_ListUsers(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.ListUsersRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.ListUsersResponse
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of ListUsers, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.ListUsersRequest{
+					PageSize:  1,
+					PageToken: "MQ",
+					Status:    rpc.UserStatus_USER_STATUS_ACTIVE,
+				},
+			}
+
+			want := &rpc.ListUsersResponse{
+				Users: []*rpc.User{
+					{
+						Id:     2,
+						Status: rpc.UserStatus_USER_STATUS_ACTIVE,
+					},
+				},
+				NextPageToken: "Mg",
+			}
+
+			params := &repository.ListUsersParams{
+				PageSize:  1,
+				PageToken: "MQ",
+				Status:    rpc.UserStatus_USER_STATUS_ACTIVE,
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ListUsers(args.ctx, params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given request of ListUsers with invalid page token, When UC returns invalid page token error, Return InvalidArgument error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.ListUsersRequest{
+					PageToken: "invalid",
+				},
+			}
+
+			errInvalid := fmt.Errorf("page token: %s: %w", args.req.PageToken, repository.ErrInvalidPageToken)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().ListUsers(args.ctx, &repository.ListUsersParams{PageToken: "invalid"}).Return(nil, errInvalid)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.InvalidArgument, errInvalid.Error()),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.ListUsers(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestBTCServer_DeactivateUser(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *rpc.DeactivateUserRequest
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.User
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of DeactivateUser, When UC executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.DeactivateUserRequest{
+					Id: 1,
+				},
+			}
+
+			want := &rpc.User{
+				Id:     1,
+				Status: rpc.UserStatus_USER_STATUS_DEACTIVATED,
+				CreatedAt: &timestamppb.Timestamp{
+					Seconds: 1676169338,
+					Nanos:   0,
+				},
+				DeactivatedAt: &timestamppb.Timestamp{
+					Seconds: 1676169400,
+					Nanos:   0,
+				},
+			}
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().DeactivateUser(args.ctx, args.req.GetId()).Return(want, nil)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of DeactivateUser, When UC returns not found error, Return NotFound error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				req: &rpc.DeactivateUserRequest{
+					Id: 999,
+				},
+			}
+
+			errNotFound := fmt.Errorf("user id: %d not found: %w", args.req.Id, repository.ErrNotFound)
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().DeactivateUser(args.ctx, args.req.GetId()).Return(nil, errNotFound)
+
+			return test{
+				fields: fields{
+					uc: ucMock,
+				},
+				args:    args,
+				wantErr: status.Error(codes.NotFound, errNotFound.Error()),
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.DeactivateUser(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Package httphandler is the HTTP handlers of the gateway, which can't be generated from the proto annotations.
+package httphandler
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/pkg/asset"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// exportTransactionsPath is the path of the transactions export download.
+	exportTransactionsPath = "/v1/transactions/export"
+	// exportTransactionsMethod is the full name of the RPC behind the download.
+	exportTransactionsMethod = "/BTCService/ExportTransactions"
+)
+
+const (
+	// formatCSV is the text/csv download with a header row.
+	formatCSV = "csv"
+	// formatNDJSON is the newline-delimited JSON download, a JSON object of the transaction per line.
+	formatNDJSON = "ndjson"
+)
+
+// contentTypes is the content type of each format.
+var contentTypes = map[string]string{
+	formatCSV:    "text/csv",
+	formatNDJSON: "application/x-ndjson",
+}
+
+// exportFilter leaves the format out of the query parameters of the request.
+var exportFilter = utilities.NewDoubleArray([][]string{{"format"}})
+
+// csvHeader is the header row of the CSV download, in the same order as the csvRecord.
+var csvHeader = []string{
+	"id", "datetime", "user_id", "asset", "amount", "amount_sats", "type",
+	"description", "external_ref", "transfer_id", "reversed_id", "reversal_reason", "labels",
+}
+
+// RegisterExportTransactions registers the download of ExportTransactions on GET /v1/transactions/export.
+// The query parameters are the fields of the request, the format is csv or ndjson, defaults by the Accept header.
+func RegisterExportTransactions(mux *runtime.ServeMux, client rpc.BTCServiceClient) error {
+	return mux.HandlePath(http.MethodGet, exportTransactionsPath, exportTransactions(mux, client))
+}
+
+// exportTransactions writes each received transaction into the response as soon as it arrives,
+// thus the memory is constant for any size of the download.
+func exportTransactions(mux *runtime.ServeMux, client rpc.BTCServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(ctx, mux, r, exportTransactionsMethod, runtime.WithHTTPPathPattern(exportTransactionsPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		format, err := exportFormat(r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		req := new(rpc.ExportTransactionsRequest)
+
+		if err = runtime.PopulateQueryParameters(req, r.URL.Query(), exportFilter); err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		stream, err := client.ExportTransactions(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		// The first transaction is received before the headers are written,
+		// thus the rejected request is still reported with the status code of the error.
+		transaction, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentTypes[format])
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="transactions.%s"`, format))
+
+		var (
+			write func(*rpc.Transaction) error
+			flush = func() error { return nil }
+		)
+
+		if format == formatCSV {
+			cw := csv.NewWriter(w)
+
+			if werr := cw.Write(csvHeader); werr != nil {
+				panic(http.ErrAbortHandler)
+			}
+
+			write = func(transaction *rpc.Transaction) error {
+				return cw.Write(csvRecord(transaction))
+			}
+
+			flush = func() error {
+				cw.Flush()
+
+				return cw.Error()
+			}
+		} else {
+			write = func(transaction *rpc.Transaction) error {
+				b, err := outbound.Marshal(transaction)
+				if err != nil {
+					return err
+				}
+
+				_, err = w.Write(append(b, '\n'))
+
+				return err
+			}
+		}
+
+		for ; err == nil; transaction, err = stream.Recv() {
+			if err = write(transaction); err != nil {
+				break
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			err = flush()
+		}
+
+		// The status code is already sent, thus the failed download is aborted without the end of the response,
+		// so the client doesn't mistake the partial download as a complete one.
+		if err != nil {
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
+
+// exportFormat returns the format of the download, from the format query parameter or the Accept header.
+// Defaults to ndjson.
+func exportFormat(r *http.Request) (string, error) {
+	format := r.URL.Query().Get("format")
+
+	switch format {
+	case formatCSV, formatNDJSON:
+		return format, nil
+	case "":
+		if strings.Contains(r.Header.Get("Accept"), contentTypes[formatCSV]) {
+			return formatCSV, nil
+		}
+
+		return formatNDJSON, nil
+	default:
+		return "", fmt.Errorf("format: %s should be %s or %s", format, formatCSV, formatNDJSON)
+	}
+}
+
+// csvRecord converts the transaction into a row of the CSV download.
+// The amount is the exact decimal of the amount_sats, and the labels are a JSON object.
+func csvRecord(transaction *rpc.Transaction) []string {
+	a, _ := asset.Lookup(transaction.GetAsset())
+
+	var labels string
+
+	if len(transaction.GetLabels()) > 0 {
+		b, _ := json.Marshal(transaction.GetLabels())
+		labels = string(b)
+	}
+
+	return []string{
+		transaction.GetId(),
+		transaction.GetDatetime().AsTime().Format(time.RFC3339Nano),
+		strconv.FormatInt(transaction.GetUserId(), 10),
+		a.Code,
+		a.FormatUnits(transaction.GetAmountSats()),
+		strconv.FormatInt(transaction.GetAmountSats(), 10),
+		transaction.GetType().String(),
+		transaction.GetDescription(),
+		transaction.GetExternalRef(),
+		transaction.GetTransferId(),
+		transaction.GetReversedId(),
+		transaction.GetReversalReason(),
+		labels,
+	}
+}
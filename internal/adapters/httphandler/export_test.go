@@ -0,0 +1,228 @@
+package httphandler_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/adapters/httphandler"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// exportTransactionsClient is a fake client of ExportTransactions, which records the request.
+type exportTransactionsClient struct {
+	rpc.BTCServiceClient
+	req          *rpc.ExportTransactionsRequest
+	transactions []*rpc.Transaction
+	err          error
+}
+
+func (c *exportTransactionsClient) ExportTransactions(
+	_ context.Context, req *rpc.ExportTransactionsRequest, _ ...grpc.CallOption,
+) (rpc.BTCService_ExportTransactionsClient, error) {
+	c.req = req
+
+	return &exportTransactionsStream{transactions: c.transactions, err: c.err}, nil
+}
+
+// exportTransactionsStream is a fake client stream of ExportTransactions,
+// which receives the transactions and then the error, or io.EOF if the error is nil.
+type exportTransactionsStream struct {
+	grpc.ClientStream
+	transactions []*rpc.Transaction
+	err          error
+}
+
+func (s *exportTransactionsStream) Recv() (*rpc.Transaction, error) {
+	if len(s.transactions) > 0 {
+		transaction := s.transactions[0]
+		s.transactions = s.transactions[1:]
+
+		return transaction, nil
+	}
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return nil, io.EOF
+}
+
+func TestExportTransactions(t *testing.T) {
+	type args struct {
+		url    string
+		accept string
+	}
+
+	type test struct {
+		client          *exportTransactionsClient
+		args            args
+		wantReq         *rpc.ExportTransactionsRequest
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+		wantAborted     bool
+	}
+
+	datetime := time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+
+	transactions := []*rpc.Transaction{
+		{
+			Id:         "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+			UserId:     1,
+			Datetime:   timestamppb.New(datetime),
+			Amount:     100.5,
+			AmountSats: 10_050_000_000,
+			Asset:      "BTC",
+			Type:       rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+			Labels:     map[string]string{"channel": "mobile"},
+		},
+		{
+			Id:          "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3b",
+			UserId:      1,
+			Datetime:    timestamppb.New(datetime.Add(time.Hour)),
+			Amount:      -0.000000001,
+			AmountSats:  -1,
+			Asset:       "ETH",
+			Description: "gas, refunded",
+		},
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid request of CSV format, When the transactions are received, Return the CSV download": func(t *testing.T) test {
+			return test{
+				client: &exportTransactionsClient{transactions: transactions},
+				args: args{
+					url: "/v1/transactions/export?format=csv&user_id=1&start_datetime=2023-02-12T00:00:00Z",
+				},
+				wantReq: &rpc.ExportTransactionsRequest{
+					UserId:        1,
+					StartDatetime: timestamppb.New(datetime),
+				},
+				wantStatus:      http.StatusOK,
+				wantContentType: "text/csv",
+				wantBody: "id,datetime,user_id,asset,amount,amount_sats,type,description,external_ref,transfer_id,reversed_id,reversal_reason,labels\n" +
+					`0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a,2023-02-12T00:00:00Z,1,BTC,100.5,10050000000,TRANSACTION_TYPE_DEPOSIT,,,,,,"{""channel"":""mobile""}"` + "\n" +
+					`0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3b,2023-02-12T01:00:00Z,1,ETH,-0.000000001,-1,TRANSACTION_TYPE_UNSPECIFIED,"gas, refunded",,,,,` + "\n",
+			}
+		},
+		"Given valid request accepts CSV, When no transactions are received, Return the CSV download with the header only": func(t *testing.T) test {
+			return test{
+				client: &exportTransactionsClient{},
+				args: args{
+					url:    "/v1/transactions/export?asset=ETH&type=TRANSACTION_TYPE_FEE",
+					accept: "text/csv",
+				},
+				wantReq: &rpc.ExportTransactionsRequest{
+					Asset: "ETH",
+					Type:  rpc.TransactionType_TRANSACTION_TYPE_FEE,
+				},
+				wantStatus:      http.StatusOK,
+				wantContentType: "text/csv",
+				wantBody:        "id,datetime,user_id,asset,amount,amount_sats,type,description,external_ref,transfer_id,reversed_id,reversal_reason,labels\n",
+			}
+		},
+		"Given valid request without format, When the transactions are received, Return the NDJSON download": func(t *testing.T) test {
+			return test{
+				client: &exportTransactionsClient{transactions: transactions[1:]},
+				args: args{
+					url: "/v1/transactions/export?external_ref=bank-1",
+				},
+				wantReq: &rpc.ExportTransactionsRequest{
+					ExternalRef: "bank-1",
+				},
+				wantStatus:      http.StatusOK,
+				wantContentType: "application/x-ndjson",
+				wantBody: `{"userId":"1","datetime":"2023-02-12T01:00:00Z","amount":-1e-09,"id":"0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3b",` +
+					`"amountSats":"-1","transferId":"","reversedId":"","reversalReason":"","asset":"ETH","quote":null,` +
+					`"type":"TRANSACTION_TYPE_UNSPECIFIED","description":"gas, refunded","externalRef":"","labels":{}}` + "\n",
+			}
+		},
+		"Given invalid format, When the request is parsed, Return bad request": func(t *testing.T) test {
+			return test{
+				client: &exportTransactionsClient{},
+				args: args{
+					url: "/v1/transactions/export?format=xml",
+				},
+				wantReq:         nil,
+				wantStatus:      http.StatusBadRequest,
+				wantContentType: "application/json",
+				wantBody:        `{"code":3,"message":"format: xml should be csv or ndjson","details":[]}`,
+			}
+		},
+		"Given valid request, When the stream fails before the first transaction, Return the status of the error": func(t *testing.T) test {
+			return test{
+				client: &exportTransactionsClient{err: status.Error(codes.InvalidArgument, "invalid asset")},
+				args: args{
+					url: "/v1/transactions/export?format=csv&asset=DOGE",
+				},
+				wantReq: &rpc.ExportTransactionsRequest{
+					Asset: "DOGE",
+				},
+				wantStatus:      http.StatusBadRequest,
+				wantContentType: "application/json",
+				wantBody:        `{"code":3,"message":"invalid asset","details":[]}`,
+			}
+		},
+		"Given valid request, When the stream fails after the first transaction, Return the aborted download": func(t *testing.T) test {
+			return test{
+				client: &exportTransactionsClient{transactions: transactions[:1], err: errors.New("connection reset")},
+				args: args{
+					url: "/v1/transactions/export?format=csv",
+				},
+				wantReq:     &rpc.ExportTransactionsRequest{},
+				wantAborted: true,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			mux := runtime.NewServeMux()
+
+			err := httphandler.RegisterExportTransactions(mux, tt.client)
+			assert.NoError(t, err)
+
+			r := httptest.NewRequest(http.MethodGet, tt.args.url, nil)
+			if tt.args.accept != "" {
+				r.Header.Set("Accept", tt.args.accept)
+			}
+
+			w := httptest.NewRecorder()
+
+			if tt.wantAborted {
+				assert.PanicsWithValue(t, http.ErrAbortHandler, func() { mux.ServeHTTP(w, r) })
+				assert.True(t, proto.Equal(tt.wantReq, tt.client.req))
+
+				return
+			}
+
+			mux.ServeHTTP(w, r)
+
+			assert.True(t, proto.Equal(tt.wantReq, tt.client.req))
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantContentType, w.Header().Get("Content-Type"))
+
+			// The spacing of the JSON is randomized by protojson, thus only the CSV is compared as is.
+			if tt.wantContentType == "text/csv" {
+				assert.Equal(t, tt.wantBody, w.Body.String())
+			} else {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/moemoe89/btc/internal/usecases"
+	"github.com/moemoe89/btc/pkg/logging"
+
+	"go.uber.org/zap"
+)
+
+// Relay publishes the outbox events in the background of the service, every interval until it's stopped.
+// Many relays can run at the same time, e.g. a relay in each replica, since the events are published by a single relay at a time.
+type Relay struct {
+	uc       usecases.BTCUsecase
+	logger   logging.Logger
+	interval time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewRelay returns a new Relay of the outbox events that runs every interval.
+func NewRelay(uc usecases.BTCUsecase, logger logging.Logger, interval time.Duration) *Relay {
+	return &Relay{
+		uc:       uc,
+		logger:   logger,
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Run keeps publishing the outbox events every interval until GracefulStop is called.
+func (r *Relay) Run() error {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.relay()
+
+		select {
+		case <-r.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay publishes the outbox events until there are no more published events, thus a backlog is drained in a single tick.
+// The event that failed to publish is retried by the next tick, together with the following events.
+func (r *Relay) relay() {
+	for {
+		select {
+		case <-r.stop:
+			return
+		default:
+		}
+
+		n, err := r.uc.RelayOutboxEvents(context.Background())
+		if err != nil {
+			r.logger.Error("failed relays outbox events", zap.Int("published", n), zap.Error(err))
+			return
+		}
+
+		if n == 0 {
+			return
+		}
+	}
+}
+
+// GracefulStop stops the Relay, and waits until the publishing events are finished.
+func (r *Relay) GracefulStop() {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+
+	<-r.done
+}
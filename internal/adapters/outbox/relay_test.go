@@ -0,0 +1,75 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/moemoe89/btc/internal/adapters/outbox"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/usecases"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelay_Run(t *testing.T) {
+	type test struct {
+		uc usecases.BTCUsecase
+		// drained is closed by the last expected relay of the first tick.
+		drained chan struct{}
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given a backlog of outbox events, When the relay runs, Return after the backlog is drained and the relay is stopped": func(t *testing.T, ctrl *gomock.Controller) test {
+			drained := make(chan struct{})
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			gomock.InOrder(
+				ucMock.EXPECT().RelayOutboxEvents(gomock.Any()).Return(100, nil),
+				ucMock.EXPECT().RelayOutboxEvents(gomock.Any()).Return(7, nil),
+				ucMock.EXPECT().RelayOutboxEvents(gomock.Any()).DoAndReturn(func(context.Context) (int, error) {
+					close(drained)
+					return 0, nil
+				}),
+			)
+
+			return test{uc: ucMock, drained: drained}
+		},
+		"Given the outbox events, When the publish failed, Return after the relay is stopped": func(t *testing.T, ctrl *gomock.Controller) test {
+			drained := make(chan struct{})
+
+			ucMock := usecases.NewGoMockBTCUsecase(ctrl)
+			ucMock.EXPECT().RelayOutboxEvents(gomock.Any()).DoAndReturn(func(context.Context) (int, error) {
+				close(drained)
+				return 2, errors.New("error")
+			})
+
+			return test{uc: ucMock, drained: drained}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			// The interval is longer than the test, thus only the first tick runs.
+			sut := outbox.NewRelay(tt.uc, di.GetLogger(), time.Hour)
+
+			done := make(chan error, 1)
+
+			go func() {
+				done <- sut.Run()
+			}()
+
+			<-tt.drained
+			sut.GracefulStop()
+
+			assert.NoError(t, <-done)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/moemoe89/btc/internal/usecases"
+	"github.com/moemoe89/btc/pkg/logging"
+
+	"go.uber.org/zap"
+)
+
+// Worker runs the due schedules in the background of the service, every interval until it's stopped.
+// Many workers can run at the same time, e.g. a worker in each replica, since each run is claimed by a single worker.
+type Worker struct {
+	uc       usecases.BTCUsecase
+	logger   logging.Logger
+	interval time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewWorker returns a new Worker of the schedules that runs every interval.
+func NewWorker(uc usecases.BTCUsecase, logger logging.Logger, interval time.Duration) *Worker {
+	return &Worker{
+		uc:       uc,
+		logger:   logger,
+		interval: interval,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Run keeps running the due schedules every interval until GracefulStop is called.
+func (w *Worker) Run() error {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.runDue()
+
+		select {
+		case <-w.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// runDue runs the due schedules until there are no more claimed schedules, thus a backlog is drained in a single tick.
+// The run that failed temporarily stays claimed until the lease ends, thus it isn't claimed again by the same tick.
+func (w *Worker) runDue() {
+	for {
+		select {
+		case <-w.stop:
+			return
+		default:
+		}
+
+		n, err := w.uc.RunDueSchedules(context.Background())
+		if err != nil {
+			w.logger.Error("failed runs due schedules", zap.Error(err))
+			return
+		}
+
+		if n == 0 {
+			return
+		}
+	}
+}
+
+// GracefulStop stops the Worker, and waits until the running schedules are finished.
+func (w *Worker) GracefulStop() {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+
+	<-w.done
+}
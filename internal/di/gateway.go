@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/adapters/httphandler"
 	"github.com/moemoe89/btc/pkg/di"
 	"github.com/moemoe89/btc/pkg/server"
 
@@ -18,8 +19,9 @@ import (
 )
 
 type httpServer struct {
-	mux *runtime.ServeMux
-	srv *http.Server
+	mux  *runtime.ServeMux
+	srv  *http.Server
+	conn *grpc.ClientConn
 }
 
 func cors(h http.Handler) http.Handler {
@@ -57,18 +59,27 @@ func GetBTCGatewayServer() server.Server {
 }
 
 func (h *httpServer) Run() error {
-	err := rpc.RegisterBTCServiceHandlerFromEndpoint(
-		context.Background(),
-		h.mux,
+	conn, err := grpc.Dial(
 		"0.0.0.0:"+os.Getenv("SERVER_PORT"),
-		[]grpc.DialOption{
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		},
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	h.conn = conn
+
+	err = rpc.RegisterBTCServiceHandler(context.Background(), h.mux, conn)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The export is served as a download instead of the generated JSON stream, thus it's registered by hand.
+	err = httphandler.RegisterExportTransactions(h.mux, rpc.NewBTCServiceClient(conn))
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	errorChan := make(chan error)
 
 	go func() {
@@ -84,4 +95,5 @@ func (h *httpServer) Run() error {
 
 func (h *httpServer) GracefulStop() {
 	_ = h.srv.Shutdown(context.Background())
+	_ = h.conn.Close()
 }
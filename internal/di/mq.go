@@ -0,0 +1,22 @@
+package di
+
+import (
+	"log"
+	"os"
+
+	"github.com/moemoe89/btc/pkg/di"
+	"github.com/moemoe89/btc/pkg/mq"
+	"github.com/moemoe89/btc/pkg/mq/rabbitmq"
+)
+
+// GetPublisher get the RabbitMQ publisher of the events.
+func GetPublisher() mq.Publisher {
+	p, err := rabbitmq.New(rabbitmq.WithURL(os.Getenv("RABBITMQ_HOST")))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	di.RegisterCloser("RabbitMQPublisher", p)
+
+	return p
+}
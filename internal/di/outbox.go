@@ -0,0 +1,48 @@
+package di
+
+import (
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/moemoe89/btc/internal/adapters/outbox"
+	"github.com/moemoe89/btc/pkg/di"
+	"github.com/moemoe89/btc/pkg/server"
+)
+
+// defaultOutboxInterval is the interval of the outbox relay without the OUTBOX_INTERVAL.
+const defaultOutboxInterval = time.Second
+
+var (
+	outboxOnce  sync.Once
+	outboxRelay server.Server
+)
+
+// GetBTCOutboxRelay returns the relay of the outbox events for BTC service.
+func GetBTCOutboxRelay() server.Server {
+	outboxOnce.Do(func() {
+		interval := defaultOutboxInterval
+
+		if v := os.Getenv("OUTBOX_INTERVAL"); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				log.Fatalf("invalid OUTBOX_INTERVAL %q: %v", v, err)
+			}
+
+			if d <= 0 {
+				log.Fatalf("invalid OUTBOX_INTERVAL %q: should be positive", v)
+			}
+
+			interval = d
+		}
+
+		r := outbox.NewRelay(GetBTCUsecase(), GetLogger(), interval)
+
+		di.RegisterCloser("OutboxRelay", di.NewCloser(r.GracefulStop))
+
+		outboxRelay = r
+	})
+
+	return outboxRelay
+}
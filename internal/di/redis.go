@@ -7,6 +7,8 @@ import (
 	"github.com/moemoe89/btc/pkg/di"
 	"github.com/moemoe89/btc/pkg/kvs"
 	"github.com/moemoe89/btc/pkg/kvs/redis"
+	"github.com/moemoe89/btc/pkg/pubsub"
+	pubsubRedis "github.com/moemoe89/btc/pkg/pubsub/redis"
 )
 
 // GetRedis get the Redis KVS client.
@@ -20,3 +22,15 @@ func GetRedis() kvs.Client {
 
 	return r
 }
+
+// GetPubSub get the Redis pub/sub client.
+func GetPubSub() pubsub.Client {
+	r, err := pubsubRedis.New(pubsubRedis.WithAddr(os.Getenv("REDIS_HOST")))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	di.RegisterCloser("RedisPubSubConnection", r)
+
+	return r
+}
@@ -14,3 +14,13 @@ func GetBaseRepo() *datastore.BaseRepo {
 func GetBTCRepo() repository.BTCRepo {
 	return datastore.NewBTCRepo(GetBaseRepo())
 }
+
+// GetPriceSource returns PriceSource instance.
+func GetPriceSource() repository.PriceSource {
+	return datastore.NewPriceRepo(GetBaseRepo())
+}
+
+// GetReconcileRepo returns ReconcileRepo instance.
+func GetReconcileRepo() repository.ReconcileRepo {
+	return datastore.NewReconcileRepo(GetBaseRepo())
+}
@@ -0,0 +1,48 @@
+package di
+
+import (
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/moemoe89/btc/internal/adapters/scheduler"
+	"github.com/moemoe89/btc/pkg/di"
+	"github.com/moemoe89/btc/pkg/server"
+)
+
+// defaultSchedulerInterval is the interval of the scheduler without the SCHEDULER_INTERVAL.
+const defaultSchedulerInterval = 10 * time.Second
+
+var (
+	schedulerOnce sync.Once
+	btcScheduler  server.Server
+)
+
+// GetBTCScheduler returns the worker of the schedules for BTC service.
+func GetBTCScheduler() server.Server {
+	schedulerOnce.Do(func() {
+		interval := defaultSchedulerInterval
+
+		if v := os.Getenv("SCHEDULER_INTERVAL"); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				log.Fatalf("invalid SCHEDULER_INTERVAL %q: %v", v, err)
+			}
+
+			if d <= 0 {
+				log.Fatalf("invalid SCHEDULER_INTERVAL %q: should be positive", v)
+			}
+
+			interval = d
+		}
+
+		w := scheduler.NewWorker(GetBTCUsecase(), GetLogger(), interval)
+
+		di.RegisterCloser("Scheduler", di.NewCloser(w.GracefulStop))
+
+		btcScheduler = w
+	})
+
+	return btcScheduler
+}
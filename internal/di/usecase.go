@@ -1,13 +1,30 @@
 package di
 
-import "github.com/moemoe89/btc/internal/usecases"
+import (
+	"sync"
+
+	"github.com/moemoe89/btc/internal/usecases"
+)
+
+var (
+	btcUsecaseOnce sync.Once
+	btcUsecase     usecases.BTCUsecase
+)
 
 // GetBTCUsecase returns BTCUsecase instance.
+// The instance is shared by the gRPC handler and the scheduler, thus the connections are only opened once.
 func GetBTCUsecase() usecases.BTCUsecase {
-	return usecases.NewBTCUsecase(
-		GetBTCRepo(),
-		GetTracer().Tracer(),
-		GetLogger(),
-		GetRedis(),
-	)
+	btcUsecaseOnce.Do(func() {
+		btcUsecase = usecases.NewBTCUsecase(
+			GetBTCRepo(),
+			GetPriceSource(),
+			GetTracer().Tracer(),
+			GetLogger(),
+			GetRedis(),
+			GetPubSub(),
+			GetPublisher(),
+		)
+	})
+
+	return btcUsecase
 }
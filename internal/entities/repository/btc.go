@@ -12,9 +12,63 @@ import (
 
 // CreateTransactionParams parameter for creates a BTC transaction.
 type CreateTransactionParams struct {
-	UserID   int64     // required
-	Datetime time.Time // required
-	Amount   float64   // required
+	UserID     int64     // required
+	Datetime   time.Time // required
+	AmountSats int64     // required, the exact amount in the minor unit of the asset
+
+	IdempotencyKey string // optional
+	Asset          string // optional, defaults to BTC
+
+	Type        rpc.TransactionType // optional
+	Description string              // optional
+	ExternalRef string              // optional
+	Labels      map[string]string   // optional
+}
+
+// CreateTransactionsParams parameter for creates many BTC transactions.
+type CreateTransactionsParams struct {
+	Transactions []*CreateTransactionParams // required
+
+	BestEffort bool // optional, defaults to all-or-nothing
+}
+
+// CreateTransactionsResult is the result of an entry of CreateTransactionsParams.
+// Either the Transaction or the Err is set.
+type CreateTransactionsResult struct {
+	Transaction *rpc.Transaction
+	Err         error
+}
+
+// ImportRow is a row of ImportTransactionsParams.
+type ImportRow struct {
+	Sequence    int64                    // required, increasing within the import
+	Transaction *CreateTransactionParams // required, unless the row is already rejected
+
+	// Err is the rejection of the row before reaching the repository, e.g. by the validation.
+	// The rejected row is still counted into the checkpoint.
+	Err error
+}
+
+// ImportTransactionsParams parameter for imports a chunk of BTC transactions.
+type ImportTransactionsParams struct {
+	ImportID string       // required
+	Rows     []*ImportRow // required, ordered by the sequence
+}
+
+// ImportRejection is a rejected row of ImportTransactionsParams.
+type ImportRejection struct {
+	Sequence int64
+	Err      error
+}
+
+// ImportTransactionsResult is the result of a chunk of ImportTransactionsParams.
+type ImportTransactionsResult struct {
+	// Checkpoint is the checkpoint of the import right after the chunk is committed.
+	Checkpoint *rpc.ImportCheckpoint
+	Imported   int64
+	// Skipped is the number of the rows up to the checkpoint before the chunk.
+	Skipped    int64
+	Rejections []*ImportRejection
 }
 
 // ListTransactionParams parameter for lists a BTC transactions.
@@ -22,16 +76,282 @@ type ListTransactionParams struct {
 	UserID        int64     // required
 	StartDatetime time.Time // required
 	EndDatetime   time.Time // required
+
+	PageSize  int32      // optional
+	PageToken string     // optional
+	Bucket    rpc.Bucket // optional
+	Asset     string     // optional, defaults to BTC
+
+	QuoteCurrency string // optional, the transactions are not valued if it's empty
+
+	Type        rpc.TransactionType // optional, only applied to the raw bucket
+	ExternalRef string              // optional, only applied to the raw bucket
+}
+
+// ExportTransactionsParams parameter for exports BTC transactions.
+type ExportTransactionsParams struct {
+	StartDatetime time.Time // required
+	EndDatetime   time.Time // required
+
+	UserID int64  // optional, defaults to all Users
+	Asset  string // optional, defaults to all assets
+
+	Type        rpc.TransactionType // optional
+	ExternalRef string              // optional
+}
+
+// GetUserBalanceParams parameter for gets a User balance.
+type GetUserBalanceParams struct {
+	UserID int64 // required
+
+	AsOf  time.Time // optional, defaults to the latest balance
+	Asset string    // optional, defaults to BTC
+
+	QuoteCurrency string // optional, the balance is not valued if it's empty
+}
+
+// GetBalanceHistoryParams parameter for gets a User balance history.
+type GetBalanceHistoryParams struct {
+	UserID        int64     // required
+	StartDatetime time.Time // required
+	EndDatetime   time.Time // required
+
+	Bucket rpc.Bucket // optional
+	Asset  string     // optional, defaults to BTC
+}
+
+// GetStatementParams parameter for gets a User statement.
+type GetStatementParams struct {
+	UserID        int64     // required
+	StartDatetime time.Time // required, inclusive
+	EndDatetime   time.Time // required, exclusive
+
+	Asset string // optional, defaults to BTC
+}
+
+// StatementAccount is a User and an asset, which has the statement.
+type StatementAccount struct {
+	UserID int64
+	Asset  string
+}
+
+// CreateHoldParams parameter for reserves an amount of a User.
+type CreateHoldParams struct {
+	UserID     int64     // required
+	AmountSats int64     // required, greater than 0
+	ExpiresAt  time.Time // required
+
+	Asset       string // optional, defaults to BTC
+	Description string // optional
+	ExternalRef string // optional
+}
+
+// CaptureHoldParams parameter for settles a hold by a withdrawal.
+type CaptureHoldParams struct {
+	ID string // required
+
+	AmountSats int64 // optional, defaults to the amount of the hold
+}
+
+// SetTransactionLimitsParams parameter for sets the limits of the debits of a User.
+// The limit of 0 isn't set, thus it falls back to the default limit.
+type SetTransactionLimitsParams struct {
+	UserID int64  // optional, 0 sets the default limits of the asset
+	Asset  string // optional, defaults to BTC
+
+	MaxAmountSats       int64         // optional
+	MaxDailyOutflowSats int64         // optional
+	MaxCount            int64         // optional
+	CountWindow         time.Duration // optional, whole seconds
+}
+
+// CreateScheduleParams parameter for creates a transaction of a User by the scheduler.
+type CreateScheduleParams struct {
+	UserID     int64                // required
+	AmountSats int64                // required, positive for a credit and negative for a debit
+	Interval   rpc.ScheduleInterval // required
+	StartAt    time.Time            // required, the first run
+
+	EndAt       time.Time           // optional, the recurring schedule is unlimited if it's zero
+	Asset       string              // optional, defaults to BTC
+	Type        rpc.TransactionType // optional
+	Description string              // optional
+	Labels      map[string]string   // optional
+}
+
+// ListSchedulesParams parameter for lists schedules.
+type ListSchedulesParams struct {
+	UserID    int64              // optional, defaults to all Users
+	Status    rpc.ScheduleStatus // optional, defaults to all statuses
+	PageSize  int32              // optional
+	PageToken string             // optional
+}
+
+// FinishScheduleRunParams parameter for finishes a run of a claimed schedule.
+// Either the TransactionID or the Error is set.
+type FinishScheduleRunParams struct {
+	ID    string    // required
+	RunAt time.Time // required, the next run of the claimed schedule
+
+	NextRunAt     time.Time // optional, the schedule is completed if it's zero
+	TransactionID string    // optional, the transaction created by the run
+	Error         string    // optional, the reason the run is skipped
+}
+
+// The types of the OutboxEvent.
+const (
+	// OutboxEventTransactionCreated is the type of the event of a created transaction, the payload is rpc.TransactionCreatedEvent.
+	OutboxEventTransactionCreated = "transaction.created"
+	// OutboxEventBalanceChanged is the type of the event of a changed balance, the payload is rpc.BalanceChangedEvent.
+	OutboxEventBalanceChanged = "balance.changed"
+)
+
+// OutboxEvent is an event written in the same database transaction as the change, until it's published.
+type OutboxEvent struct {
+	ID        int64
+	UserID    int64
+	Type      string
+	Payload   []byte // the JSON of the event message
+	CreatedAt time.Time
+}
+
+// TransferParams parameter for transfers BTC between Users.
+type TransferParams struct {
+	FromUserID int64     // required
+	ToUserID   int64     // required
+	Datetime   time.Time // required
+	AmountSats int64     // required, the exact amount in the minor unit of the asset
+
+	IdempotencyKey string // optional
+	Asset          string // optional, defaults to BTC
+}
+
+// TransferBalances is the balances of the Users right after the transfer is committed.
+type TransferBalances struct {
+	From *rpc.UserBalance
+	To   *rpc.UserBalance
+}
+
+// ReverseTransactionParams parameter for reverses a BTC transaction.
+type ReverseTransactionParams struct {
+	ID     string // required, the ID of the transaction to reverse
+	Reason string // required
+}
+
+// CreateUserParams parameter for creates a User.
+type CreateUserParams struct {
+	ExternalRef string // optional, unique if it's set
+}
+
+// ListUsersParams parameter for lists Users.
+type ListUsersParams struct {
+	PageSize  int32          // optional
+	PageToken string         // optional
+	Status    rpc.UserStatus // optional, defaults to all statuses
 }
 
 // BTCRepo defines BTC repository.
 type BTCRepo interface {
 	// CreateTransaction creates a new record for BTC transaction.
 	// Only single transaction will create by this RPC for a specific User.
-	CreateTransaction(ctx context.Context, params *CreateTransactionParams) (*rpc.Transaction, error)
+	// Returns the balance of the User right after the transaction is committed,
+	// nil if the balance is unchanged because of a replay with the same idempotency key.
+	CreateTransaction(ctx context.Context, params *CreateTransactionParams) (*rpc.Transaction, *rpc.UserBalance, error)
+	// CreateTransactions creates many records for BTC transaction within a single database transaction.
+	// Returns the result of each entry in the same order as the params,
+	// and the balances of each changed User by the asset right after the transactions are committed.
+	// In all-or-nothing mode, the first rejected entry is returned as the error prefixed by its index.
+	CreateTransactions(
+		ctx context.Context, params *CreateTransactionsParams,
+	) ([]*CreateTransactionsResult, map[int64][]*rpc.UserBalance, error)
+	// ImportTransactions creates the records for BTC transaction of a chunk of an import within a single database transaction,
+	// together with the balances and the checkpoint of the import.
+	// The rows up to the checkpoint are skipped, and the rejected rows are reported without aborting the chunk.
+	// Returns the balances of each changed User by the asset right after the chunk is committed.
+	ImportTransactions(
+		ctx context.Context, params *ImportTransactionsParams,
+	) (*ImportTransactionsResult, map[int64][]*rpc.UserBalance, error)
+	// GetImportCheckpoint get the checkpoint of an import by the ID.
+	GetImportCheckpoint(ctx context.Context, importID string) (*rpc.ImportCheckpoint, error)
 	// ListTransaction get the list of records for BTC transaction.
 	// The record can be filtered by specific User.
-	ListTransaction(ctx context.Context, params *ListTransactionParams) ([]*rpc.Transaction, error)
+	// Returns the token of the next page, empty if there are no more records.
+	ListTransaction(ctx context.Context, params *ListTransactionParams) ([]*rpc.Transaction, string, error)
+	// ExportTransactions sends each un-aggregated record for BTC transaction ordered by the datetime and the ID.
+	// The records are read in batches keyed on the last sent record, thus the memory is constant for any date range.
+	// Stops at the first error returned by the send.
+	ExportTransactions(ctx context.Context, params *ExportTransactionsParams, send func(*rpc.Transaction) error) error
+	// Transfer moves BTC from a User to another User within a single database transaction.
+	// Returns the balances of the Users right after the transfer is committed,
+	// nil if the balances are unchanged because of a replay with the same idempotency key.
+	Transfer(ctx context.Context, params *TransferParams) (*rpc.TransferResponse, *TransferBalances, error)
+	// GetTransaction get a single record of BTC transaction by the ID.
+	GetTransaction(ctx context.Context, id string) (*rpc.Transaction, error)
+	// ReverseTransaction creates a compensating transaction with the opposite amount of a transaction
+	// within a single database transaction.
+	// Returns the balance of the User right after the reversal is committed.
+	ReverseTransaction(ctx context.Context, params *ReverseTransactionParams) (*rpc.Transaction, *rpc.UserBalance, error)
 	// GetUserBalance get the latest balance for a specific User.
-	GetUserBalance(ctx context.Context, userID int64) (*rpc.UserBalance, error)
+	// If the AsOf is set, get the balance at that point in time instead.
+	GetUserBalance(ctx context.Context, params *GetUserBalanceParams) (*rpc.UserBalance, error)
+	// GetBalanceHistory get the closing balance at the end of each bucket for a specific User.
+	// The buckets without transactions are omitted, since the balance is unchanged.
+	GetBalanceHistory(ctx context.Context, params *GetBalanceHistoryParams) ([]*rpc.BalancePoint, error)
+	// CreateHold reserves an amount of a User within a single database transaction.
+	// Returns the balance of the User right after the hold is committed, with the reduced available balance.
+	CreateHold(ctx context.Context, params *CreateHoldParams) (*rpc.Hold, *rpc.UserBalance, error)
+	// GetHold get a single Hold by the ID.
+	GetHold(ctx context.Context, id string) (*rpc.Hold, error)
+	// GetPendingHold get a single pending Hold by the ID from the master.
+	// Returns ErrHoldSettled if the hold is already captured or released, and ErrHoldExpired if the deadline passed.
+	GetPendingHold(ctx context.Context, id string) (*rpc.Hold, error)
+	// CaptureHold settles a pending hold by a withdrawal transaction of the captured amount
+	// within a single database transaction.
+	// Returns the balance of the User right after the withdrawal is committed.
+	CaptureHold(ctx context.Context, params *CaptureHoldParams) (*rpc.Hold, *rpc.Transaction, *rpc.UserBalance, error)
+	// ReleaseHold cancels a pending hold without a debit.
+	// Returns the balance of the User right after the hold is released, with the restored available balance.
+	ReleaseHold(ctx context.Context, id string) (*rpc.Hold, *rpc.UserBalance, error)
+	// SetTransactionLimits stores the limits of the debits of a User for an asset, replacing the previous limits.
+	// Returns the stored limits, without the default limits.
+	SetTransactionLimits(ctx context.Context, params *SetTransactionLimitsParams) (*rpc.TransactionLimits, error)
+	// GetTransactionLimits get the effective limits of the debits of a User for an asset,
+	// the limits that aren't set for the User are the default limits of the asset.
+	GetTransactionLimits(ctx context.Context, userID int64, asset string) (*rpc.TransactionLimits, error)
+	// FindIdempotencyKeys returns the keys that are already used by a committed transaction or transfer,
+	// thus the request with the key is a replay.
+	FindIdempotencyKeys(ctx context.Context, keys []string) (map[string]bool, error)
+	// CreateSchedule creates a schedule of a User, the first run is at the start time.
+	CreateSchedule(ctx context.Context, params *CreateScheduleParams) (*rpc.Schedule, error)
+	// ListSchedules get the list of schedules ordered by the created time and the ID.
+	// Returns the token of the next page, empty if there are no more schedules.
+	ListSchedules(ctx context.Context, params *ListSchedulesParams) ([]*rpc.Schedule, string, error)
+	// CancelSchedule cancels an active schedule, the cancelled schedule is returned as is.
+	CancelSchedule(ctx context.Context, id string) (*rpc.Schedule, error)
+	// ClaimDueSchedules locks the active schedules with the next run at or before now for the lease duration,
+	// thus the other replicas skip them until the run is finished or the lease ends.
+	ClaimDueSchedules(ctx context.Context, limit int, lease time.Duration) ([]*rpc.Schedule, error)
+	// FinishScheduleRun records the run of a claimed schedule and moves it into the next run, then releases the lease.
+	// Returns ErrScheduleFinished if the run is already finished or the schedule isn't active anymore.
+	FinishScheduleRun(ctx context.Context, params *FinishScheduleRunParams) (*rpc.Schedule, error)
+	// RelayOutboxEvents publishes the unpublished events in the order of the ID, up to the limit,
+	// and marks the published events. The relay stops at the first event that failed to publish,
+	// thus the following events of the same User aren't published before it.
+	// Returns the number of the published events, 0 if another relay is already running.
+	RelayOutboxEvents(ctx context.Context, limit int, publish func(*OutboxEvent) error) (int, error)
+	// GetStatement get the opening balance, the transactions, the totals and the closing balance of the period
+	// for a specific User from a single snapshot of the transactions, thus the balances always agree with the transactions.
+	GetStatement(ctx context.Context, params *GetStatementParams) (*rpc.Statement, error)
+	// ListStatementAccounts get the Users and the assets with any transaction before the end date and time,
+	// ordered by the User ID and the asset.
+	ListStatementAccounts(ctx context.Context, endDatetime time.Time) ([]*StatementAccount, error)
+	// CreateUser creates a new User, the User is active once created.
+	CreateUser(ctx context.Context, params *CreateUserParams) (*rpc.User, error)
+	// GetUser get a single User by the ID.
+	GetUser(ctx context.Context, id int64) (*rpc.User, error)
+	// ListUsers get the list of Users ordered by the ID.
+	// Returns the token of the next page, empty if there are no more records.
+	ListUsers(ctx context.Context, params *ListUsersParams) ([]*rpc.User, string, error)
+	// DeactivateUser deactivates a User, thus the User can't create transactions anymore.
+	DeactivateUser(ctx context.Context, id int64) (*rpc.User, error)
 }
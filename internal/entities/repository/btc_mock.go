@@ -7,6 +7,7 @@ package repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	grpc "github.com/moemoe89/btc/api/go/grpc"
@@ -35,13 +36,92 @@ func (m *GoMockBTCRepo) EXPECT() *GoMockBTCRepoMockRecorder {
 	return m.recorder
 }
 
+// CancelSchedule mocks base method.
+func (m *GoMockBTCRepo) CancelSchedule(ctx context.Context, id string) (*grpc.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSchedule", ctx, id)
+	ret0, _ := ret[0].(*grpc.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *GoMockBTCRepoMockRecorder) CancelSchedule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*GoMockBTCRepo)(nil).CancelSchedule), ctx, id)
+}
+
+// CaptureHold mocks base method.
+func (m *GoMockBTCRepo) CaptureHold(ctx context.Context, params *CaptureHoldParams) (*grpc.Hold, *grpc.Transaction, *grpc.UserBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, params)
+	ret0, _ := ret[0].(*grpc.Hold)
+	ret1, _ := ret[1].(*grpc.Transaction)
+	ret2, _ := ret[2].(*grpc.UserBalance)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *GoMockBTCRepoMockRecorder) CaptureHold(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*GoMockBTCRepo)(nil).CaptureHold), ctx, params)
+}
+
+// ClaimDueSchedules mocks base method.
+func (m *GoMockBTCRepo) ClaimDueSchedules(ctx context.Context, limit int, lease time.Duration) ([]*grpc.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueSchedules", ctx, limit, lease)
+	ret0, _ := ret[0].([]*grpc.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueSchedules indicates an expected call of ClaimDueSchedules.
+func (mr *GoMockBTCRepoMockRecorder) ClaimDueSchedules(ctx, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueSchedules", reflect.TypeOf((*GoMockBTCRepo)(nil).ClaimDueSchedules), ctx, limit, lease)
+}
+
+// CreateHold mocks base method.
+func (m *GoMockBTCRepo) CreateHold(ctx context.Context, params *CreateHoldParams) (*grpc.Hold, *grpc.UserBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", ctx, params)
+	ret0, _ := ret[0].(*grpc.Hold)
+	ret1, _ := ret[1].(*grpc.UserBalance)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *GoMockBTCRepoMockRecorder) CreateHold(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*GoMockBTCRepo)(nil).CreateHold), ctx, params)
+}
+
+// CreateSchedule mocks base method.
+func (m *GoMockBTCRepo) CreateSchedule(ctx context.Context, params *CreateScheduleParams) (*grpc.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, params)
+	ret0, _ := ret[0].(*grpc.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *GoMockBTCRepoMockRecorder) CreateSchedule(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*GoMockBTCRepo)(nil).CreateSchedule), ctx, params)
+}
+
 // CreateTransaction mocks base method.
-func (m *GoMockBTCRepo) CreateTransaction(ctx context.Context, params *CreateTransactionParams) (*grpc.Transaction, error) {
+func (m *GoMockBTCRepo) CreateTransaction(ctx context.Context, params *CreateTransactionParams) (*grpc.Transaction, *grpc.UserBalance, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateTransaction", ctx, params)
 	ret0, _ := ret[0].(*grpc.Transaction)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*grpc.UserBalance)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // CreateTransaction indicates an expected call of CreateTransaction.
@@ -50,28 +130,286 @@ func (mr *GoMockBTCRepoMockRecorder) CreateTransaction(ctx, params interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*GoMockBTCRepo)(nil).CreateTransaction), ctx, params)
 }
 
+// CreateTransactions mocks base method.
+func (m *GoMockBTCRepo) CreateTransactions(ctx context.Context, params *CreateTransactionsParams) ([]*CreateTransactionsResult, map[int64][]*grpc.UserBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactions", ctx, params)
+	ret0, _ := ret[0].([]*CreateTransactionsResult)
+	ret1, _ := ret[1].(map[int64][]*grpc.UserBalance)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateTransactions indicates an expected call of CreateTransactions.
+func (mr *GoMockBTCRepoMockRecorder) CreateTransactions(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactions", reflect.TypeOf((*GoMockBTCRepo)(nil).CreateTransactions), ctx, params)
+}
+
+// CreateUser mocks base method.
+func (m *GoMockBTCRepo) CreateUser(ctx context.Context, params *CreateUserParams) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, params)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *GoMockBTCRepoMockRecorder) CreateUser(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*GoMockBTCRepo)(nil).CreateUser), ctx, params)
+}
+
+// DeactivateUser mocks base method.
+func (m *GoMockBTCRepo) DeactivateUser(ctx context.Context, id int64) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, id)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *GoMockBTCRepoMockRecorder) DeactivateUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*GoMockBTCRepo)(nil).DeactivateUser), ctx, id)
+}
+
+// ExportTransactions mocks base method.
+func (m *GoMockBTCRepo) ExportTransactions(ctx context.Context, params *ExportTransactionsParams, send func(*grpc.Transaction) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTransactions", ctx, params, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportTransactions indicates an expected call of ExportTransactions.
+func (mr *GoMockBTCRepoMockRecorder) ExportTransactions(ctx, params, send interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTransactions", reflect.TypeOf((*GoMockBTCRepo)(nil).ExportTransactions), ctx, params, send)
+}
+
+// FindIdempotencyKeys mocks base method.
+func (m *GoMockBTCRepo) FindIdempotencyKeys(ctx context.Context, keys []string) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdempotencyKeys", ctx, keys)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdempotencyKeys indicates an expected call of FindIdempotencyKeys.
+func (mr *GoMockBTCRepoMockRecorder) FindIdempotencyKeys(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdempotencyKeys", reflect.TypeOf((*GoMockBTCRepo)(nil).FindIdempotencyKeys), ctx, keys)
+}
+
+// FinishScheduleRun mocks base method.
+func (m *GoMockBTCRepo) FinishScheduleRun(ctx context.Context, params *FinishScheduleRunParams) (*grpc.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishScheduleRun", ctx, params)
+	ret0, _ := ret[0].(*grpc.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishScheduleRun indicates an expected call of FinishScheduleRun.
+func (mr *GoMockBTCRepoMockRecorder) FinishScheduleRun(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishScheduleRun", reflect.TypeOf((*GoMockBTCRepo)(nil).FinishScheduleRun), ctx, params)
+}
+
+// GetBalanceHistory mocks base method.
+func (m *GoMockBTCRepo) GetBalanceHistory(ctx context.Context, params *GetBalanceHistoryParams) ([]*grpc.BalancePoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceHistory", ctx, params)
+	ret0, _ := ret[0].([]*grpc.BalancePoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceHistory indicates an expected call of GetBalanceHistory.
+func (mr *GoMockBTCRepoMockRecorder) GetBalanceHistory(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceHistory", reflect.TypeOf((*GoMockBTCRepo)(nil).GetBalanceHistory), ctx, params)
+}
+
+// GetHold mocks base method.
+func (m *GoMockBTCRepo) GetHold(ctx context.Context, id string) (*grpc.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", ctx, id)
+	ret0, _ := ret[0].(*grpc.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *GoMockBTCRepoMockRecorder) GetHold(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*GoMockBTCRepo)(nil).GetHold), ctx, id)
+}
+
+// GetImportCheckpoint mocks base method.
+func (m *GoMockBTCRepo) GetImportCheckpoint(ctx context.Context, importID string) (*grpc.ImportCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportCheckpoint", ctx, importID)
+	ret0, _ := ret[0].(*grpc.ImportCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportCheckpoint indicates an expected call of GetImportCheckpoint.
+func (mr *GoMockBTCRepoMockRecorder) GetImportCheckpoint(ctx, importID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportCheckpoint", reflect.TypeOf((*GoMockBTCRepo)(nil).GetImportCheckpoint), ctx, importID)
+}
+
+// GetPendingHold mocks base method.
+func (m *GoMockBTCRepo) GetPendingHold(ctx context.Context, id string) (*grpc.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingHold", ctx, id)
+	ret0, _ := ret[0].(*grpc.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingHold indicates an expected call of GetPendingHold.
+func (mr *GoMockBTCRepoMockRecorder) GetPendingHold(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingHold", reflect.TypeOf((*GoMockBTCRepo)(nil).GetPendingHold), ctx, id)
+}
+
+// GetStatement mocks base method.
+func (m *GoMockBTCRepo) GetStatement(ctx context.Context, params *GetStatementParams) (*grpc.Statement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", ctx, params)
+	ret0, _ := ret[0].(*grpc.Statement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *GoMockBTCRepoMockRecorder) GetStatement(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*GoMockBTCRepo)(nil).GetStatement), ctx, params)
+}
+
+// GetTransaction mocks base method.
+func (m *GoMockBTCRepo) GetTransaction(ctx context.Context, id string) (*grpc.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", ctx, id)
+	ret0, _ := ret[0].(*grpc.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *GoMockBTCRepoMockRecorder) GetTransaction(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*GoMockBTCRepo)(nil).GetTransaction), ctx, id)
+}
+
+// GetTransactionLimits mocks base method.
+func (m *GoMockBTCRepo) GetTransactionLimits(ctx context.Context, userID int64, asset string) (*grpc.TransactionLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionLimits", ctx, userID, asset)
+	ret0, _ := ret[0].(*grpc.TransactionLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionLimits indicates an expected call of GetTransactionLimits.
+func (mr *GoMockBTCRepoMockRecorder) GetTransactionLimits(ctx, userID, asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionLimits", reflect.TypeOf((*GoMockBTCRepo)(nil).GetTransactionLimits), ctx, userID, asset)
+}
+
+// GetUser mocks base method.
+func (m *GoMockBTCRepo) GetUser(ctx context.Context, id int64) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *GoMockBTCRepoMockRecorder) GetUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*GoMockBTCRepo)(nil).GetUser), ctx, id)
+}
+
 // GetUserBalance mocks base method.
-func (m *GoMockBTCRepo) GetUserBalance(ctx context.Context, userID int64) (*grpc.UserBalance, error) {
+func (m *GoMockBTCRepo) GetUserBalance(ctx context.Context, params *GetUserBalanceParams) (*grpc.UserBalance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserBalance", ctx, userID)
+	ret := m.ctrl.Call(m, "GetUserBalance", ctx, params)
 	ret0, _ := ret[0].(*grpc.UserBalance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserBalance indicates an expected call of GetUserBalance.
-func (mr *GoMockBTCRepoMockRecorder) GetUserBalance(ctx, userID interface{}) *gomock.Call {
+func (mr *GoMockBTCRepoMockRecorder) GetUserBalance(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBalance", reflect.TypeOf((*GoMockBTCRepo)(nil).GetUserBalance), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBalance", reflect.TypeOf((*GoMockBTCRepo)(nil).GetUserBalance), ctx, params)
+}
+
+// ImportTransactions mocks base method.
+func (m *GoMockBTCRepo) ImportTransactions(ctx context.Context, params *ImportTransactionsParams) (*ImportTransactionsResult, map[int64][]*grpc.UserBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTransactions", ctx, params)
+	ret0, _ := ret[0].(*ImportTransactionsResult)
+	ret1, _ := ret[1].(map[int64][]*grpc.UserBalance)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ImportTransactions indicates an expected call of ImportTransactions.
+func (mr *GoMockBTCRepoMockRecorder) ImportTransactions(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTransactions", reflect.TypeOf((*GoMockBTCRepo)(nil).ImportTransactions), ctx, params)
+}
+
+// ListSchedules mocks base method.
+func (m *GoMockBTCRepo) ListSchedules(ctx context.Context, params *ListSchedulesParams) ([]*grpc.Schedule, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", ctx, params)
+	ret0, _ := ret[0].([]*grpc.Schedule)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *GoMockBTCRepoMockRecorder) ListSchedules(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*GoMockBTCRepo)(nil).ListSchedules), ctx, params)
+}
+
+// ListStatementAccounts mocks base method.
+func (m *GoMockBTCRepo) ListStatementAccounts(ctx context.Context, endDatetime time.Time) ([]*StatementAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatementAccounts", ctx, endDatetime)
+	ret0, _ := ret[0].([]*StatementAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatementAccounts indicates an expected call of ListStatementAccounts.
+func (mr *GoMockBTCRepoMockRecorder) ListStatementAccounts(ctx, endDatetime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatementAccounts", reflect.TypeOf((*GoMockBTCRepo)(nil).ListStatementAccounts), ctx, endDatetime)
 }
 
 // ListTransaction mocks base method.
-func (m *GoMockBTCRepo) ListTransaction(ctx context.Context, params *ListTransactionParams) ([]*grpc.Transaction, error) {
+func (m *GoMockBTCRepo) ListTransaction(ctx context.Context, params *ListTransactionParams) ([]*grpc.Transaction, string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListTransaction", ctx, params)
 	ret0, _ := ret[0].([]*grpc.Transaction)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ListTransaction indicates an expected call of ListTransaction.
@@ -79,3 +417,97 @@ func (mr *GoMockBTCRepoMockRecorder) ListTransaction(ctx, params interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransaction", reflect.TypeOf((*GoMockBTCRepo)(nil).ListTransaction), ctx, params)
 }
+
+// ListUsers mocks base method.
+func (m *GoMockBTCRepo) ListUsers(ctx context.Context, params *ListUsersParams) ([]*grpc.User, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, params)
+	ret0, _ := ret[0].([]*grpc.User)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *GoMockBTCRepoMockRecorder) ListUsers(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*GoMockBTCRepo)(nil).ListUsers), ctx, params)
+}
+
+// RelayOutboxEvents mocks base method.
+func (m *GoMockBTCRepo) RelayOutboxEvents(ctx context.Context, limit int, publish func(*OutboxEvent) error) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayOutboxEvents", ctx, limit, publish)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayOutboxEvents indicates an expected call of RelayOutboxEvents.
+func (mr *GoMockBTCRepoMockRecorder) RelayOutboxEvents(ctx, limit, publish interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutboxEvents", reflect.TypeOf((*GoMockBTCRepo)(nil).RelayOutboxEvents), ctx, limit, publish)
+}
+
+// ReleaseHold mocks base method.
+func (m *GoMockBTCRepo) ReleaseHold(ctx context.Context, id string) (*grpc.Hold, *grpc.UserBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", ctx, id)
+	ret0, _ := ret[0].(*grpc.Hold)
+	ret1, _ := ret[1].(*grpc.UserBalance)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *GoMockBTCRepoMockRecorder) ReleaseHold(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*GoMockBTCRepo)(nil).ReleaseHold), ctx, id)
+}
+
+// ReverseTransaction mocks base method.
+func (m *GoMockBTCRepo) ReverseTransaction(ctx context.Context, params *ReverseTransactionParams) (*grpc.Transaction, *grpc.UserBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseTransaction", ctx, params)
+	ret0, _ := ret[0].(*grpc.Transaction)
+	ret1, _ := ret[1].(*grpc.UserBalance)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReverseTransaction indicates an expected call of ReverseTransaction.
+func (mr *GoMockBTCRepoMockRecorder) ReverseTransaction(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransaction", reflect.TypeOf((*GoMockBTCRepo)(nil).ReverseTransaction), ctx, params)
+}
+
+// SetTransactionLimits mocks base method.
+func (m *GoMockBTCRepo) SetTransactionLimits(ctx context.Context, params *SetTransactionLimitsParams) (*grpc.TransactionLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransactionLimits", ctx, params)
+	ret0, _ := ret[0].(*grpc.TransactionLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransactionLimits indicates an expected call of SetTransactionLimits.
+func (mr *GoMockBTCRepoMockRecorder) SetTransactionLimits(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransactionLimits", reflect.TypeOf((*GoMockBTCRepo)(nil).SetTransactionLimits), ctx, params)
+}
+
+// Transfer mocks base method.
+func (m *GoMockBTCRepo) Transfer(ctx context.Context, params *TransferParams) (*grpc.TransferResponse, *TransferBalances, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, params)
+	ret0, _ := ret[0].(*grpc.TransferResponse)
+	ret1, _ := ret[1].(*TransferBalances)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *GoMockBTCRepoMockRecorder) Transfer(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*GoMockBTCRepo)(nil).Transfer), ctx, params)
+}
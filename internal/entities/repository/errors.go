@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is an error for indicates record not found.
+	ErrNotFound = errors.New("error not found")
+	// ErrIdempotencyKeyConflict is an error for indicates the idempotency key already used by a different payload.
+	ErrIdempotencyKeyConflict = errors.New("idempotency key already used with a different payload")
+	// ErrInvalidPageToken is an error for indicates the page token can't be decoded.
+	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrInvalidBucket is an error for indicates the bucket is not in the whitelist.
+	ErrInvalidBucket = errors.New("invalid bucket")
+	// ErrInvalidAmount is an error for indicates the amount is 0 or has sub-satoshi precision.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrInvalidAsset is an error for indicates the asset is not in the registry.
+	ErrInvalidAsset = errors.New("invalid asset")
+	// ErrInvalidMetadata is an error for indicates the type, description, external reference or labels of the transaction is invalid.
+	ErrInvalidMetadata = errors.New("invalid transaction metadata")
+	// ErrInvalidTransfer is an error for indicates the transfer is from and to the same User.
+	ErrInvalidTransfer = errors.New("invalid transfer")
+	// ErrInvalidBatch is an error for indicates the batch is empty or exceeds the maximum size.
+	ErrInvalidBatch = errors.New("invalid batch")
+	// ErrInvalidImport is an error for indicates the import ID or the sequence of a row of an import is invalid.
+	ErrInvalidImport = errors.New("invalid import")
+	// ErrInvalidStatement is an error for indicates the period or the format of a statement is invalid.
+	ErrInvalidStatement = errors.New("invalid statement")
+	// ErrInvalidHold is an error for indicates the deadline of a hold or the captured amount is invalid.
+	ErrInvalidHold = errors.New("invalid hold")
+	// ErrInvalidLimits is an error for indicates the limits of the debits are invalid.
+	ErrInvalidLimits = errors.New("invalid transaction limits")
+	// ErrInvalidSchedule is an error for indicates the interval, the start or the end of a schedule is invalid.
+	ErrInvalidSchedule = errors.New("invalid schedule")
+	// ErrInvalidUserStatus is an error for indicates the User status is unknown.
+	ErrInvalidUserStatus = errors.New("invalid user status")
+	// ErrAlreadyExists is an error for indicates the record already exists.
+	ErrAlreadyExists = errors.New("error already exists")
+	// ErrUserDeactivated is an error for indicates the User is deactivated.
+	ErrUserDeactivated = errors.New("user is deactivated")
+	// ErrAlreadyReversed is an error for indicates the transaction is already reversed.
+	ErrAlreadyReversed = errors.New("transaction already reversed")
+	// ErrInvalidReversal is an error for indicates the transaction can't be reversed,
+	// since it's a reversal or a part of a transfer.
+	ErrInvalidReversal = errors.New("invalid reversal")
+	// ErrInvalidReason is an error for indicates the reason is empty or too long.
+	ErrInvalidReason = errors.New("invalid reason")
+	// ErrInvalidQuoteCurrency is an error for indicates the quote currency is not an ISO 4217 code.
+	ErrInvalidQuoteCurrency = errors.New("invalid quote currency")
+	// ErrPriceNotFound is an error for indicates there's no price of the asset in the quote currency.
+	ErrPriceNotFound = errors.New("price not found")
+	// ErrHoldSettled is an error for indicates the hold is already captured or released.
+	ErrHoldSettled = errors.New("hold already settled")
+	// ErrHoldExpired is an error for indicates the deadline of the hold passed before it's settled.
+	ErrHoldExpired = errors.New("hold expired")
+	// ErrScheduleFinished is an error for indicates the schedule is already completed or cancelled.
+	ErrScheduleFinished = errors.New("schedule already finished")
+	// ErrInsufficientBalance is an error for indicates the debit overdraws the balance of the User.
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	// ErrLimitExceeded is an error for indicates the debit exceeds a limit of the User.
+	ErrLimitExceeded = errors.New("transaction limit exceeded")
+)
+
+const (
+	// LimitMaxAmount is the limit of the amount of a single debit.
+	LimitMaxAmount = "max_amount_sats"
+	// LimitMaxDailyOutflow is the limit of the sum of the debits within a day.
+	LimitMaxDailyOutflow = "max_daily_outflow_sats"
+	// LimitMaxCount is the limit of the number of the debits within the count window.
+	LimitMaxCount = "max_count"
+)
+
+// LimitError is an error for indicates which limit of the User is exceeded by the debit, it wraps ErrLimitExceeded.
+type LimitError struct {
+	// Limit is the name of the exceeded limit, one of LimitMaxAmount, LimitMaxDailyOutflow or LimitMaxCount.
+	Limit  string
+	UserID int64
+	Asset  string
+	// Value is the value of the exceeded limit.
+	Value int64
+}
+
+// Error returns the message of the error.
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("user id: %d asset: %s: %s: %d: %s", e.UserID, e.Asset, e.Limit, e.Value, ErrLimitExceeded)
+}
+
+// Unwrap returns ErrLimitExceeded.
+func (e *LimitError) Unwrap() error {
+	return ErrLimitExceeded
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate rm -f ./price_mock.go
+//go:generate mockgen -destination price_mock.go -package repository -mock_names PriceSource=GoMockPriceSource -source price.go
+
+// GetPricesParams parameter for gets the prices of an asset.
+type GetPricesParams struct {
+	Asset         string      // required
+	QuoteCurrency string      // required
+	Datetimes     []time.Time // required
+}
+
+// Price is the price of 1 unit of an asset in a quote currency.
+type Price struct {
+	Datetime time.Time
+	Price    float64
+}
+
+// PriceSource defines the source of the historical prices of the assets.
+type PriceSource interface {
+	// GetPrices get the latest price at or before each of the datetimes, in the same order as the datetimes.
+	// Returns ErrPriceNotFound if there's no price for any of the datetimes.
+	GetPrices(ctx context.Context, params *GetPricesParams) ([]*Price, error)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: price.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// GoMockPriceSource is a mock of PriceSource interface.
+type GoMockPriceSource struct {
+	ctrl     *gomock.Controller
+	recorder *GoMockPriceSourceMockRecorder
+}
+
+// GoMockPriceSourceMockRecorder is the mock recorder for GoMockPriceSource.
+type GoMockPriceSourceMockRecorder struct {
+	mock *GoMockPriceSource
+}
+
+// NewGoMockPriceSource creates a new mock instance.
+func NewGoMockPriceSource(ctrl *gomock.Controller) *GoMockPriceSource {
+	mock := &GoMockPriceSource{ctrl: ctrl}
+	mock.recorder = &GoMockPriceSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *GoMockPriceSource) EXPECT() *GoMockPriceSourceMockRecorder {
+	return m.recorder
+}
+
+// GetPrices mocks base method.
+func (m *GoMockPriceSource) GetPrices(ctx context.Context, params *GetPricesParams) ([]*Price, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrices", ctx, params)
+	ret0, _ := ret[0].([]*Price)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrices indicates an expected call of GetPrices.
+func (mr *GoMockPriceSourceMockRecorder) GetPrices(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrices", reflect.TypeOf((*GoMockPriceSource)(nil).GetPrices), ctx, params)
+}
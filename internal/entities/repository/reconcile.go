@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+)
+
+//go:generate rm -f ./reconcile_mock.go
+//go:generate mockgen -destination reconcile_mock.go -package repository -mock_names ReconcileRepo=GoMockReconcileRepo -source reconcile.go
+
+// BalanceMismatch is a balance of a User that disagrees with the sum of its transactions.
+// The amounts are the exact decimals in units of the asset, since a manual fix may not fit the minor unit.
+type BalanceMismatch struct {
+	UserID     int64
+	Asset      string
+	Balance    string
+	Expected   string // the sum of the transactions
+	Difference string // the expected minus the balance
+}
+
+// ReconcileRepo defines the reconciliation of the balances against the transactions.
+type ReconcileRepo interface {
+	// FindBalanceMismatches compares the balance of each User and asset with the sum of its transactions,
+	// and returns the mismatches ordered by the User ID and the asset.
+	FindBalanceMismatches(ctx context.Context) ([]*BalanceMismatch, error)
+	// RepairBalance rewrites the balance of the User for the asset into the sum of its transactions under lock.
+	// Returns the repaired mismatch, nil if the balance already agrees once it's locked.
+	RepairBalance(ctx context.Context, userID int64, asset string) (*BalanceMismatch, error)
+}
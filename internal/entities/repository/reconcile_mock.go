@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reconcile.go
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// GoMockReconcileRepo is a mock of ReconcileRepo interface.
+type GoMockReconcileRepo struct {
+	ctrl     *gomock.Controller
+	recorder *GoMockReconcileRepoMockRecorder
+}
+
+// GoMockReconcileRepoMockRecorder is the mock recorder for GoMockReconcileRepo.
+type GoMockReconcileRepoMockRecorder struct {
+	mock *GoMockReconcileRepo
+}
+
+// NewGoMockReconcileRepo creates a new mock instance.
+func NewGoMockReconcileRepo(ctrl *gomock.Controller) *GoMockReconcileRepo {
+	mock := &GoMockReconcileRepo{ctrl: ctrl}
+	mock.recorder = &GoMockReconcileRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *GoMockReconcileRepo) EXPECT() *GoMockReconcileRepoMockRecorder {
+	return m.recorder
+}
+
+// FindBalanceMismatches mocks base method.
+func (m *GoMockReconcileRepo) FindBalanceMismatches(ctx context.Context) ([]*BalanceMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBalanceMismatches", ctx)
+	ret0, _ := ret[0].([]*BalanceMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBalanceMismatches indicates an expected call of FindBalanceMismatches.
+func (mr *GoMockReconcileRepoMockRecorder) FindBalanceMismatches(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBalanceMismatches", reflect.TypeOf((*GoMockReconcileRepo)(nil).FindBalanceMismatches), ctx)
+}
+
+// RepairBalance mocks base method.
+func (m *GoMockReconcileRepo) RepairBalance(ctx context.Context, userID int64, asset string) (*BalanceMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairBalance", ctx, userID, asset)
+	ret0, _ := ret[0].(*BalanceMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepairBalance indicates an expected call of RepairBalance.
+func (mr *GoMockReconcileRepoMockRecorder) RepairBalance(ctx, userID, asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairBalance", reflect.TypeOf((*GoMockReconcileRepo)(nil).RepairBalance), ctx, userID, asset)
+}
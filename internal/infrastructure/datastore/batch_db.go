@@ -0,0 +1,569 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/pkg/asset"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// lockedUser is a locked User, its balances are updated as the entries of the batch are applied.
+type lockedUser struct {
+	OverdraftLimitSats int64
+	Deactivated        bool
+	// Balances is the balance of each asset by the code.
+	Balances map[string]*lockedBalance
+}
+
+// lockedBalance is the balance of an asset of a locked User.
+type lockedBalance struct {
+	BalanceSats int64
+	DeltaSats   int64
+	// HeldSats is the sum of the pending holds, which reduces the available balance.
+	HeldSats int64
+	// Changed is whether any entry is applied to the balance, even when the entries net to zero.
+	Changed bool
+}
+
+// balance returns the balance of the asset, the missing balance is zero.
+func (u *lockedUser) balance(code string) *lockedBalance {
+	b, ok := u.Balances[code]
+	if !ok {
+		b = new(lockedBalance)
+		u.Balances[code] = b
+	}
+
+	return b
+}
+
+// CreateTransactions creates many records for BTC transaction within a single database transaction.
+// The rows are inserted with COPY and the balance of each User is updated once with the sum of its entries.
+// Returns the result of each entry in the same order as the params,
+// and the balance of each changed User right after the transactions are committed.
+// In all-or-nothing mode, the first rejected entry is returned as the error prefixed by its index.
+func (r *btcRepo) CreateTransactions( //nolint: funlen, gocyclo
+	ctx context.Context, params *repository.CreateTransactionsParams,
+) ([]*repository.CreateTransactionsResult, map[int64][]*rpc.UserBalance, error) {
+	results := make([]*repository.CreateTransactionsResult, len(params.Transactions))
+
+	// reject reports the error of the entry, in all-or-nothing mode it's returned to abort the whole batch.
+	reject := func(i int, err error) error {
+		results[i] = &repository.CreateTransactionsResult{Err: err}
+
+		if params.BestEffort {
+			return nil
+		}
+
+		return fmt.Errorf("transactions[%d]: %w", i, err)
+	}
+
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+	}()
+
+	users, err := lockUsers(ctx, tx, params.Transactions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The IDs are generated before the insert, thus they can be stored together with the idempotency keys.
+	var (
+		ids     = make([]uuid.UUID, len(params.Transactions))
+		assets  = make([]asset.Asset, len(params.Transactions))
+		entries = make([]data, len(params.Transactions))
+		keys    = make(map[string]int)
+	)
+
+	for i, p := range params.Transactions {
+		ids[i] = uuid.New()
+
+		assets[i], err = lookupAsset(p.Asset)
+		if err == nil {
+			entries[i], err = newData(ids[i].String(), p, assets[i])
+		}
+
+		if err != nil {
+			if err = reject(i, err); err != nil {
+				return nil, nil, err
+			}
+
+			continue
+		}
+
+		user, ok := users[p.UserID]
+		if !ok {
+			if err = reject(i, fmt.Errorf("user id: %d not found: %w", p.UserID, ErrNotFound)); err != nil {
+				return nil, nil, err
+			}
+
+			continue
+		}
+
+		if user.Deactivated {
+			if err = reject(i, fmt.Errorf("user id: %d: %w", p.UserID, ErrUserDeactivated)); err != nil {
+				return nil, nil, err
+			}
+
+			continue
+		}
+
+		if p.IdempotencyKey == "" {
+			continue
+		}
+
+		// The same key within the batch can't be told apart from a replay, thus it's rejected.
+		if _, ok := keys[p.IdempotencyKey]; ok {
+			err = reject(i, fmt.Errorf("idempotency key: %s is duplicated in the batch: %w", p.IdempotencyKey, ErrIdempotencyKeyConflict))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			continue
+		}
+
+		keys[p.IdempotencyKey] = i
+	}
+
+	originals, err := r.claimIdempotencyKeys(ctx, tx, params.Transactions, ids, assets, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		rows      [][]any
+		journal   []*journalEntry
+		unclaimed []string
+		created   []*rpc.Transaction
+	)
+
+	for i, p := range params.Transactions {
+		if results[i] != nil {
+			continue
+		}
+
+		a := assets[i]
+
+		// The key was already used, returns the original transaction and leave the balance untouched.
+		if original, ok := originals[i]; ok {
+			if original.TransferID != "" || original.CounterpartyUserID != 0 || original.UserID != p.UserID ||
+				!original.Datetime.Equal(p.Datetime.Truncate(time.Microsecond)) || original.AmountSats != p.AmountSats ||
+				original.Asset != a {
+				err = reject(i, fmt.Errorf("idempotency key: %s: %w", p.IdempotencyKey, ErrIdempotencyKeyConflict))
+				if err != nil {
+					return nil, nil, err
+				}
+
+				continue
+			}
+
+			var transaction *rpc.Transaction
+
+			transaction, err = getReplayedTransaction(ctx, tx, original)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			results[i] = &repository.CreateTransactionsResult{Transaction: transaction}
+
+			continue
+		}
+
+		// The entries are applied in order, thus a debit can be funded by a previous credit of the batch.
+		var (
+			user          = users[p.UserID]
+			balance       = user.balance(a.Code)
+			overdraftSats int64
+		)
+
+		// Only the BTC balance has the overdraft limit.
+		if a.Code == asset.BTC.Code {
+			overdraftSats = user.OverdraftLimitSats
+		}
+
+		// The pending holds are reserved, thus the debit can only spend the available balance.
+		if p.AmountSats < 0 && balance.BalanceSats-balance.HeldSats+balance.DeltaSats+p.AmountSats < -overdraftSats {
+			if err = reject(i, fmt.Errorf("user id: %d: %w", p.UserID, ErrInsufficientBalance)); err != nil {
+				return nil, nil, err
+			}
+
+			// The key is released, thus the entry can be retried once the User is funded.
+			if p.IdempotencyKey != "" {
+				unclaimed = append(unclaimed, p.IdempotencyKey)
+			}
+
+			continue
+		}
+
+		balance.DeltaSats += p.AmountSats
+		balance.Changed = true
+
+		d := entries[i]
+
+		rows = append(rows, []any{
+			ids[i], d.Datetime, d.UserID, pgtype.Numeric{Int: big.NewInt(d.AmountSats), Exp: int32(-a.Decimals), Valid: true}, a.Code,
+			nullIfEmpty(d.Type), nullIfEmpty(d.Description), nullIfEmpty(d.ExternalRef), d.labelsValue(), d.JournalEntryID,
+		})
+
+		journal = append(journal, transactionEntry(journalKindTransaction, &d, a, d.Type))
+
+		results[i] = &repository.CreateTransactionsResult{Transaction: d.toProto()}
+
+		created = append(created, results[i].Transaction)
+	}
+
+	if len(unclaimed) > 0 {
+		_, err = tx.Exec(ctx, `DELETE FROM idempotency_keys WHERE idempotency_key = ANY($1)`, unclaimed)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	balances := make(map[int64][]*rpc.UserBalance)
+
+	if len(rows) > 0 {
+		_, err = tx.CopyFrom(ctx,
+			pgx.Identifier{"transactions"},
+			[]string{"id", "datetime", "user_id", "amount", "asset", "type", "description", "external_ref", "labels", "journal_entry_id"},
+			pgx.CopyFromRows(rows),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The balance checks are already done while applying the entries, the ledger checks them again anyway.
+		err = postJournalEntries(ctx, tx, journal)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		balances, err = getChangedBalances(ctx, tx, users)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = insertOutboxEvents(ctx, tx, created, balances)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	errCommit := tx.Commit(ctx)
+	if errCommit != nil {
+		return nil, nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
+	}
+
+	return results, balances, nil
+}
+
+// nullIfEmpty returns nil for the empty string, thus it's copied as NULL.
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// lockUsers locks the Users of the entries in the order of the ID, thus the concurrent batches can't deadlock.
+// The balances are summed from the postings once the Users are locked, thus they can't change until the commit.
+// Returns the locked Users by the ID, the missing Users are omitted.
+func lockUsers(ctx context.Context, tx pgx.Tx, params []*repository.CreateTransactionParams) (map[int64]*lockedUser, error) {
+	var (
+		userIDs        = make([]int64, 0, len(params))
+		balanceUserIDs = make([]int64, 0, len(params))
+		balanceCodes   = make([]string, 0, len(params))
+	)
+
+	for _, p := range params {
+		userIDs = append(userIDs, p.UserID)
+
+		// The invalid asset is rejected while applying the entries.
+		if a, err := asset.Lookup(p.Asset); err == nil {
+			balanceUserIDs = append(balanceUserIDs, p.UserID)
+			balanceCodes = append(balanceCodes, a.Code)
+		}
+	}
+
+	query := `SELECT id, (overdraft_limit * $3::numeric)::bigint, status = $2
+				FROM users
+					WHERE id = ANY($1)
+						ORDER BY id
+							FOR UPDATE`
+
+	rows, err := tx.Query(ctx, query, userIDs, userStatusDeactivated, asset.BTC.Scale())
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[int64]*lockedUser)
+
+	for rows.Next() {
+		var (
+			id   int64
+			user = lockedUser{Balances: make(map[string]*lockedBalance)}
+		)
+
+		err = rows.Scan(&id, &user.OverdraftLimitSats, &user.Deactivated)
+		if err != nil {
+			rows.Close()
+
+			return nil, err
+		}
+
+		users[id] = &user
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err = getHeldBalances(ctx, tx, users, userIDs); err != nil {
+		return nil, err
+	}
+
+	if len(balanceCodes) == 0 {
+		return users, nil
+	}
+
+	// The balance is selected as the exact decimal, since the minor unit depends on the asset of the row.
+	query = `SELECT c.user_id, c.asset, account_balance(c.user_id::int, c.asset)::text
+				FROM (SELECT DISTINCT * FROM unnest($1::bigint[], $2::text[])) AS c(user_id, asset)`
+
+	rows, err = tx.Query(ctx, query, balanceUserIDs, balanceCodes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			userID       int64
+			code, amount string
+		)
+
+		if err = rows.Scan(&userID, &code, &amount); err != nil {
+			return nil, err
+		}
+
+		a, err := lookupAsset(code)
+		if err != nil {
+			return nil, err
+		}
+
+		balanceSats, err := a.ParseUnits(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		if user, ok := users[userID]; ok {
+			user.balance(code).BalanceSats = balanceSats
+		}
+	}
+
+	return users, rows.Err()
+}
+
+// claimIdempotencyKeys stores the idempotency keys of the entries within the given database transaction.
+// The keys is the index of the entry by the key, only those entries are claimed.
+// Returns the original claim by the index of the entry, for the keys that already exist.
+func (r *btcRepo) claimIdempotencyKeys(
+	ctx context.Context, tx pgx.Tx, params []*repository.CreateTransactionParams,
+	ids []uuid.UUID, assets []asset.Asset, keys map[string]int,
+) (map[int]*idempotencyClaim, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	var (
+		claimKeys      = make([]string, 0, len(keys))
+		transactionIDs = make([]string, 0, len(keys))
+		userIDs        = make([]int64, 0, len(keys))
+		datetimes      = make([]time.Time, 0, len(keys))
+		amounts        = make([]int64, 0, len(keys))
+		scales         = make([]int64, 0, len(keys))
+		codes          = make([]string, 0, len(keys))
+	)
+
+	for key, i := range keys {
+		claimKeys = append(claimKeys, key)
+		transactionIDs = append(transactionIDs, ids[i].String())
+		userIDs = append(userIDs, params[i].UserID)
+		datetimes = append(datetimes, params[i].Datetime)
+		amounts = append(amounts, params[i].AmountSats)
+		scales = append(scales, assets[i].Scale())
+		codes = append(codes, assets[i].Code)
+	}
+
+	query := `INSERT INTO idempotency_keys (idempotency_key, transaction_id, user_id, datetime, amount, asset)
+				SELECT c.key, c.transaction_id::uuid, c.user_id, c.datetime, c.amount::bigint / c.scale::numeric, c.asset
+					FROM unnest($1::text[], $2::text[], $3::bigint[], $4::timestamptz[], $5::bigint[], $6::bigint[], $7::text[])
+						AS c(key, transaction_id, user_id, datetime, amount, scale, asset)
+							ON CONFLICT (idempotency_key) DO NOTHING
+								RETURNING idempotency_key`
+
+	rows, err := tx.Query(ctx, query, claimKeys, transactionIDs, userIDs, datetimes, amounts, scales, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make(map[string]bool, len(keys))
+
+	for rows.Next() {
+		var key string
+
+		if err = rows.Scan(&key); err != nil {
+			rows.Close()
+
+			return nil, err
+		}
+
+		claimed[key] = true
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var used []string
+
+	for _, key := range claimKeys {
+		if !claimed[key] {
+			used = append(used, key)
+		}
+	}
+
+	if len(used) == 0 {
+		return nil, nil
+	}
+
+	query = `SELECT idempotency_key, COALESCE(transaction_id::text, ''), COALESCE(transfer_id::text, ''), user_id,
+				COALESCE(counterparty_user_id, 0), datetime, amount::text, asset
+				FROM idempotency_keys
+					WHERE idempotency_key = ANY($1)`
+
+	rows, err = tx.Query(ctx, query, used)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	originals := make(map[int]*idempotencyClaim, len(used))
+
+	for rows.Next() {
+		var (
+			original     idempotencyClaim
+			amount, code string
+		)
+
+		err = rows.Scan(&original.Key, &original.TransactionID, &original.TransferID, &original.UserID,
+			&original.CounterpartyUserID, &original.Datetime, &amount, &code)
+		if err != nil {
+			return nil, err
+		}
+
+		original.Asset, err = lookupAsset(code)
+		if err != nil {
+			return nil, err
+		}
+
+		original.AmountSats, err = original.Asset.ParseUnits(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		originals[keys[original.Key]] = &original
+	}
+
+	return originals, rows.Err()
+}
+
+// getHeldBalances sets the sum of the pending holds of each asset into the balances of the locked Users.
+// A hold is only created while its User is locked, thus the sum can't grow until the database transaction is done.
+func getHeldBalances(ctx context.Context, tx pgx.Tx, users map[int64]*lockedUser, userIDs []int64) error {
+	// The amount is selected as the exact decimal, since the minor unit depends on the asset of the row.
+	query := `SELECT user_id, asset, SUM(amount)::text
+				FROM holds
+					WHERE user_id = ANY($1) AND status = 'pending' AND expires_at > NOW()
+						GROUP BY user_id, asset`
+
+	rows, err := tx.Query(ctx, query, userIDs)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			userID       int64
+			code, amount string
+		)
+
+		if err = rows.Scan(&userID, &code, &amount); err != nil {
+			return err
+		}
+
+		a, err := lookupAsset(code)
+		if err != nil {
+			return err
+		}
+
+		heldSats, err := a.ParseUnits(amount)
+		if err != nil {
+			return err
+		}
+
+		if user, ok := users[userID]; ok {
+			user.balance(code).HeldSats = heldSats
+		}
+	}
+
+	return rows.Err()
+}
+
+// getChangedBalances get the changed balances of the Users within the given database transaction,
+// and returns the balances by the ID ordered by the asset code.
+// A balance is changed once any entry is applied to it, thus the entries netting to zero still report the balance.
+func getChangedBalances(ctx context.Context, tx pgx.Tx, users map[int64]*lockedUser) (map[int64][]*rpc.UserBalance, error) {
+	var (
+		userIDs []int64
+		assets  []asset.Asset
+	)
+
+	for id, user := range users {
+		for code, balance := range user.Balances {
+			if !balance.Changed {
+				continue
+			}
+
+			a, err := lookupAsset(code)
+			if err != nil {
+				return nil, err
+			}
+
+			userIDs = append(userIDs, id)
+			assets = append(assets, a)
+		}
+	}
+
+	return getUserBalances(ctx, tx, userIDs, assets)
+}
@@ -0,0 +1,329 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBTCRepo_CreateTransactions(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.CreateTransactionsParams
+	}
+
+	type test struct {
+		args         args
+		want         []*repository.CreateTransactionsResult
+		wantBalances map[int64][]*rpc.UserBalance
+		wantErr      error
+		beforeFunc   func(*testing.T)
+		afterFunc    func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	firstUserID, secondUserID := int64(1994), int64(1995)
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM idempotency_keys WHERE user_id IN ($1, $2)", firstUserID, secondUserID)
+		assert.NoError(t, err)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id IN ($1, $2)", firstUserID, secondUserID)
+		assert.NoError(t, err)
+
+		clearLedger(t, firstUserID, secondUserID)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id IN ($1, $2)", firstUserID, secondUserID)
+		assert.NoError(t, err)
+	}
+
+	seed := func(t *testing.T) {
+		t.Helper()
+
+		// Remove existing data, if any.
+		clear(t)
+
+		// Insert test data.
+		_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1), ($2)", firstUserID, secondUserID)
+		assert.NoError(t, err)
+
+		seedBalance(t, firstUserID, "BTC", 10)
+	}
+
+	check := func(t *testing.T, wantFirst, wantSecond float64, wantCount int) {
+		t.Helper()
+
+		var (
+			first, second float64
+			count         int
+		)
+
+		err := db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", firstUserID).Scan(&first)
+		assert.NoError(t, err)
+		assert.Equal(t, wantFirst, first)
+
+		err = db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", secondUserID).Scan(&second)
+		assert.NoError(t, err)
+		assert.Equal(t, wantSecond, second)
+
+		err = db.QueryRow(context.Background(),
+			"SELECT COUNT(*) FROM transactions WHERE user_id IN ($1, $2)", firstUserID, secondUserID,
+		).Scan(&count)
+		assert.NoError(t, err)
+		assert.Equal(t, wantCount, count)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of CreateTransactions, When query executed successfully, Return the created transactions": func(t *testing.T) test {
+			datetime := time.Now().UTC().Truncate(time.Microsecond)
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionsParams{
+					Transactions: []*repository.CreateTransactionParams{
+						{UserID: firstUserID, Datetime: datetime, AmountSats: 150_000_000},
+						{UserID: secondUserID, Datetime: datetime, AmountSats: 100_000_000},
+						// The debit is funded by the previous credit of the batch.
+						{UserID: secondUserID, Datetime: datetime, AmountSats: -50_000_000},
+					},
+				},
+			}
+
+			want := []*repository.CreateTransactionsResult{
+				{Transaction: &rpc.Transaction{UserId: firstUserID, Datetime: timestamppb.New(datetime), Amount: 1.5, AmountSats: 150_000_000, Asset: "BTC"}},
+				{Transaction: &rpc.Transaction{UserId: secondUserID, Datetime: timestamppb.New(datetime), Amount: 1, AmountSats: 100_000_000, Asset: "BTC"}},
+				{Transaction: &rpc.Transaction{UserId: secondUserID, Datetime: timestamppb.New(datetime), Amount: -0.5, AmountSats: -50_000_000, Asset: "BTC"}},
+			}
+
+			wantBalances := map[int64][]*rpc.UserBalance{
+				firstUserID:  {{Balance: 11.5, BalanceSats: 1_150_000_000, Asset: "BTC", AvailableBalance: 11.5, AvailableBalanceSats: 1_150_000_000}},
+				secondUserID: {{Balance: 0.5, BalanceSats: 50_000_000, Asset: "BTC", AvailableBalance: 0.5, AvailableBalanceSats: 50_000_000}},
+			}
+
+			return test{
+				args:         args,
+				want:         want,
+				wantBalances: wantBalances,
+				wantErr:      nil,
+				beforeFunc:   seed,
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					check(t, 11.5, 0.5, 3)
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+		"Given valid query of CreateTransactions with entries netting to zero, When query executed successfully, Return the unchanged balance of the User": func(t *testing.T) test {
+			datetime := time.Now().UTC().Truncate(time.Microsecond)
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionsParams{
+					Transactions: []*repository.CreateTransactionParams{
+						{UserID: firstUserID, Datetime: datetime, AmountSats: 50_000_000},
+						{UserID: firstUserID, Datetime: datetime, AmountSats: -50_000_000},
+					},
+				},
+			}
+
+			want := []*repository.CreateTransactionsResult{
+				{Transaction: &rpc.Transaction{UserId: firstUserID, Datetime: timestamppb.New(datetime), Amount: 0.5, AmountSats: 50_000_000, Asset: "BTC"}},
+				{Transaction: &rpc.Transaction{UserId: firstUserID, Datetime: timestamppb.New(datetime), Amount: -0.5, AmountSats: -50_000_000, Asset: "BTC"}},
+			}
+
+			// The transactions are written, thus the balance is reported even though it nets to the same.
+			wantBalances := map[int64][]*rpc.UserBalance{
+				firstUserID: {{Balance: 10, BalanceSats: 1_000_000_000, Asset: "BTC", AvailableBalance: 10, AvailableBalanceSats: 1_000_000_000}},
+			}
+
+			return test{
+				args:         args,
+				want:         want,
+				wantBalances: wantBalances,
+				wantErr:      nil,
+				beforeFunc:   seed,
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					check(t, 10, 0, 2)
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+		"Given valid query of CreateTransactions with debit overdraws the balance, When query executed, Return an error and create nothing": func(t *testing.T) test {
+			datetime := time.Now().UTC()
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionsParams{
+					Transactions: []*repository.CreateTransactionParams{
+						{UserID: firstUserID, Datetime: datetime, AmountSats: 150_000_000, IdempotencyKey: "idempotency-key-1994"},
+						{UserID: secondUserID, Datetime: datetime, AmountSats: -50_000_000},
+					},
+				},
+			}
+
+			return test{
+				args:       args,
+				want:       nil,
+				wantErr:    datastore.ErrInsufficientBalance,
+				beforeFunc: seed,
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					check(t, 10, 0, 0)
+
+					// Check the idempotency key is not claimed.
+					var count int
+
+					err := db.QueryRow(context.Background(),
+						"SELECT COUNT(*) FROM idempotency_keys WHERE idempotency_key = $1", "idempotency-key-1994",
+					).Scan(&count)
+					assert.NoError(t, err)
+					assert.Equal(t, 0, count)
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+		"Given valid query of CreateTransactions in best-effort mode, When query executed successfully, Return the result of each entry": func(t *testing.T) test {
+			datetime := time.Now().UTC().Truncate(time.Microsecond)
+			idempotencyKey := "idempotency-key-1995"
+			transactionID := "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionsParams{
+					Transactions: []*repository.CreateTransactionParams{
+						{UserID: firstUserID, Datetime: datetime, AmountSats: 1_000_000_000, IdempotencyKey: idempotencyKey},
+						{UserID: secondUserID, Datetime: datetime, AmountSats: -50_000_000, IdempotencyKey: "idempotency-key-1996"},
+						{UserID: 999, Datetime: datetime, AmountSats: 50_000_000},
+						{UserID: secondUserID, Datetime: datetime, AmountSats: 50_000_000},
+					},
+					BestEffort: true,
+				},
+			}
+
+			want := []*repository.CreateTransactionsResult{
+				{Transaction: &rpc.Transaction{Id: transactionID, UserId: firstUserID, Datetime: timestamppb.New(datetime), Amount: 10, AmountSats: 1_000_000_000, Asset: "BTC"}},
+				{Err: datastore.ErrInsufficientBalance},
+				{Err: datastore.ErrNotFound},
+				{Transaction: &rpc.Transaction{UserId: secondUserID, Datetime: timestamppb.New(datetime), Amount: 0.5, AmountSats: 50_000_000, Asset: "BTC"}},
+			}
+
+			wantBalances := map[int64][]*rpc.UserBalance{
+				secondUserID: {{Balance: 0.5, BalanceSats: 50_000_000, Asset: "BTC", AvailableBalance: 0.5, AvailableBalanceSats: 50_000_000}},
+			}
+
+			return test{
+				args:         args,
+				want:         want,
+				wantBalances: wantBalances,
+				wantErr:      nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					seed(t)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", 999)
+					assert.NoError(t, err)
+
+					// The first entry is a replay of a committed transaction.
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO transactions (id, datetime, user_id, amount) VALUES ($1, $2, $3, $4)",
+						transactionID, datetime, firstUserID, 10,
+					)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO idempotency_keys (idempotency_key, transaction_id, user_id, datetime, amount) VALUES ($1, $2, $3, $4, $5)",
+						idempotencyKey, transactionID, firstUserID, datetime, 10,
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					check(t, 10, 0.5, 2)
+
+					// Check the idempotency key of the rejected entry is released.
+					var count int
+
+					err := db.QueryRow(context.Background(),
+						"SELECT COUNT(*) FROM idempotency_keys WHERE idempotency_key = $1", "idempotency-key-1996",
+					).Scan(&count)
+					assert.NoError(t, err)
+					assert.Equal(t, 0, count)
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetBTCRepo()
+
+			got, gotBalances, err := sut.CreateTransactions(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			if !assert.Len(t, got, len(tt.want)) {
+				return
+			}
+
+			for i, result := range got {
+				if tt.want[i].Err != nil {
+					assert.ErrorIs(t, result.Err, tt.want[i].Err)
+					assert.Nil(t, result.Transaction)
+
+					continue
+				}
+
+				assert.NoError(t, result.Err)
+
+				// The ID is generated by server, thus only make sure it's not empty.
+				if tt.want[i].Transaction.Id == "" {
+					assert.NotEmpty(t, result.Transaction.GetId())
+
+					tt.want[i].Transaction.Id = result.Transaction.GetId()
+				}
+
+				assert.Equal(t, tt.want[i].Transaction, result.Transaction)
+			}
+
+			assert.Equal(t, tt.wantBalances, gotBalances)
+		})
+	}
+}
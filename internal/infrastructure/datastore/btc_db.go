@@ -2,22 +2,220 @@ package datastore
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
 	rpc "github.com/moemoe89/btc/api/go/grpc"
 	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/pkg/asset"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
 	// ErrNotFound is an error for indicates record not found.
-	ErrNotFound = errors.New("error not found")
+	ErrNotFound = repository.ErrNotFound
+	// ErrIdempotencyKeyConflict is an error for indicates the idempotency key already used by a different payload.
+	ErrIdempotencyKeyConflict = repository.ErrIdempotencyKeyConflict
+	// ErrInvalidPageToken is an error for indicates the page token can't be decoded.
+	ErrInvalidPageToken = repository.ErrInvalidPageToken
+	// ErrInvalidBucket is an error for indicates the bucket is not in the whitelist.
+	ErrInvalidBucket = repository.ErrInvalidBucket
+	// ErrInsufficientBalance is an error for indicates the debit overdraws the balance of the User.
+	ErrInsufficientBalance = repository.ErrInsufficientBalance
+	// ErrInvalidUserStatus is an error for indicates the User status is unknown.
+	ErrInvalidUserStatus = repository.ErrInvalidUserStatus
+	// ErrAlreadyExists is an error for indicates the record already exists.
+	ErrAlreadyExists = repository.ErrAlreadyExists
+	// ErrUserDeactivated is an error for indicates the User is deactivated.
+	ErrUserDeactivated = repository.ErrUserDeactivated
+	// ErrAlreadyReversed is an error for indicates the transaction is already reversed.
+	ErrAlreadyReversed = repository.ErrAlreadyReversed
+	// ErrInvalidReversal is an error for indicates the transaction can't be reversed.
+	ErrInvalidReversal = repository.ErrInvalidReversal
+	// ErrInvalidAsset is an error for indicates the asset is not in the registry.
+	ErrInvalidAsset = repository.ErrInvalidAsset
+	// ErrInvalidMetadata is an error for indicates the type, description, external reference or labels of the transaction is invalid.
+	ErrInvalidMetadata = repository.ErrInvalidMetadata
+	// ErrPriceNotFound is an error for indicates there's no price of the asset in the quote currency.
+	ErrPriceNotFound = repository.ErrPriceNotFound
+	// ErrInvalidHold is an error for indicates the deadline of a hold or the captured amount is invalid.
+	ErrInvalidHold = repository.ErrInvalidHold
+	// ErrHoldSettled is an error for indicates the hold is already captured or released.
+	ErrHoldSettled = repository.ErrHoldSettled
+	// ErrHoldExpired is an error for indicates the deadline of the hold passed before it's settled.
+	ErrHoldExpired = repository.ErrHoldExpired
+	// ErrInvalidSchedule is an error for indicates the interval, the start or the end of a schedule is invalid.
+	ErrInvalidSchedule = repository.ErrInvalidSchedule
+	// ErrScheduleFinished is an error for indicates the schedule is already completed or cancelled.
+	ErrScheduleFinished = repository.ErrScheduleFinished
 )
 
+// lookupAsset returns the registered asset by the code, the empty code is the default asset.
+func lookupAsset(code string) (asset.Asset, error) {
+	a, err := asset.Lookup(code)
+	if err != nil {
+		return asset.Asset{}, fmt.Errorf("asset: %s: %w", code, ErrInvalidAsset)
+	}
+
+	return a, nil
+}
+
+// transactionTypeFee is the stored type of a fee, it's posted to the fee account.
+const transactionTypeFee = "fee"
+
+// transactionTypes maps the stored transaction type into proto enum.
+var transactionTypes = map[string]rpc.TransactionType{
+	"deposit":          rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+	"withdrawal":       rpc.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+	transactionTypeFee: rpc.TransactionType_TRANSACTION_TYPE_FEE,
+	"adjustment":       rpc.TransactionType_TRANSACTION_TYPE_ADJUSTMENT,
+}
+
+// transactionTypeName returns the stored transaction type of the proto enum, empty for the unspecified type.
+func transactionTypeName(t rpc.TransactionType) (string, error) {
+	if t == rpc.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+		return "", nil
+	}
+
+	for name, v := range transactionTypes {
+		if v == t {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("type: %s: %w", t, ErrInvalidMetadata)
+}
+
+// data is a struct for scanning a transaction row.
+type data struct {
+	ID         string
+	Datetime   time.Time
+	UserID     int64
+	AmountSats int64
+	TransferID string
+	Asset      string
+
+	ReversedID     string
+	ReversalReason string
+
+	Type        string
+	Description string
+	ExternalRef string
+	Labels      map[string]string
+
+	// JournalEntryID is the journal entry of the transaction in the ledger, it's only set while creating the transaction.
+	JournalEntryID string
+}
+
+// transactionColumns is the selected columns for scanning a transaction row.
+// The amount is selected as the exact decimal, since the minor unit depends on the asset of the row.
+const transactionColumns = `id::text, datetime, user_id, amount::text, asset, COALESCE(transfer_id::text, ''),
+	COALESCE(reversed_id::text, ''), COALESCE(reversal_reason, ''),
+	COALESCE(type, ''), COALESCE(description, ''), COALESCE(external_ref, ''), labels`
+
+// scan scans the transactionColumns of the row.
+func (d *data) scan(row pgx.Row) error {
+	var amount string
+
+	err := row.Scan(&d.ID, &d.Datetime, &d.UserID, &amount, &d.Asset, &d.TransferID, &d.ReversedID, &d.ReversalReason,
+		&d.Type, &d.Description, &d.ExternalRef, &d.Labels)
+	if err != nil {
+		return err
+	}
+
+	a, err := lookupAsset(d.Asset)
+	if err != nil {
+		return err
+	}
+
+	d.AmountSats, err = a.ParseUnits(amount)
+
+	return err
+}
+
+// toProto converts the scanned transaction row into proto message.
+// The empty asset is the default asset.
+func (d *data) toProto() *rpc.Transaction {
+	a, _ := asset.Lookup(d.Asset)
+
+	transaction := &rpc.Transaction{
+		Id:         d.ID,
+		UserId:     d.UserID,
+		Datetime:   timestamppb.New(d.Datetime),
+		Amount:     a.ToUnits(d.AmountSats),
+		AmountSats: d.AmountSats,
+		TransferId: d.TransferID,
+		Asset:      a.Code,
+
+		ReversedId:     d.ReversedID,
+		ReversalReason: d.ReversalReason,
+
+		Type:        transactionTypes[d.Type],
+		Description: d.Description,
+		ExternalRef: d.ExternalRef,
+	}
+
+	// The empty labels are left unset, the same as a transaction without labels.
+	if len(d.Labels) > 0 {
+		transaction.Labels = d.Labels
+	}
+
+	return transaction
+}
+
+// newData returns the transaction row of the params with the generated ID.
+// The type is converted into the stored type.
+func newData(id string, params *repository.CreateTransactionParams, a asset.Asset) (data, error) {
+	typeName, err := transactionTypeName(params.Type)
+	if err != nil {
+		return data{}, err
+	}
+
+	return data{
+		ID:             id,
+		JournalEntryID: uuid.NewString(),
+		Datetime:       params.Datetime,
+		UserID:         params.UserID,
+		AmountSats:     params.AmountSats,
+		Asset:          a.Code,
+		Type:           typeName,
+		Description:    params.Description,
+		ExternalRef:    params.ExternalRef,
+		Labels:         params.Labels,
+	}, nil
+}
+
+// labelsValue returns the labels to store, the missing labels are stored as an empty object.
+func (d *data) labelsValue() map[string]string {
+	if d.Labels == nil {
+		return map[string]string{}
+	}
+
+	return d.Labels
+}
+
+// getReplayedTransaction get the original transaction of the claim within the given database transaction.
+// The datetime of the claim narrows the lookup into a single chunk.
+func getReplayedTransaction(ctx context.Context, tx pgx.Tx, claim *idempotencyClaim) (*rpc.Transaction, error) {
+	var d data
+
+	query := `SELECT ` + transactionColumns + ` FROM transactions WHERE id = $1 AND datetime = $2`
+
+	err := d.scan(tx.QueryRow(ctx, query, claim.TransactionID, claim.Datetime))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("transaction id: %s not found: %w", claim.TransactionID, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d.toProto(), nil
+}
+
 type btcRepo struct {
 	*BaseRepo
 }
@@ -31,23 +229,23 @@ func NewBTCRepo(base *BaseRepo) repository.BTCRepo {
 
 // CreateTransaction creates a new record for BTC transaction.
 // Only single transaction will create by this RPC for a specific User.
-func (r *btcRepo) CreateTransaction(ctx context.Context, params *repository.CreateTransactionParams) (*rpc.Transaction, error) {
-	var id int64
-
-	var err error
-
-	err = r.dbSlave.QueryRow(ctx, "SELECT id FROM users WHERE id = $1", params.UserID).Scan(&id)
-	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("user id: %d not found: %w", params.UserID, ErrNotFound)
+func (r *btcRepo) CreateTransaction(
+	ctx context.Context, params *repository.CreateTransactionParams,
+) (*rpc.Transaction, *rpc.UserBalance, error) {
+	a, err := lookupAsset(params.Asset)
+	if err != nil {
+		return nil, nil, err
 	}
 
+	// The ID is generated before the insert, thus it can be stored together with the idempotency key.
+	d, err := newData(uuid.NewString(), params, a)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	tx, err := r.dbMaster.Begin(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to begin transaction: %v", err)
+		return nil, nil, fmt.Errorf("unable to begin transaction: %v", err)
 	}
 
 	defer func() {
@@ -57,86 +255,514 @@ func (r *btcRepo) CreateTransaction(ctx context.Context, params *repository.Crea
 		}
 	}()
 
-	query := `INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)`
+	// Lock the User before the idempotency key, the same order as the batch and the transfer,
+	// thus the concurrent writes sharing the User and the key can't deadlock.
+	var status string
+
+	err = tx.QueryRow(ctx, "SELECT status FROM users WHERE id = $1 FOR UPDATE", params.UserID).Scan(&status)
+	if err == pgx.ErrNoRows {
+		err = fmt.Errorf("user id: %d not found: %w", params.UserID, ErrNotFound)
+
+		return nil, nil, err
+	}
 
-	_, err = tx.Exec(ctx, query, params.Datetime, params.UserID, params.Amount)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	query = `UPDATE users SET balance = balance + $1 WHERE id = $2`
+	if status == userStatusDeactivated {
+		err = fmt.Errorf("user id: %d: %w", params.UserID, ErrUserDeactivated)
+
+		return nil, nil, err
+	}
 
-	_, err = tx.Exec(ctx, query, params.Amount, params.UserID)
+	if params.IdempotencyKey != "" {
+		var original *idempotencyClaim
+
+		original, err = r.claimIdempotencyKey(ctx, tx, &idempotencyClaim{
+			Key:           params.IdempotencyKey,
+			TransactionID: d.ID,
+			UserID:        params.UserID,
+			Datetime:      params.Datetime,
+			AmountSats:    params.AmountSats,
+			Asset:         a,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The key was already used by the same payload, returns the original transaction
+		// and leave the balance untouched.
+		if original != nil {
+			var transaction *rpc.Transaction
+
+			transaction, err = getReplayedTransaction(ctx, tx, original)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			_ = tx.Rollback(ctx)
+
+			return transaction, nil, nil
+		}
+	}
+
+	// The amount is stored in units of the asset, the conversion from minor units is done by the database to keep it exact.
+	query := `INSERT INTO transactions (id, datetime, user_id, amount, asset, type, description, external_ref, labels, journal_entry_id)
+				VALUES ($1, $2, $3, $4::bigint / $5::numeric, $6, NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, ''), $10, $11)`
+
+	_, err = tx.Exec(ctx, query,
+		d.ID, d.Datetime, d.UserID, d.AmountSats, a.Scale(), a.Code, d.Type, d.Description, d.ExternalRef, d.labelsValue(),
+		d.JournalEntryID,
+	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// The balance is derived from the postings, a debit that overdraws the balance is rejected by the ledger.
+	err = postJournalEntries(ctx, tx, []*journalEntry{transactionEntry(journalKindTransaction, &d, a, d.Type)})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balance, err := getUserBalance(ctx, tx, d.UserID, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transaction := d.toProto()
+
+	err = insertOutboxEvents(ctx, tx, []*rpc.Transaction{transaction}, map[int64][]*rpc.UserBalance{d.UserID: {balance}})
+	if err != nil {
+		return nil, nil, err
 	}
 
 	errCommit := tx.Commit(ctx)
 	if errCommit != nil {
-		return nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
+		return nil, nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
 	}
 
-	return &rpc.Transaction{
-		UserId:   params.UserID,
-		Datetime: timestamppb.New(params.Datetime),
-		Amount:   params.Amount,
-	}, nil
+	return transaction, balance, nil
+}
+
+// toUserBalance converts the balance and the pending holds in minor units of the asset into proto message.
+func toUserBalance(a asset.Asset, balanceSats, heldSats int64) *rpc.UserBalance {
+	return &rpc.UserBalance{
+		Balance:              a.ToUnits(balanceSats),
+		BalanceSats:          balanceSats,
+		Asset:                a.Code,
+		AvailableBalance:     a.ToUnits(balanceSats - heldSats),
+		AvailableBalanceSats: balanceSats - heldSats,
+	}
+}
+
+// idempotencyClaim is the payload stored together with the idempotency key.
+// The TransferID and CounterpartyUserID are only set for a transfer.
+type idempotencyClaim struct {
+	Key                string
+	TransactionID      string
+	TransferID         string
+	UserID             int64
+	CounterpartyUserID int64
+	Datetime           time.Time
+	AmountSats         int64
+	Asset              asset.Asset
+}
+
+// claimIdempotencyKey stores the idempotency key within the given database transaction.
+// If the key already exists, returns the original claim when the payload is the same,
+// otherwise returns ErrIdempotencyKeyConflict.
+// Returns nil claim and nil error when the key is claimed for the first time.
+func (r *btcRepo) claimIdempotencyKey(ctx context.Context, tx pgx.Tx, claim *idempotencyClaim) (*idempotencyClaim, error) {
+	// The unique constraint makes a concurrent request with the same key wait until this one is done.
+	query := `INSERT INTO idempotency_keys (idempotency_key, transaction_id, transfer_id, user_id, counterparty_user_id, datetime, amount, asset)
+				VALUES ($1, $2, NULLIF($3, '')::uuid, $4, NULLIF($5::bigint, 0), $6, $7::bigint / $8::numeric, $9)
+					ON CONFLICT (idempotency_key) DO NOTHING`
+
+	tag, err := tx.Exec(ctx, query,
+		claim.Key, claim.TransactionID, claim.TransferID, claim.UserID, claim.CounterpartyUserID, claim.Datetime, claim.AmountSats,
+		claim.Asset.Scale(), claim.Asset.Code,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if tag.RowsAffected() == 1 {
+		return nil, nil
+	}
+
+	var (
+		original = &idempotencyClaim{Key: claim.Key, Asset: claim.Asset}
+		same     bool
+	)
+
+	// The key is shared with the transaction and the transfer,
+	// thus a key used by a transaction is a conflict for a transfer and the other way around.
+	query = `SELECT COALESCE(transaction_id::text, ''), COALESCE(transfer_id::text, ''), user_id, COALESCE(counterparty_user_id, 0),
+				datetime, (amount * $6::numeric)::bigint,
+				(user_id = $2 AND counterparty_user_id IS NOT DISTINCT FROM NULLIF($3::bigint, 0)
+					AND datetime = $4 AND amount = $5::bigint / $6::numeric AND asset = $7) AS same
+				FROM idempotency_keys
+					WHERE idempotency_key = $1`
+
+	// The original amount is only meaningful when it's the same asset, otherwise it's a conflict anyway.
+	err = tx.QueryRow(ctx, query,
+		claim.Key, claim.UserID, claim.CounterpartyUserID, claim.Datetime, claim.AmountSats, claim.Asset.Scale(), claim.Asset.Code,
+	).
+		Scan(&original.TransactionID, &original.TransferID, &original.UserID, &original.CounterpartyUserID,
+			&original.Datetime, &original.AmountSats, &same)
+	if err != nil {
+		return nil, err
+	}
+
+	if !same {
+		return nil, fmt.Errorf("idempotency key: %s: %w", claim.Key, ErrIdempotencyKeyConflict)
+	}
+
+	return original, nil
+}
+
+// FindIdempotencyKeys returns the keys that are already used by a committed transaction or transfer.
+// The master is queried, since a lagging replica would miss the key of a replay.
+func (r *btcRepo) FindIdempotencyKeys(ctx context.Context, keys []string) (map[string]bool, error) {
+	used := make(map[string]bool)
+
+	if len(keys) == 0 {
+		return used, nil
+	}
+
+	rows, err := r.dbMaster.Query(ctx, "SELECT idempotency_key FROM idempotency_keys WHERE idempotency_key = ANY($1)", keys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+
+		if err = rows.Scan(&key); err != nil {
+			return nil, err
+		}
+
+		used[key] = true
+	}
+
+	return used, rows.Err()
+}
+
+// bucketIntervals is the whitelist of bucket intervals for aggregating the transactions.
+// The interval is used for TimescaleDB time_bucket function.
+var bucketIntervals = map[rpc.Bucket]time.Duration{
+	rpc.Bucket_BUCKET_UNSPECIFIED:     time.Hour,
+	rpc.Bucket_BUCKET_MINUTE:          time.Minute,
+	rpc.Bucket_BUCKET_FIFTEEN_MINUTES: 15 * time.Minute,
+	rpc.Bucket_BUCKET_HOUR:            time.Hour,
+	rpc.Bucket_BUCKET_DAY:             24 * time.Hour,
+	rpc.Bucket_BUCKET_WEEK:            7 * 24 * time.Hour,
 }
 
 // ListTransaction get the list of records for BTC transaction.
 // The record can be filtered by specific User.
-func (r *btcRepo) ListTransaction(ctx context.Context, params *repository.ListTransactionParams) ([]*rpc.Transaction, error) {
-	query := `SELECT date_trunc('hour', datetime) as datetime, user_id, SUM(amount) AS amount
+// Returns the token of the next page, empty if there are no more records.
+func (r *btcRepo) ListTransaction(ctx context.Context, params *repository.ListTransactionParams) ([]*rpc.Transaction, string, error) {
+	var cursor pageCursor
+
+	a, err := lookupAsset(params.Asset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if params.PageToken != "" {
+		cursor, err = decodePageToken(params.PageToken)
+		if err != nil {
+			return nil, "", fmt.Errorf("page token: %s: %w", params.PageToken, ErrInvalidPageToken)
+		}
+	}
+
+	if params.Bucket == rpc.Bucket_BUCKET_RAW {
+		return r.listRawTransaction(ctx, params, a, cursor)
+	}
+
+	// The aggregated bucket mixes the transactions of all types and references, thus it can't be filtered by them.
+	if params.Type != rpc.TransactionType_TRANSACTION_TYPE_UNSPECIFIED || params.ExternalRef != "" {
+		return nil, "", fmt.Errorf("bucket: %s can't be filtered by type or external_ref: %w", params.Bucket, ErrInvalidBucket)
+	}
+
+	interval, ok := bucketIntervals[params.Bucket]
+	if !ok {
+		return nil, "", fmt.Errorf("bucket: %s: %w", params.Bucket, ErrInvalidBucket)
+	}
+
+	startDatetime := params.StartDatetime
+
+	// The cursor is the last returned bucket, thus the next page starts from the next bucket.
+	// Since it's keyed on the bucket, newly arrived rows won't shift the page.
+	if params.PageToken != "" {
+		if next := cursor.Datetime.Add(interval); next.After(startDatetime) {
+			startDatetime = next
+		}
+	}
+
+	limit := pageLimit(params.PageSize)
+
+	// The sum is done in exact decimal and converted into minor units, thus it doesn't drift on large histories.
+	query := `SELECT time_bucket($4::interval, datetime) AS bucket, user_id, (SUM(amount) * $6::numeric)::bigint AS amount
 				FROM transactions
-					WHERE user_id = $1 AND datetime >= $2::timestamptz AND datetime <= $3::timestamptz
-						GROUP BY datetime, user_id`
+					WHERE user_id = $1 AND asset = $7 AND datetime >= $2::timestamptz AND datetime <= $3::timestamptz
+						GROUP BY bucket, user_id
+							ORDER BY bucket
+								LIMIT $5`
 
-	rows, err := r.dbSlave.Query(ctx, query, params.UserID, params.StartDatetime, params.EndDatetime)
+	// Query one more record to know whether there's a next page.
+	rows, err := r.dbSlave.Query(ctx, query,
+		params.UserID, startDatetime, params.EndDatetime, interval, limit+1, a.Scale(), a.Code,
+	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer rows.Close()
 
-	type data struct {
-		Datetime time.Time
-		UserID   int64
-		Amount   float64
+	var transactions []*rpc.Transaction
+
+	for rows.Next() {
+		d := data{Asset: a.Code}
+
+		err = rows.Scan(&d.Datetime, &d.UserID, &d.AmountSats)
+		if err != nil {
+			return nil, "", err
+		}
+
+		transactions = append(transactions, d.toProto())
 	}
 
+	// A read error ends the rows early, thus the page would look like the last page.
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	return nextPage(transactions, limit)
+}
+
+// listRawTransaction get the list of un-aggregated records for BTC transaction.
+// The records are ordered by datetime and ID, thus the cursor stays stable for the same datetime.
+func (r *btcRepo) listRawTransaction(
+	ctx context.Context, params *repository.ListTransactionParams, a asset.Asset, cursor pageCursor,
+) ([]*rpc.Transaction, string, error) {
+	// Without cursor, starts from the start datetime with the smallest ID.
+	if cursor.Datetime.IsZero() || cursor.Datetime.Before(params.StartDatetime) {
+		cursor = pageCursor{Datetime: params.StartDatetime, ID: uuid.Nil.String()}
+	}
+
+	if cursor.ID == "" {
+		cursor.ID = uuid.Nil.String()
+	}
+
+	typeName, err := transactionTypeName(params.Type)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := pageLimit(params.PageSize)
+
+	// The empty type and external reference match all transactions.
+	query := `SELECT ` + transactionColumns + `
+				FROM transactions
+					WHERE user_id = $1 AND asset = $7 AND datetime >= $2::timestamptz AND datetime <= $3::timestamptz
+						AND (datetime, id) > ($4::timestamptz, $5::uuid)
+						AND ($8::text = '' OR type = $8) AND ($9::text = '' OR external_ref = $9)
+							ORDER BY datetime, id
+								LIMIT $6`
+
+	// Query one more record to know whether there's a next page.
+	rows, err := r.dbSlave.Query(ctx, query,
+		params.UserID, params.StartDatetime, params.EndDatetime, cursor.Datetime, cursor.ID, limit+1, a.Code,
+		typeName, params.ExternalRef,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
 	var transactions []*rpc.Transaction
 
 	for rows.Next() {
 		var d data
 
-		err = rows.Scan(&d.Datetime, &d.UserID, &d.Amount)
+		err = d.scan(rows)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
-		transactions = append(transactions, &rpc.Transaction{
-			UserId:   d.UserID,
-			Datetime: timestamppb.New(d.Datetime),
-			Amount:   d.Amount,
-		})
+		transactions = append(transactions, d.toProto())
+	}
+
+	// A read error ends the rows early, thus the page would look like the last page.
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	return nextPage(transactions, limit)
+}
+
+// nextPage trims the records into the page limit and returns the token of the next page, if any.
+func nextPage(transactions []*rpc.Transaction, limit int) ([]*rpc.Transaction, string, error) {
+	if len(transactions) <= limit {
+		return transactions, "", nil
+	}
+
+	transactions = transactions[:limit]
+	last := transactions[limit-1]
+
+	return transactions, encodePageToken(pageCursor{
+		Datetime: last.GetDatetime().AsTime(),
+		ID:       last.GetId(),
+	}), nil
+}
+
+// GetTransaction get a single record of BTC transaction by the ID.
+func (r *btcRepo) GetTransaction(ctx context.Context, id string) (*rpc.Transaction, error) {
+	var d data
+
+	query := `SELECT ` + transactionColumns + ` FROM transactions WHERE id = $1`
+
+	err := d.scan(r.dbSlave.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("transaction id: %s not found: %w", id, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
 	}
 
-	return transactions, nil
+	return d.toProto(), nil
 }
 
 // GetUserBalance get the latest balance for a specific User.
-func (r *btcRepo) GetUserBalance(ctx context.Context, userID int64) (*rpc.UserBalance, error) {
-	var balance float64
+// If the AsOf is set, get the balance at that point in time instead.
+func (r *btcRepo) GetUserBalance(ctx context.Context, params *repository.GetUserBalanceParams) (*rpc.UserBalance, error) {
+	a, err := lookupAsset(params.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.AsOf.IsZero() {
+		return r.getUserBalanceAsOf(ctx, params, a)
+	}
+
+	var balanceSats, heldSats int64
 
-	err := r.dbSlave.QueryRow(ctx, "SELECT balance FROM users WHERE id = $1", userID).Scan(&balance)
+	// The account_balance is the sum of the postings of the wallet account of the User.
+	// The held_amount is the sum of the pending holds, which aren't expired yet.
+	query := `SELECT (account_balance(u.id, $3) * $2::numeric)::bigint, (held_amount(u.id, $3) * $2::numeric)::bigint
+				FROM users u
+					WHERE u.id = $1`
+
+	err = r.dbSlave.QueryRow(ctx, query, params.UserID, a.Scale(), a.Code).Scan(&balanceSats, &heldSats)
 	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("user id: %d not found: %w", userID, ErrNotFound)
+		return nil, fmt.Errorf("user id: %d not found: %w", params.UserID, ErrNotFound)
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &rpc.UserBalance{
-		Balance: balance,
-	}, nil
+	return toUserBalance(a, balanceSats, heldSats), nil
+}
+
+// getUserBalanceAsOf get the balance for a specific User at the point in time.
+// The balance is the sum of the transactions up to and including the AsOf,
+// thus it also covers the backdated transactions.
+// The holds aren't kept in the history, thus the available balance is the same as the balance.
+func (r *btcRepo) getUserBalanceAsOf(
+	ctx context.Context, params *repository.GetUserBalanceParams, a asset.Asset,
+) (*rpc.UserBalance, error) {
+	var balanceSats int64
+
+	query := `SELECT (COALESCE((
+					SELECT SUM(amount) FROM transactions WHERE user_id = u.id AND asset = $3 AND datetime <= $2::timestamptz
+				), 0) * $4::numeric)::bigint
+				FROM users u
+					WHERE u.id = $1`
+
+	err := r.dbSlave.QueryRow(ctx, query, params.UserID, params.AsOf, a.Code, a.Scale()).Scan(&balanceSats)
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("user id: %d not found: %w", params.UserID, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserBalance(a, balanceSats, 0), nil
+}
+
+// GetBalanceHistory get the closing balance at the end of each bucket for a specific User.
+// The buckets without transactions are omitted, since the balance is unchanged.
+func (r *btcRepo) GetBalanceHistory(ctx context.Context, params *repository.GetBalanceHistoryParams) ([]*rpc.BalancePoint, error) {
+	interval, ok := bucketIntervals[params.Bucket]
+	if !ok {
+		return nil, fmt.Errorf("bucket: %s: %w", params.Bucket, ErrInvalidBucket)
+	}
+
+	a, err := lookupAsset(params.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	var id int64
+
+	err = r.dbSlave.QueryRow(ctx, "SELECT id FROM users WHERE id = $1", params.UserID).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("user id: %d not found: %w", params.UserID, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The running balance is the opening balance before the range start,
+	// plus the cumulative sum of the bucket deltas within the range.
+	query := `WITH opening AS (
+					SELECT COALESCE(SUM(amount), 0) AS balance
+						FROM transactions
+							WHERE user_id = $1 AND asset = $5 AND datetime < $2::timestamptz
+				), deltas AS (
+					SELECT time_bucket($4::interval, datetime) AS bucket, SUM(amount) AS amount
+						FROM transactions
+							WHERE user_id = $1 AND asset = $5 AND datetime >= $2::timestamptz AND datetime <= $3::timestamptz
+								GROUP BY bucket
+				)
+				SELECT bucket, (((SELECT balance FROM opening) + SUM(amount) OVER (ORDER BY bucket)) * $6::numeric)::bigint
+					FROM deltas
+						ORDER BY bucket`
+
+	rows, err := r.dbSlave.Query(ctx, query,
+		params.UserID, params.StartDatetime, params.EndDatetime, interval, a.Code, a.Scale(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var balances []*rpc.BalancePoint
+
+	for rows.Next() {
+		var (
+			datetime    time.Time
+			balanceSats int64
+		)
+
+		err = rows.Scan(&datetime, &balanceSats)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, &rpc.BalancePoint{
+			Datetime:    timestamppb.New(datetime),
+			Balance:     a.ToUnits(balanceSats),
+			BalanceSats: balanceSats,
+		})
+	}
+
+	return balances, rows.Err()
 }
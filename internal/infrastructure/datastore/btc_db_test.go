@@ -2,6 +2,7 @@ package datastore_test
 
 import (
 	"context"
+	"encoding/base64"
 	"os"
 	"testing"
 	"time"
@@ -34,6 +35,1311 @@ func TestBTCRepo_CreateTransaction(t *testing.T) {
 		params *repository.CreateTransactionParams
 	}
 
+	type test struct {
+		args        args
+		want        *rpc.Transaction
+		wantBalance *rpc.UserBalance
+		wantErr     error
+		beforeFunc  func(*testing.T)
+		afterFunc   func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Create transaction, When query executed successfully, Return no error": func(t *testing.T) test {
+			userID := int64(1988)
+			amount := 100.5
+			amountSats := int64(10_050_000_000)
+			datetime := time.Now().UTC()
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:     userID,
+					Datetime:   datetime,
+					AmountSats: amountSats,
+				},
+			}
+
+			want := &rpc.Transaction{
+				UserId:     userID,
+				Datetime:   timestamppb.New(datetime),
+				Amount:     amount,
+				AmountSats: amountSats,
+				Asset:      "BTC",
+			}
+
+			wantBalance := &rpc.UserBalance{
+				Balance:              amount,
+				BalanceSats:          amountSats,
+				Asset:                "BTC",
+				AvailableBalance:     amount,
+				AvailableBalanceSats: amountSats,
+			}
+
+			return test{
+				args:        args,
+				want:        want,
+				wantBalance: wantBalance,
+				wantErr:     nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clearLedger(t, userID)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check accumulated balance after insert transaction.
+					var balance float64
+
+					err := db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+					assert.NoError(t, err)
+					assert.Equal(t, amount, balance)
+
+					// Clear data.
+					_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Create transaction with used Idempotency key, When query executed successfully, Return the original transaction": func(t *testing.T) test {
+			userID := int64(1988)
+			amount := 100.5
+			amountSats := int64(10_050_000_000)
+			datetime := time.Now().UTC().Truncate(time.Microsecond)
+			idempotencyKey := "idempotency-key-1988"
+			transactionID := "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a"
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:         userID,
+					Datetime:       datetime,
+					AmountSats:     amountSats,
+					IdempotencyKey: idempotencyKey,
+				},
+			}
+
+			want := &rpc.Transaction{
+				Id:         transactionID,
+				UserId:     userID,
+				Datetime:   timestamppb.New(datetime),
+				Amount:     amount,
+				AmountSats: amountSats,
+				Asset:      "BTC",
+			}
+
+			return test{
+				args:    args,
+				want:    want,
+				wantErr: nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM idempotency_keys WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data as the first request already committed.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					seedBalance(t, userID, "BTC", amount)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO transactions (id, datetime, user_id, amount) VALUES ($1, $2, $3, $4)",
+						transactionID, datetime, userID, amount,
+					)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO idempotency_keys (idempotency_key, transaction_id, user_id, datetime, amount) VALUES ($1, $2, $3, $4, $5)",
+						idempotencyKey, transactionID, userID, datetime, amount,
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the balance is not accumulated twice.
+					var balance float64
+
+					err := db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+					assert.NoError(t, err)
+					assert.Equal(t, amount, balance)
+
+					var count int
+
+					err = db.QueryRow(context.Background(), "SELECT COUNT(*) FROM transactions WHERE user_id = $1", userID).Scan(&count)
+					assert.NoError(t, err)
+					assert.Equal(t, 1, count)
+
+					// Check the used key is found, thus the replay isn't counted by the limits again.
+					used, err := di.GetBTCRepo().FindIdempotencyKeys(context.Background(), []string{idempotencyKey, "idempotency-key-unused"})
+					assert.NoError(t, err)
+					assert.Equal(t, map[string]bool{idempotencyKey: true}, used)
+
+					// Clear data.
+					_, err = db.Exec(context.Background(), "DELETE FROM idempotency_keys WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Create transaction with Idempotency key used by a different payload, When query executed, Return an error": func(t *testing.T) test {
+			userID := int64(1988)
+			amount := 100.5
+			amountSats := int64(10_050_000_000)
+			datetime := time.Now().UTC().Truncate(time.Microsecond)
+			idempotencyKey := "idempotency-key-1988"
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:         userID,
+					Datetime:       datetime,
+					AmountSats:     amountSats + 1,
+					IdempotencyKey: idempotencyKey,
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrIdempotencyKeyConflict,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM idempotency_keys WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					seedBalance(t, userID, "BTC", amount)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO idempotency_keys (idempotency_key, user_id, datetime, amount) VALUES ($1, $2, $3, $4)",
+						idempotencyKey, userID, datetime, amount,
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the balance is untouched.
+					var balance float64
+
+					err := db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+					assert.NoError(t, err)
+					assert.Equal(t, amount, balance)
+
+					// Clear data.
+					_, err = db.Exec(context.Background(), "DELETE FROM idempotency_keys WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Create transaction, When query executed successfully with no User found, Return an error": func(t *testing.T) test {
+			userID := int64(999)
+			amountSats := int64(10_050_000_000)
+			datetime := time.Now().UTC()
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:     userID,
+					Datetime:   datetime,
+					AmountSats: amountSats,
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrNotFound,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					clearLedger(t, userID)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Create transaction for deactivated User, When query executed, Return an error": func(t *testing.T) test {
+			userID := int64(1987)
+			datetime := time.Now().UTC()
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:     userID,
+					Datetime:   datetime,
+					AmountSats: 10_050_000_000,
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrUserDeactivated,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clearLedger(t, userID)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO users (id, status, deactivated_at) VALUES ($1, $2, NOW())", userID, "deactivated",
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check no transaction is created.
+					var count int
+
+					err := db.QueryRow(context.Background(), "SELECT COUNT(*) FROM transactions WHERE user_id = $1", userID).Scan(&count)
+					assert.NoError(t, err)
+					assert.Equal(t, 0, count)
+
+					// Clear data.
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Create transaction with debit overdraws the balance, When query executed, Return an error": func(t *testing.T) test {
+			userID := int64(1988)
+			amount := 100.5
+			datetime := time.Now().UTC()
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:     userID,
+					Datetime:   datetime,
+					AmountSats: -10_050_000_001,
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrInsufficientBalance,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					seedBalance(t, userID, "BTC", amount)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the balance and transactions are untouched.
+					var balance float64
+
+					err := db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+					assert.NoError(t, err)
+					assert.Equal(t, amount, balance)
+
+					var count int
+
+					err = db.QueryRow(context.Background(), "SELECT COUNT(*) FROM transactions WHERE user_id = $1", userID).Scan(&count)
+					assert.NoError(t, err)
+					assert.Equal(t, 0, count)
+
+					// Clear data.
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Create transaction with debit within the overdraft limit, When query executed successfully, Return no error": func(t *testing.T) test {
+			userID := int64(1988)
+			amountSats := int64(-10_050_000_000)
+			datetime := time.Now().UTC()
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:     userID,
+					Datetime:   datetime,
+					AmountSats: amountSats,
+				},
+			}
+
+			want := &rpc.Transaction{
+				UserId:     userID,
+				Datetime:   timestamppb.New(datetime),
+				Amount:     -100.5,
+				AmountSats: amountSats,
+				Asset:      "BTC",
+			}
+
+			wantBalance := &rpc.UserBalance{
+				Balance:              -100.5,
+				BalanceSats:          amountSats,
+				Asset:                "BTC",
+				AvailableBalance:     -100.5,
+				AvailableBalanceSats: amountSats,
+			}
+
+			return test{
+				args:        args,
+				want:        want,
+				wantBalance: wantBalance,
+				wantErr:     nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id, overdraft_limit) VALUES ($1, $2)", userID, 200)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the balance is below zero.
+					var balance float64
+
+					err := db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+					assert.NoError(t, err)
+					assert.Equal(t, -100.5, balance)
+
+					// Clear data.
+					_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		}, "Given valid query of Create transaction in ETH, When query executed successfully, Return the balance of ETH": func(t *testing.T) test {
+			userID := int64(1988)
+			datetime := time.Now().UTC()
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:     userID,
+					Datetime:   datetime,
+					AmountSats: 1_500_000_001,
+					Asset:      "ETH",
+				},
+			}
+
+			want := &rpc.Transaction{
+				UserId:     userID,
+				Datetime:   timestamppb.New(datetime),
+				Amount:     1.500000001,
+				AmountSats: 1_500_000_001,
+				Asset:      "ETH",
+			}
+
+			wantBalance := &rpc.UserBalance{
+				Balance:              1.500000001,
+				BalanceSats:          1_500_000_001,
+				Asset:                "ETH",
+				AvailableBalance:     1.500000001,
+				AvailableBalanceSats: 1_500_000_001,
+			}
+
+			return test{
+				args:        args,
+				want:        want,
+				wantBalance: wantBalance,
+				wantErr:     nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clearLedger(t, userID)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					seedBalance(t, userID, "BTC", 10)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the BTC balance is untouched.
+					var balance float64
+
+					err := db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+					assert.NoError(t, err)
+					assert.Equal(t, float64(10), balance)
+
+					// Clear data.
+					_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Create transaction with debit in USDT, When the User has no USDT, Return insufficient balance error": func(t *testing.T) test {
+			userID := int64(1988)
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:     userID,
+					Datetime:   time.Now().UTC(),
+					AmountSats: -1_000_000,
+					Asset:      "USDT",
+				},
+			}
+
+			return test{
+				args:    args,
+				wantErr: datastore.ErrInsufficientBalance,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clearLedger(t, userID)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// The overdraft limit only applies to BTC.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id, overdraft_limit) VALUES ($1, $2)", userID, 1000)
+					assert.NoError(t, err)
+
+					seedBalance(t, userID, "BTC", 10)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Clear data.
+					clearLedger(t, userID)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Create transaction with metadata, When query executed successfully, Return the transaction with the metadata": func(t *testing.T) test {
+			userID := int64(1988)
+			amount := 100.5
+			amountSats := int64(10_050_000_000)
+			datetime := time.Now().UTC()
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.CreateTransactionParams{
+					UserID:      userID,
+					Datetime:    datetime,
+					AmountSats:  amountSats,
+					Type:        rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+					Description: "Bank deposit",
+					ExternalRef: "bank-transfer-1988",
+					Labels:      map[string]string{"channel": "mobile"},
+				},
+			}
+
+			want := &rpc.Transaction{
+				UserId:      userID,
+				Datetime:    timestamppb.New(datetime),
+				Amount:      amount,
+				AmountSats:  amountSats,
+				Asset:       "BTC",
+				Type:        rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+				Description: "Bank deposit",
+				ExternalRef: "bank-transfer-1988",
+				Labels:      map[string]string{"channel": "mobile"},
+			}
+
+			wantBalance := &rpc.UserBalance{
+				Balance:              amount,
+				BalanceSats:          amountSats,
+				Asset:                "BTC",
+				AvailableBalance:     amount,
+				AvailableBalanceSats: amountSats,
+			}
+
+			return test{
+				args:        args,
+				want:        want,
+				wantBalance: wantBalance,
+				wantErr:     nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the metadata is persisted.
+					var (
+						transactionType, description, externalRef string
+						labels                                    map[string]string
+					)
+
+					err := db.QueryRow(context.Background(),
+						"SELECT type, description, external_ref, labels FROM transactions WHERE user_id = $1", userID,
+					).Scan(&transactionType, &description, &externalRef, &labels)
+					assert.NoError(t, err)
+					assert.Equal(t, "deposit", transactionType)
+					assert.Equal(t, "Bank deposit", description)
+					assert.Equal(t, "bank-transfer-1988", externalRef)
+					assert.Equal(t, map[string]string{"channel": "mobile"}, labels)
+
+					// Clear data.
+					_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetBTCRepo()
+
+			got, gotBalance, err := sut.CreateTransaction(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			// The ID is generated by server, thus only make sure it's not empty.
+			if tt.want != nil && tt.want.Id == "" {
+				assert.NotEmpty(t, got.GetId())
+
+				tt.want.Id = got.GetId()
+			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantBalance, gotBalance)
+		})
+	}
+}
+
+func TestBTCRepo_ListTransaction(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.ListTransactionParams
+	}
+
+	type test struct {
+		args              args
+		want              []*rpc.Transaction
+		wantNextPageToken string
+		wantErr           error
+		beforeFunc        func(*testing.T)
+		afterFunc         func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Get List transactions, When query executed successfully, Return no error": func(t *testing.T) test {
+			userID := int64(1988)
+			balance1 := 100.5
+			balance2 := 900.6
+
+			// 2023-02-12 02:35:38 +0000 UTC
+			datetime1 := &timestamppb.Timestamp{
+				Seconds: 1676169338,
+				Nanos:   0,
+			}
+			// 2023-02-14 01:46:36 +0000 UTC
+			datetime2 := &timestamppb.Timestamp{
+				Seconds: 1676339196,
+				Nanos:   0,
+			}
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.ListTransactionParams{
+					UserID:        userID,
+					StartDatetime: datetime1.AsTime(),
+					EndDatetime:   datetime1.AsTime().Add(1 * time.Hour),
+				},
+			}
+
+			want := []*rpc.Transaction{
+				{
+					UserId: userID,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676167200,
+						Nanos:   0,
+					},
+					Amount:     balance1,
+					AmountSats: 10_050_000_000,
+					Asset:      "BTC",
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    want,
+				wantErr: nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime1.AsTime(), userID, balance1)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime2.AsTime(), userID, balance2)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Clear data.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Get List transactions with page size, When query executed successfully, Return the first page and next page token": func(t *testing.T) test {
+			userID := int64(1988)
+			balance1 := 100.5
+			balance2 := 900.6
+
+			// 2023-02-12 02:35:38 +0000 UTC
+			datetime1 := &timestamppb.Timestamp{
+				Seconds: 1676169338,
+				Nanos:   0,
+			}
+			// 2023-02-14 01:46:36 +0000 UTC
+			datetime2 := &timestamppb.Timestamp{
+				Seconds: 1676339196,
+				Nanos:   0,
+			}
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.ListTransactionParams{
+					UserID:        userID,
+					StartDatetime: datetime1.AsTime(),
+					EndDatetime:   datetime2.AsTime(),
+					PageSize:      1,
+				},
+			}
+
+			want := []*rpc.Transaction{
+				{
+					UserId: userID,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676167200,
+						Nanos:   0,
+					},
+					Amount:     balance1,
+					AmountSats: 10_050_000_000,
+					Asset:      "BTC",
+				},
+			}
+
+			return test{
+				args: args,
+				want: want,
+				// The cursor of 2023-02-12 02:00:00 +0000 UTC bucket.
+				wantNextPageToken: base64.RawURLEncoding.EncodeToString([]byte("1676167200000000")),
+				wantErr:           nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime1.AsTime(), userID, balance1)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime2.AsTime(), userID, balance2)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Clear data.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Get List transactions with page token, When query executed successfully, Return the next page": func(t *testing.T) test {
+			userID := int64(1988)
+			balance1 := 100.5
+			balance2 := 900.6
+
+			// 2023-02-12 02:35:38 +0000 UTC
+			datetime1 := &timestamppb.Timestamp{
+				Seconds: 1676169338,
+				Nanos:   0,
+			}
+			// 2023-02-14 01:46:36 +0000 UTC
+			datetime2 := &timestamppb.Timestamp{
+				Seconds: 1676339196,
+				Nanos:   0,
+			}
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.ListTransactionParams{
+					UserID:        userID,
+					StartDatetime: datetime1.AsTime(),
+					EndDatetime:   datetime2.AsTime(),
+					PageSize:      1,
+					PageToken:     base64.RawURLEncoding.EncodeToString([]byte("1676167200000000")),
+				},
+			}
+
+			want := []*rpc.Transaction{
+				{
+					UserId: userID,
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676336400,
+						Nanos:   0,
+					},
+					Amount:     balance2,
+					AmountSats: 90_060_000_000,
+					Asset:      "BTC",
+				},
+			}
+
+			return test{
+				args:              args,
+				want:              want,
+				wantNextPageToken: "",
+				wantErr:           nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime1.AsTime(), userID, balance1)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime2.AsTime(), userID, balance2)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Clear data.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Get List transactions with daily bucket, When query executed successfully, Return no error": func(t *testing.T) test {
+			userID := int64(1988)
+			balance1 := 100.5
+			balance2 := 900.6
+
+			// 2023-02-12 02:35:38 +0000 UTC
+			datetime1 := &timestamppb.Timestamp{
+				Seconds: 1676169338,
+				Nanos:   0,
+			}
+			// 2023-02-12 03:35:38 +0000 UTC
+			datetime2 := &timestamppb.Timestamp{
+				Seconds: 1676172938,
+				Nanos:   0,
+			}
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.ListTransactionParams{
+					UserID:        userID,
+					StartDatetime: datetime1.AsTime(),
+					EndDatetime:   datetime2.AsTime(),
+					Bucket:        rpc.Bucket_BUCKET_DAY,
+				},
+			}
+
+			want := []*rpc.Transaction{
+				{
+					UserId: userID,
+					// 2023-02-12 00:00:00 +0000 UTC
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676160000,
+						Nanos:   0,
+					},
+					Amount:     1001.1,
+					AmountSats: 100_110_000_000,
+					Asset:      "BTC",
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    want,
+				wantErr: nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime1.AsTime(), userID, balance1)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime2.AsTime(), userID, balance2)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Clear data.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Get List transactions with raw bucket, When query executed successfully, Return the raw transactions": func(t *testing.T) test {
+			userID := int64(1988)
+			balance1 := 100.5
+			balance2 := 900.6
+			transactionID1 := "1c7d9c1e-2f3a-4b5c-8d9e-0a1b2c3d4e5f"
+			transactionID2 := "2d8e0d2f-3a4b-4c6d-9e0f-1b2c3d4e5f60"
+
+			// 2023-02-12 02:35:38 +0000 UTC
+			datetime1 := &timestamppb.Timestamp{
+				Seconds: 1676169338,
+				Nanos:   0,
+			}
+			// 2023-02-12 02:45:38 +0000 UTC
+			datetime2 := &timestamppb.Timestamp{
+				Seconds: 1676169938,
+				Nanos:   0,
+			}
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.ListTransactionParams{
+					UserID:        userID,
+					StartDatetime: datetime1.AsTime(),
+					EndDatetime:   datetime2.AsTime(),
+					Bucket:        rpc.Bucket_BUCKET_RAW,
+				},
+			}
+
+			want := []*rpc.Transaction{
+				{
+					Id:         transactionID1,
+					UserId:     userID,
+					Datetime:   datetime1,
+					Amount:     balance1,
+					AmountSats: 10_050_000_000,
+					Asset:      "BTC",
+				},
+				{
+					Id:         transactionID2,
+					UserId:     userID,
+					Datetime:   datetime2,
+					Amount:     balance2,
+					AmountSats: 90_060_000_000,
+					Asset:      "BTC",
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    want,
+				wantErr: nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO transactions (id, datetime, user_id, amount) VALUES ($1, $2, $3, $4)",
+						transactionID1, datetime1.AsTime(), userID, balance1,
+					)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO transactions (id, datetime, user_id, amount) VALUES ($1, $2, $3, $4)",
+						transactionID2, datetime2.AsTime(), userID, balance2,
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Clear data.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given invalid bucket of Get List transactions, When query executed, Return an error": func(t *testing.T) test {
+			args := args{
+				ctx: context.Background(),
+				params: &repository.ListTransactionParams{
+					UserID:        1988,
+					StartDatetime: time.Now(),
+					EndDatetime:   time.Now(),
+					Bucket:        rpc.Bucket(99),
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrInvalidBucket,
+			}
+		},
+		"Given invalid page token of Get List transactions, When query executed, Return an error": func(t *testing.T) test {
+			args := args{
+				ctx: context.Background(),
+				params: &repository.ListTransactionParams{
+					UserID:        1988,
+					StartDatetime: time.Now(),
+					EndDatetime:   time.Now(),
+					PageToken:     "invalid",
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrInvalidPageToken,
+			}
+		},
+		"Given valid query of Get List transactions with raw bucket filtered by type and external reference, When query executed successfully, Return the matching transactions": func(t *testing.T) test {
+			userID := int64(1988)
+			balance1 := 100.5
+			balance2 := -0.5
+			transactionID1 := "1c7d9c1e-2f3a-4b5c-8d9e-0a1b2c3d4e5f"
+			transactionID2 := "2d8e0d2f-3a4b-4c6d-9e0f-1b2c3d4e5f60"
+
+			// 2023-02-12 02:35:38 +0000 UTC
+			datetime1 := &timestamppb.Timestamp{
+				Seconds: 1676169338,
+				Nanos:   0,
+			}
+			// 2023-02-12 02:45:38 +0000 UTC
+			datetime2 := &timestamppb.Timestamp{
+				Seconds: 1676169938,
+				Nanos:   0,
+			}
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.ListTransactionParams{
+					UserID:        userID,
+					StartDatetime: datetime1.AsTime(),
+					EndDatetime:   datetime2.AsTime(),
+					Bucket:        rpc.Bucket_BUCKET_RAW,
+					Type:          rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+					ExternalRef:   "bank-transfer-1988",
+				},
+			}
+
+			want := []*rpc.Transaction{
+				{
+					Id:          transactionID1,
+					UserId:      userID,
+					Datetime:    datetime1,
+					Amount:      balance1,
+					AmountSats:  10_050_000_000,
+					Asset:       "BTC",
+					Type:        rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+					Description: "Bank deposit",
+					ExternalRef: "bank-transfer-1988",
+					Labels:      map[string]string{"channel": "mobile"},
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    want,
+				wantErr: nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(),
+						`INSERT INTO transactions (id, datetime, user_id, amount, type, description, external_ref, labels)
+							VALUES ($1, $2, $3, $4, 'deposit', 'Bank deposit', 'bank-transfer-1988', '{"channel": "mobile"}')`,
+						transactionID1, datetime1.AsTime(), userID, balance1,
+					)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO transactions (id, datetime, user_id, amount, type, external_ref) VALUES ($1, $2, $3, $4, 'fee', 'bank-transfer-1988')",
+						transactionID2, datetime2.AsTime(), userID, balance2,
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Clear data.
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given daily bucket of Get List transactions filtered by type, When query executed, Return an error": func(t *testing.T) test {
+			args := args{
+				ctx: context.Background(),
+				params: &repository.ListTransactionParams{
+					UserID:        1988,
+					StartDatetime: time.Now(),
+					EndDatetime:   time.Now(),
+					Bucket:        rpc.Bucket_BUCKET_DAY,
+					Type:          rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrInvalidBucket,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetBTCRepo()
+
+			got, gotNextPageToken, err := sut.ListTransaction(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantNextPageToken, gotNextPageToken)
+		})
+	}
+}
+
+func TestBTCRepo_GetTransaction(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+
 	type test struct {
 		args       args
 		want       *rpc.Transaction
@@ -45,24 +1351,30 @@ func TestBTCRepo_CreateTransaction(t *testing.T) {
 	db := datastore.GetDatabaseMaster()
 
 	tests := map[string]func(t *testing.T) test{
-		"Given valid query of Create transaction, When query executed successfully, Return no error": func(t *testing.T) test {
-			userID := int64(1988)
+		"Given valid query of Get transaction, When query executed successfully, Return no error": func(t *testing.T) test {
+			userID := int64(1990)
 			amount := 100.5
-			datetime := time.Now().UTC()
+			amountSats := int64(10_050_000_000)
+			transactionID := "5d0b1f6a-8c3e-4a8e-b1d2-6a4f0c9e7b21"
+
+			// 2023-02-12 02:35:38 +0000 UTC
+			datetime := &timestamppb.Timestamp{
+				Seconds: 1676169338,
+				Nanos:   0,
+			}
 
 			args := args{
 				ctx: context.Background(),
-				params: &repository.CreateTransactionParams{
-					UserID:   userID,
-					Datetime: datetime,
-					Amount:   amount,
-				},
+				id:  transactionID,
 			}
 
 			want := &rpc.Transaction{
-				UserId:   userID,
-				Datetime: timestamppb.New(datetime),
-				Amount:   amount,
+				Id:         transactionID,
+				UserId:     userID,
+				Datetime:   datetime,
+				Amount:     amount,
+				AmountSats: amountSats,
+				Asset:      "BTC",
 			}
 
 			return test{
@@ -73,56 +1385,48 @@ func TestBTCRepo_CreateTransaction(t *testing.T) {
 					t.Helper()
 
 					// Remove existing data, if any.
-					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
 					assert.NoError(t, err)
 
 					// Insert test data.
-					_, err = db.Exec(context.Background(), "INSERT INTO users (id, balance) VALUES ($1, $2)", userID, 0)
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO transactions (id, datetime, user_id, amount) VALUES ($1, $2, $3, $4)",
+						transactionID, datetime.AsTime(), userID, amount,
+					)
 					assert.NoError(t, err)
 				},
 				afterFunc: func(t *testing.T) {
 					t.Helper()
 
-					// Check accumulated balance after insert transaction.
-					var balance float64
-
-					err := db.QueryRow(context.Background(), "SELECT balance FROM users WHERE id = $1", userID).Scan(&balance)
-					assert.NoError(t, err)
-					assert.Equal(t, amount, balance)
-
 					// Clear data.
-					_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
 					assert.NoError(t, err)
 
+					clearLedger(t, userID)
+
 					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
 					assert.NoError(t, err)
 				},
 			}
 		},
-		"Given valid query of Create transaction, When query executed successfully with no User found, Return an error": func(t *testing.T) test {
-			userID := int64(999)
-			amount := 100.5
-			datetime := time.Now().UTC()
-
+		"Given valid query of Get transaction, When query executed successfully with no Transaction found, Return an error": func(t *testing.T) test {
 			args := args{
 				ctx: context.Background(),
-				params: &repository.CreateTransactionParams{
-					UserID:   userID,
-					Datetime: datetime,
-					Amount:   amount,
-				},
+				id:  "8e0f3c2a-1b4d-4c6e-9f7a-2d5b8e1c4a90",
 			}
 
 			return test{
 				args:    args,
 				want:    nil,
 				wantErr: datastore.ErrNotFound,
-				beforeFunc: func(t *testing.T) {
-					t.Helper()
-
-					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
-					assert.NoError(t, err)
-				},
 			}
 		},
 	}
@@ -141,7 +1445,7 @@ func TestBTCRepo_CreateTransaction(t *testing.T) {
 
 			sut := di.GetBTCRepo()
 
-			got, err := sut.CreateTransaction(tt.args.ctx, tt.args.params)
+			got, err := sut.GetTransaction(tt.args.ctx, tt.args.id)
 
 			if !assert.ErrorIs(t, err, tt.wantErr) {
 				return
@@ -152,15 +1456,15 @@ func TestBTCRepo_CreateTransaction(t *testing.T) {
 	}
 }
 
-func TestBTCRepo_ListTransaction(t *testing.T) {
+func TestBTCRepo_GetUserBalance(t *testing.T) {
 	type args struct {
 		ctx    context.Context
-		params *repository.ListTransactionParams
+		params *repository.GetUserBalanceParams
 	}
 
 	type test struct {
 		args       args
-		want       []*rpc.Transaction
+		want       *rpc.UserBalance
 		wantErr    error
 		beforeFunc func(*testing.T)
 		afterFunc  func(*testing.T)
@@ -169,40 +1473,80 @@ func TestBTCRepo_ListTransaction(t *testing.T) {
 	db := datastore.GetDatabaseMaster()
 
 	tests := map[string]func(t *testing.T) test{
-		"Given valid query of Get List transactions, When query executed successfully, Return no error": func(t *testing.T) test {
-			userID := int64(1988)
+		"Given valid query of Get User balance, When query executed successfully, Return no error": func(t *testing.T) test {
+			userID := int64(1989)
+			balance := 100.5
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.GetUserBalanceParams{
+					UserID: userID,
+				},
+			}
+
+			want := &rpc.UserBalance{
+				Balance:              balance,
+				BalanceSats:          10_050_000_000,
+				Asset:                "BTC",
+				AvailableBalance:     balance,
+				AvailableBalanceSats: 10_050_000_000,
+			}
+
+			return test{
+				args:    args,
+				want:    want,
+				wantErr: nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clearLedger(t, userID)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					seedBalance(t, userID, "BTC", balance)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Clear data.
+					clearLedger(t, userID)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Get User balance as of a point in time, When query executed successfully, Return the balance up to that point": func(t *testing.T) test {
+			userID := int64(1989)
 			balance1 := 100.5
 			balance2 := 900.6
 
 			// 2023-02-12 02:35:38 +0000 UTC
-			datetime1 := &timestamppb.Timestamp{
-				Seconds: 1676169338,
-				Nanos:   0,
-			}
+			datetime1 := time.Unix(1676169338, 0).UTC()
 			// 2023-02-14 01:46:36 +0000 UTC
-			datetime2 := &timestamppb.Timestamp{
-				Seconds: 1676339196,
-				Nanos:   0,
-			}
+			datetime2 := time.Unix(1676339196, 0).UTC()
 
 			args := args{
 				ctx: context.Background(),
-				params: &repository.ListTransactionParams{
-					UserID:        userID,
-					StartDatetime: datetime1.AsTime(),
-					EndDatetime:   datetime1.AsTime().Add(1 * time.Hour),
+				params: &repository.GetUserBalanceParams{
+					UserID: userID,
+					// 2023-02-12 23:59:59 +0000 UTC
+					AsOf: time.Unix(1676246399, 0).UTC(),
 				},
 			}
 
-			want := []*rpc.Transaction{
-				{
-					UserId: userID,
-					Datetime: &timestamppb.Timestamp{
-						Seconds: 1676167200,
-						Nanos:   0,
-					},
-					Amount: balance1,
-				},
+			want := &rpc.UserBalance{
+				Balance:              balance1,
+				BalanceSats:          10_050_000_000,
+				Asset:                "BTC",
+				AvailableBalance:     balance1,
+				AvailableBalanceSats: 10_050_000_000,
 			}
 
 			return test{
@@ -216,17 +1560,21 @@ func TestBTCRepo_ListTransaction(t *testing.T) {
 					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
 					assert.NoError(t, err)
 
+					clearLedger(t, userID)
+
 					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
 					assert.NoError(t, err)
 
 					// Insert test data.
-					_, err = db.Exec(context.Background(), "INSERT INTO users (id, balance) VALUES ($1, $2)", userID, 0)
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
 					assert.NoError(t, err)
 
-					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime1.AsTime(), userID, balance1)
+					seedBalance(t, userID, "BTC", balance1+balance2)
+
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime1, userID, balance1)
 					assert.NoError(t, err)
 
-					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime2.AsTime(), userID, balance2)
+					_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", datetime2, userID, balance2)
 					assert.NoError(t, err)
 				},
 				afterFunc: func(t *testing.T) {
@@ -236,11 +1584,38 @@ func TestBTCRepo_ListTransaction(t *testing.T) {
 					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
 					assert.NoError(t, err)
 
+					clearLedger(t, userID)
+
 					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
 					assert.NoError(t, err)
 				},
 			}
 		},
+		"Given valid query of Get User balance, When query executed successfully with no User found, Return an error": func(t *testing.T) test {
+			userID := int64(999)
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.GetUserBalanceParams{
+					UserID: userID,
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrNotFound,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clearLedger(t, userID)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
 	}
 
 	for name, fn := range tests {
@@ -257,7 +1632,7 @@ func TestBTCRepo_ListTransaction(t *testing.T) {
 
 			sut := di.GetBTCRepo()
 
-			got, err := sut.ListTransaction(tt.args.ctx, tt.args.params)
+			got, err := sut.GetUserBalance(tt.args.ctx, tt.args.params)
 
 			if !assert.ErrorIs(t, err, tt.wantErr) {
 				return
@@ -268,15 +1643,15 @@ func TestBTCRepo_ListTransaction(t *testing.T) {
 	}
 }
 
-func TestBTCRepo_GetUserBalance(t *testing.T) {
+func TestBTCRepo_GetBalanceHistory(t *testing.T) {
 	type args struct {
 		ctx    context.Context
-		userID int64
+		params *repository.GetBalanceHistoryParams
 	}
 
 	type test struct {
 		args       args
-		want       *rpc.UserBalance
+		want       []*rpc.BalancePoint
 		wantErr    error
 		beforeFunc func(*testing.T)
 		afterFunc  func(*testing.T)
@@ -285,17 +1660,54 @@ func TestBTCRepo_GetUserBalance(t *testing.T) {
 	db := datastore.GetDatabaseMaster()
 
 	tests := map[string]func(t *testing.T) test{
-		"Given valid query of Get User balance, When query executed successfully, Return no error": func(t *testing.T) test {
-			userID := int64(1989)
-			balance := 100.5
+		"Given valid query of Get Balance history with daily bucket, When query executed successfully, Return the closing balances seeded by the opening balance": func(t *testing.T) test {
+			userID := int64(1991)
+
+			transactions := []struct {
+				datetime time.Time
+				amount   float64
+			}{
+				// 2023-02-11 10:00:00 +0000 UTC, before the range start.
+				{datetime: time.Unix(1676109600, 0).UTC(), amount: 50},
+				// 2023-02-12 02:35:38 +0000 UTC
+				{datetime: time.Unix(1676169338, 0).UTC(), amount: 100.5},
+				// 2023-02-12 03:35:38 +0000 UTC
+				{datetime: time.Unix(1676172938, 0).UTC(), amount: -20},
+				// 2023-02-14 01:46:36 +0000 UTC
+				{datetime: time.Unix(1676339196, 0).UTC(), amount: 900.6},
+			}
 
 			args := args{
-				ctx:    context.Background(),
-				userID: userID,
+				ctx: context.Background(),
+				params: &repository.GetBalanceHistoryParams{
+					UserID: userID,
+					// 2023-02-12 00:00:00 +0000 UTC
+					StartDatetime: time.Unix(1676160000, 0).UTC(),
+					// 2023-02-15 00:00:00 +0000 UTC
+					EndDatetime: time.Unix(1676419200, 0).UTC(),
+					Bucket:      rpc.Bucket_BUCKET_DAY,
+				},
 			}
 
-			want := &rpc.UserBalance{
-				Balance: balance,
+			want := []*rpc.BalancePoint{
+				{
+					// 2023-02-12 00:00:00 +0000 UTC
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676160000,
+						Nanos:   0,
+					},
+					Balance:     130.5,
+					BalanceSats: 13_050_000_000,
+				},
+				{
+					// 2023-02-14 00:00:00 +0000 UTC
+					Datetime: &timestamppb.Timestamp{
+						Seconds: 1676332800,
+						Nanos:   0,
+					},
+					Balance:     1031.1,
+					BalanceSats: 103_110_000_000,
+				},
 			}
 
 			return test{
@@ -306,28 +1718,66 @@ func TestBTCRepo_GetUserBalance(t *testing.T) {
 					t.Helper()
 
 					// Remove existing data, if any.
-					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
 					assert.NoError(t, err)
 
 					// Insert test data.
-					_, err = db.Exec(context.Background(), "INSERT INTO users (id, balance) VALUES ($1, $2)", userID, balance)
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
 					assert.NoError(t, err)
+
+					seedBalance(t, userID, "BTC", 1031.1)
+
+					for _, trx := range transactions {
+						_, err = db.Exec(context.Background(), "INSERT INTO transactions (datetime, user_id, amount) VALUES ($1, $2, $3)", trx.datetime, userID, trx.amount)
+						assert.NoError(t, err)
+					}
 				},
 				afterFunc: func(t *testing.T) {
 					t.Helper()
 
 					// Clear data.
-					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+					assert.NoError(t, err)
+
+					clearLedger(t, userID)
+
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
 					assert.NoError(t, err)
 				},
 			}
 		},
-		"Given valid query of Get User balance, When query executed successfully with no User found, Return an error": func(t *testing.T) test {
+		"Given valid query of Get Balance history with raw bucket, When query executed, Return an error": func(t *testing.T) test {
+			args := args{
+				ctx: context.Background(),
+				params: &repository.GetBalanceHistoryParams{
+					UserID:        1991,
+					StartDatetime: time.Unix(1676160000, 0).UTC(),
+					EndDatetime:   time.Unix(1676419200, 0).UTC(),
+					Bucket:        rpc.Bucket_BUCKET_RAW,
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrInvalidBucket,
+			}
+		},
+		"Given valid query of Get Balance history, When query executed successfully with no User found, Return an error": func(t *testing.T) test {
 			userID := int64(999)
 
 			args := args{
-				ctx:    context.Background(),
-				userID: userID,
+				ctx: context.Background(),
+				params: &repository.GetBalanceHistoryParams{
+					UserID:        userID,
+					StartDatetime: time.Unix(1676160000, 0).UTC(),
+					EndDatetime:   time.Unix(1676419200, 0).UTC(),
+				},
 			}
 
 			return test{
@@ -338,6 +1788,8 @@ func TestBTCRepo_GetUserBalance(t *testing.T) {
 					t.Helper()
 
 					// Remove existing data, if any.
+					clearLedger(t, userID)
+
 					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
 					assert.NoError(t, err)
 				},
@@ -359,7 +1811,7 @@ func TestBTCRepo_GetUserBalance(t *testing.T) {
 
 			sut := di.GetBTCRepo()
 
-			got, err := sut.GetUserBalance(tt.args.ctx, tt.args.userID)
+			got, err := sut.GetBalanceHistory(tt.args.ctx, tt.args.params)
 
 			if !assert.ErrorIs(t, err, tt.wantErr) {
 				return
@@ -0,0 +1,93 @@
+package datastore
+
+import (
+	"context"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/google/uuid"
+)
+
+// exportBatchSize is the number of records read from the database at once while exporting the transactions.
+const exportBatchSize = 1000
+
+// ExportTransactions sends each un-aggregated record for BTC transaction ordered by the datetime and the ID.
+// The records are read in batches keyed on the last sent record, thus the memory is constant for any date range
+// and no database transaction is held open while the records are sent.
+// Stops at the first error returned by the send.
+func (r *btcRepo) ExportTransactions(
+	ctx context.Context, params *repository.ExportTransactionsParams, send func(*rpc.Transaction) error,
+) error {
+	if params.Asset != "" {
+		if _, err := lookupAsset(params.Asset); err != nil {
+			return err
+		}
+	}
+
+	typeName, err := transactionTypeName(params.Type)
+	if err != nil {
+		return err
+	}
+
+	cursor := pageCursor{Datetime: params.StartDatetime, ID: uuid.Nil.String()}
+
+	for {
+		batch, err := r.exportBatch(ctx, params, typeName, cursor)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range batch {
+			if err = send(d.toProto()); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < exportBatchSize {
+			return nil
+		}
+
+		last := batch[len(batch)-1]
+
+		cursor = pageCursor{Datetime: last.Datetime, ID: last.ID}
+	}
+}
+
+// exportBatch get the next batch of the exported records after the cursor.
+// The empty User, asset, type and external reference match all transactions.
+func (r *btcRepo) exportBatch(
+	ctx context.Context, params *repository.ExportTransactionsParams, typeName string, cursor pageCursor,
+) ([]data, error) {
+	query := `SELECT ` + transactionColumns + `
+				FROM transactions
+					WHERE datetime >= $1::timestamptz AND datetime <= $2::timestamptz
+						AND (datetime, id) > ($3::timestamptz, $4::uuid)
+						AND ($5::bigint = 0 OR user_id = $5) AND ($6::text = '' OR asset = $6)
+						AND ($7::text = '' OR type = $7) AND ($8::text = '' OR external_ref = $8)
+							ORDER BY datetime, id
+								LIMIT $9`
+
+	rows, err := r.dbSlave.Query(ctx, query,
+		params.StartDatetime, params.EndDatetime, cursor.Datetime, cursor.ID,
+		params.UserID, params.Asset, typeName, params.ExternalRef, exportBatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	batch := make([]data, 0, exportBatchSize)
+
+	for rows.Next() {
+		var d data
+
+		if err = d.scan(rows); err != nil {
+			return nil, err
+		}
+
+		batch = append(batch, d)
+	}
+
+	return batch, rows.Err()
+}
@@ -0,0 +1,168 @@
+package datastore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBTCRepo_ExportTransactions(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.ExportTransactionsParams
+		// sendErr is returned by the send of each record, if any.
+		sendErr error
+	}
+
+	type test struct {
+		args    args
+		want    []string
+		wantErr error
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	userID := int64(1992)
+	datetime := time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+
+	ids := []string{
+		"1c2d3e4f-0000-4000-8000-000000000001",
+		"1c2d3e4f-0000-4000-8000-000000000002",
+		"1c2d3e4f-0000-4000-8000-000000000003",
+	}
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+		assert.NoError(t, err)
+	}
+
+	seed := func(t *testing.T) {
+		t.Helper()
+
+		// Remove existing data, if any.
+		clear(t)
+
+		// Insert test data, the first two records share the same datetime, thus they're ordered by the ID.
+		_, err := db.Exec(context.Background(),
+			`INSERT INTO transactions (id, datetime, user_id, amount, asset, type, external_ref) VALUES
+				($1, $4, $6, 40, 'BTC', 'deposit', NULL),
+				($2, $4, $6, 1.5, 'ETH', NULL, NULL),
+				($3, $5, $6, -10, 'BTC', 'withdrawal', 'bank-1')`,
+			ids[0], ids[1], ids[2], datetime, datetime.Add(time.Hour), userID,
+		)
+		assert.NoError(t, err)
+	}
+
+	errSend := errors.New("client disconnected")
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Export transactions of a User, When query executed successfully, Return all the records in order": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ExportTransactionsParams{
+						UserID:        userID,
+						StartDatetime: datetime,
+						EndDatetime:   datetime.Add(time.Hour),
+					},
+				},
+				want:    ids,
+				wantErr: nil,
+			}
+		},
+		"Given valid query of Export transactions filtered by asset, When query executed successfully, Return the records of the asset": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ExportTransactionsParams{
+						UserID:        userID,
+						StartDatetime: datetime,
+						EndDatetime:   datetime.Add(time.Hour),
+						Asset:         "ETH",
+					},
+				},
+				want:    ids[1:2],
+				wantErr: nil,
+			}
+		},
+		"Given valid query of Export transactions filtered by type and external reference, When query executed successfully, Return the matched records": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ExportTransactionsParams{
+						UserID:        userID,
+						StartDatetime: datetime,
+						EndDatetime:   datetime.Add(time.Hour),
+						Type:          rpc.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+						ExternalRef:   "bank-1",
+					},
+				},
+				want:    ids[2:],
+				wantErr: nil,
+			}
+		},
+		"Given valid query of Export transactions, When the send failed, Return the error of the send": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ExportTransactionsParams{
+						UserID:        userID,
+						StartDatetime: datetime,
+						EndDatetime:   datetime.Add(time.Hour),
+					},
+					sendErr: errSend,
+				},
+				want:    ids[:1],
+				wantErr: errSend,
+			}
+		},
+		"Given invalid asset of Export transactions, When query executed, Return invalid asset error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ExportTransactionsParams{
+						UserID:        userID,
+						StartDatetime: datetime,
+						EndDatetime:   datetime.Add(time.Hour),
+						Asset:         "DOGE",
+					},
+				},
+				want:    nil,
+				wantErr: datastore.ErrInvalidAsset,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			seed(t)
+
+			defer clear(t)
+
+			sut := di.GetBTCRepo()
+
+			var got []string
+
+			err := sut.ExportTransactions(tt.args.ctx, tt.args.params, func(transaction *rpc.Transaction) error {
+				got = append(got, transaction.GetId())
+
+				return tt.args.sendErr
+			})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/pkg/asset"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// holdStatusPending is the stored status of a hold reserving the amount.
+	holdStatusPending = "pending"
+	// holdStatusCaptured is the stored status of a hold settled by a withdrawal.
+	holdStatusCaptured = "captured"
+	// holdStatusReleased is the stored status of a hold cancelled without a debit.
+	holdStatusReleased = "released"
+	// holdStatusExpired is the status of a pending hold after the deadline, it's never stored.
+	holdStatusExpired = "expired"
+)
+
+// holdStatuses maps the status of a hold into proto enum.
+var holdStatuses = map[string]rpc.HoldStatus{
+	holdStatusPending:  rpc.HoldStatus_HOLD_STATUS_PENDING,
+	holdStatusCaptured: rpc.HoldStatus_HOLD_STATUS_CAPTURED,
+	holdStatusReleased: rpc.HoldStatus_HOLD_STATUS_RELEASED,
+	holdStatusExpired:  rpc.HoldStatus_HOLD_STATUS_EXPIRED,
+}
+
+// holdColumns is the selected columns for scanning a hold row.
+// The holds expire lazily, thus a pending hold after the deadline is selected as expired instead of being updated.
+// The amount is selected as the exact decimal, since the minor unit depends on the asset of the row.
+const holdColumns = `id::text, user_id, asset, amount::text,
+	CASE WHEN status = 'pending' AND expires_at <= NOW() THEN 'expired' ELSE status END,
+	expires_at, COALESCE(description, ''), COALESCE(external_ref, ''), COALESCE(transaction_id::text, ''),
+	created_at, settled_at`
+
+// holdData is a struct for scanning a hold row.
+type holdData struct {
+	ID            string
+	UserID        int64
+	Asset         string
+	AmountSats    int64
+	Status        string
+	ExpiresAt     time.Time
+	Description   string
+	ExternalRef   string
+	TransactionID string
+	CreatedAt     time.Time
+	SettledAt     *time.Time
+}
+
+// scan scans the holdColumns of the row.
+func (h *holdData) scan(row pgx.Row) error {
+	var amount string
+
+	err := row.Scan(&h.ID, &h.UserID, &h.Asset, &amount, &h.Status, &h.ExpiresAt, &h.Description, &h.ExternalRef,
+		&h.TransactionID, &h.CreatedAt, &h.SettledAt)
+	if err != nil {
+		return err
+	}
+
+	a, err := lookupAsset(h.Asset)
+	if err != nil {
+		return err
+	}
+
+	h.AmountSats, err = a.ParseUnits(amount)
+
+	return err
+}
+
+// toProto converts the scanned hold row into proto message.
+func (h *holdData) toProto() *rpc.Hold {
+	hold := &rpc.Hold{
+		Id:            h.ID,
+		UserId:        h.UserID,
+		Asset:         h.Asset,
+		AmountSats:    h.AmountSats,
+		Status:        holdStatuses[h.Status],
+		ExpiresAt:     timestamppb.New(h.ExpiresAt),
+		Description:   h.Description,
+		ExternalRef:   h.ExternalRef,
+		TransactionId: h.TransactionID,
+		CreatedAt:     timestamppb.New(h.CreatedAt),
+	}
+
+	if h.SettledAt != nil {
+		hold.SettledAt = timestamppb.New(*h.SettledAt)
+	}
+
+	return hold
+}
+
+// CreateHold reserves an amount of a User within a single database transaction.
+// The User is locked while the available balance is checked, thus the concurrent holds and debits can't overdraw it.
+// Returns the balance of the User right after the hold is committed, with the reduced available balance.
+func (r *btcRepo) CreateHold(ctx context.Context, params *repository.CreateHoldParams) (*rpc.Hold, *rpc.UserBalance, error) {
+	a, err := lookupAsset(params.Asset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+	}()
+
+	users, err := lockUsers(ctx, tx, []*repository.CreateTransactionParams{{UserID: params.UserID, Asset: a.Code}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, ok := users[params.UserID]
+	if !ok {
+		err = fmt.Errorf("user id: %d not found: %w", params.UserID, ErrNotFound)
+
+		return nil, nil, err
+	}
+
+	if user.Deactivated {
+		err = fmt.Errorf("user id: %d: %w", params.UserID, ErrUserDeactivated)
+
+		return nil, nil, err
+	}
+
+	var overdraftSats int64
+
+	// Only the BTC balance has the overdraft limit.
+	if a.Code == asset.BTC.Code {
+		overdraftSats = user.OverdraftLimitSats
+	}
+
+	balance := user.balance(a.Code)
+
+	// The hold is checked the same way as a debit of the amount, against the balance left by the other pending holds.
+	if balance.BalanceSats-balance.HeldSats-params.AmountSats < -overdraftSats {
+		err = fmt.Errorf("user id: %d: %w", params.UserID, ErrInsufficientBalance)
+
+		return nil, nil, err
+	}
+
+	var h holdData
+
+	// The amount is stored in units of the asset, the conversion from minor units is done by the database to keep it exact.
+	query := `INSERT INTO holds (id, user_id, asset, amount, expires_at, description, external_ref)
+				VALUES ($1, $2, $3, $4::bigint / $5::numeric, $6, NULLIF($7, ''), NULLIF($8, ''))
+					RETURNING ` + holdColumns
+
+	err = h.scan(tx.QueryRow(ctx, query,
+		uuid.NewString(), params.UserID, a.Code, params.AmountSats, a.Scale(), params.ExpiresAt,
+		params.Description, params.ExternalRef,
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userBalance, err := getUserBalance(ctx, tx, params.UserID, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errCommit := tx.Commit(ctx)
+	if errCommit != nil {
+		return nil, nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
+	}
+
+	return h.toProto(), userBalance, nil
+}
+
+// GetHold get a single Hold by the ID.
+func (r *btcRepo) GetHold(ctx context.Context, id string) (*rpc.Hold, error) {
+	var h holdData
+
+	err := h.scan(r.dbSlave.QueryRow(ctx, `SELECT `+holdColumns+` FROM holds WHERE id = $1`, id))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("hold id: %s not found: %w", id, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return h.toProto(), nil
+}
+
+// GetPendingHold get a single pending Hold by the ID from the master,
+// thus a hold created right before is found even when the replica lags.
+// Returns ErrHoldSettled if the hold is already captured or released, and ErrHoldExpired if the deadline passed.
+func (r *btcRepo) GetPendingHold(ctx context.Context, id string) (*rpc.Hold, error) {
+	h, err := pendingHold(r.dbMaster.QueryRow(ctx, `SELECT `+holdColumns+` FROM holds WHERE id = $1`, id), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.toProto(), nil
+}
+
+// lockPendingHold locks a hold within the given database transaction, thus a concurrent settlement waits until it's done.
+// Returns ErrHoldSettled if the hold is already captured or released, and ErrHoldExpired if the deadline passed.
+func lockPendingHold(ctx context.Context, tx pgx.Tx, id string) (*holdData, error) {
+	return pendingHold(tx.QueryRow(ctx, `SELECT `+holdColumns+` FROM holds WHERE id = $1 FOR UPDATE`, id), id)
+}
+
+// pendingHold scans the hold of the row, and returns it only while it's pending.
+func pendingHold(row pgx.Row, id string) (*holdData, error) {
+	var h holdData
+
+	err := h.scan(row)
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("hold id: %s not found: %w", id, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch h.Status {
+	case holdStatusPending:
+		return &h, nil
+	case holdStatusExpired:
+		return nil, fmt.Errorf("hold id: %s: %w", id, ErrHoldExpired)
+	default:
+		return nil, fmt.Errorf("hold id: %s is %s: %w", id, h.Status, ErrHoldSettled)
+	}
+}
+
+// CaptureHold settles a pending hold by a withdrawal transaction of the captured amount
+// within a single database transaction.
+// The captured amount may be less than the hold, the rest is released.
+// Returns the balance of the User right after the withdrawal is committed.
+func (r *btcRepo) CaptureHold(
+	ctx context.Context, params *repository.CaptureHoldParams,
+) (*rpc.Hold, *rpc.Transaction, *rpc.UserBalance, error) {
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+	}()
+
+	h, err := lockPendingHold(ctx, tx, params.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	amountSats := params.AmountSats
+	if amountSats == 0 {
+		amountSats = h.AmountSats
+	}
+
+	if amountSats > h.AmountSats {
+		err = fmt.Errorf("hold id: %s: captured amount %d exceeds the held amount %d: %w",
+			h.ID, amountSats, h.AmountSats, ErrInvalidHold)
+
+		return nil, nil, nil, err
+	}
+
+	a, err := lookupAsset(h.Asset)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	d := data{
+		ID:             uuid.NewString(),
+		JournalEntryID: uuid.NewString(),
+		Datetime:       time.Now().UTC(),
+		UserID:         h.UserID,
+		AmountSats:     -amountSats,
+		Asset:          a.Code,
+		Type:           "withdrawal",
+		Description:    h.Description,
+		ExternalRef:    h.ExternalRef,
+	}
+
+	// The hold is settled before the withdrawal is posted, thus the captured amount isn't reserved twice
+	// while the ledger checks the debit against the available balance.
+	query := `UPDATE holds SET status = $2, settled_at = $3, transaction_id = $4 WHERE id = $1 RETURNING ` + holdColumns
+
+	err = h.scan(tx.QueryRow(ctx, query, h.ID, holdStatusCaptured, d.Datetime, d.ID))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	query = `INSERT INTO transactions (id, datetime, user_id, amount, asset, type, description, external_ref, labels, journal_entry_id)
+				VALUES ($1, $2, $3, $4::bigint / $5::numeric, $6, $7, NULLIF($8, ''), NULLIF($9, ''), $10, $11)`
+
+	_, err = tx.Exec(ctx, query,
+		d.ID, d.Datetime, d.UserID, d.AmountSats, a.Scale(), a.Code, d.Type, d.Description, d.ExternalRef, d.labelsValue(),
+		d.JournalEntryID,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	err = postJournalEntries(ctx, tx, []*journalEntry{transactionEntry(journalKindTransaction, &d, a, d.Type)})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	balance, err := getUserBalance(ctx, tx, d.UserID, a)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	transaction := d.toProto()
+
+	err = insertOutboxEvents(ctx, tx, []*rpc.Transaction{transaction}, map[int64][]*rpc.UserBalance{d.UserID: {balance}})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	errCommit := tx.Commit(ctx)
+	if errCommit != nil {
+		return nil, nil, nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
+	}
+
+	return h.toProto(), transaction, balance, nil
+}
+
+// ReleaseHold cancels a pending hold without a debit.
+// Returns the balance of the User right after the hold is released, with the restored available balance.
+func (r *btcRepo) ReleaseHold(ctx context.Context, id string) (*rpc.Hold, *rpc.UserBalance, error) {
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+	}()
+
+	h, err := lockPendingHold(ctx, tx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a, err := lookupAsset(h.Asset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query := `UPDATE holds SET status = $2, settled_at = NOW() WHERE id = $1 RETURNING ` + holdColumns
+
+	err = h.scan(tx.QueryRow(ctx, query, h.ID, holdStatusReleased))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balance, err := getUserBalance(ctx, tx, h.UserID, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errCommit := tx.Commit(ctx)
+	if errCommit != nil {
+		return nil, nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
+	}
+
+	return h.toProto(), balance, nil
+}
@@ -0,0 +1,289 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// holdUserID is the User of the hold tests.
+const holdUserID = int64(1998)
+
+// clearHolds removes the holds, the transactions and the User of the hold tests.
+func clearHolds(t *testing.T) {
+	t.Helper()
+
+	db := datastore.GetDatabaseMaster()
+
+	_, err := db.Exec(context.Background(), "DELETE FROM holds WHERE user_id = $1", holdUserID)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", holdUserID)
+	assert.NoError(t, err)
+
+	clearLedger(t, holdUserID)
+
+	_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", holdUserID)
+	assert.NoError(t, err)
+}
+
+// seedHolds inserts the User of the hold tests with the balance, and a pending hold of the amount with the deadline.
+func seedHolds(t *testing.T, id string, amount float64, expiresAt time.Time) {
+	t.Helper()
+
+	db := datastore.GetDatabaseMaster()
+
+	// Remove existing data, if any.
+	clearHolds(t)
+
+	_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", holdUserID)
+	assert.NoError(t, err)
+
+	seedBalance(t, holdUserID, "BTC", 100.5)
+
+	_, err = db.Exec(context.Background(),
+		"INSERT INTO holds (id, user_id, asset, amount, expires_at, description) VALUES ($1, $2, 'BTC', $3, $4, 'on-chain')",
+		id, holdUserID, amount, expiresAt,
+	)
+	assert.NoError(t, err)
+}
+
+func TestBTCRepo_CreateHold(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.CreateHoldParams
+	}
+
+	type test struct {
+		args        args
+		wantBalance *rpc.UserBalance
+		wantErr     error
+	}
+
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Create hold, When query executed successfully, Return the reduced available balance": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CreateHoldParams{
+						UserID:     holdUserID,
+						AmountSats: 5_000_000_000,
+						ExpiresAt:  expiresAt,
+					},
+				},
+				wantBalance: &rpc.UserBalance{
+					Balance:              100.5,
+					BalanceSats:          10_050_000_000,
+					Asset:                "BTC",
+					AvailableBalance:     30.5,
+					AvailableBalanceSats: 3_050_000_000,
+				},
+				wantErr: nil,
+			}
+		},
+		"Given valid query of Create hold exceeds the available balance, When query executed, Return insufficient balance error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CreateHoldParams{
+						UserID:     holdUserID,
+						AmountSats: 8_100_000_000,
+						ExpiresAt:  expiresAt,
+					},
+				},
+				wantErr: datastore.ErrInsufficientBalance,
+			}
+		},
+		"Given valid query of Create hold of unknown User, When query executed, Return not found error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CreateHoldParams{
+						UserID:     99999,
+						AmountSats: 1,
+						ExpiresAt:  expiresAt,
+					},
+				},
+				wantErr: datastore.ErrNotFound,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			// The User already has a pending hold of 20, thus 80.5 is available.
+			seedHolds(t, "5a6b7c8d-0000-4000-8000-000000000001", 20, expiresAt)
+
+			defer clearHolds(t)
+
+			sut := di.GetBTCRepo()
+
+			got, gotBalance, err := sut.CreateHold(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+
+			assert.NotEmpty(t, got.GetId())
+			assert.Equal(t, holdUserID, got.GetUserId())
+			assert.Equal(t, "BTC", got.GetAsset())
+			assert.Equal(t, tt.args.params.AmountSats, got.GetAmountSats())
+			assert.Equal(t, rpc.HoldStatus_HOLD_STATUS_PENDING, got.GetStatus())
+			assert.Equal(t, expiresAt, got.GetExpiresAt().AsTime())
+			assert.Nil(t, got.GetSettledAt())
+			assert.Equal(t, tt.wantBalance, gotBalance)
+
+			// Check a debit can't spend the reserved amount.
+			_, _, err = sut.CreateTransaction(context.Background(), &repository.CreateTransactionParams{
+				UserID:     holdUserID,
+				Datetime:   time.Now().UTC(),
+				AmountSats: -3_100_000_000,
+			})
+			assert.ErrorIs(t, err, datastore.ErrInsufficientBalance)
+		})
+	}
+}
+
+func TestBTCRepo_CaptureHold(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.CaptureHoldParams
+	}
+
+	type test struct {
+		args            args
+		expiresAt       time.Time
+		wantAmountSats  int64
+		wantBalanceSats int64
+		wantErr         error
+	}
+
+	holdID := "5a6b7c8d-0000-4000-8000-000000000002"
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Capture hold with a partial amount, When query executed successfully, Return the withdrawal and release the rest": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CaptureHoldParams{
+						ID:         holdID,
+						AmountSats: 1_500_000_000,
+					},
+				},
+				expiresAt:       time.Now().Add(time.Hour),
+				wantAmountSats:  -1_500_000_000,
+				wantBalanceSats: 8_550_000_000,
+				wantErr:         nil,
+			}
+		},
+		"Given valid query of Capture hold exceeds the held amount, When query executed, Return invalid hold error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CaptureHoldParams{
+						ID:         holdID,
+						AmountSats: 2_000_000_001,
+					},
+				},
+				expiresAt: time.Now().Add(time.Hour),
+				wantErr:   datastore.ErrInvalidHold,
+			}
+		},
+		"Given valid query of Capture hold after the deadline, When query executed, Return hold expired error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CaptureHoldParams{
+						ID: holdID,
+					},
+				},
+				expiresAt: time.Now().Add(-time.Second),
+				wantErr:   datastore.ErrHoldExpired,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			seedHolds(t, holdID, 20, tt.expiresAt)
+
+			defer clearHolds(t)
+
+			sut := di.GetBTCRepo()
+
+			got, gotTransaction, gotBalance, err := sut.CaptureHold(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+
+			assert.Equal(t, rpc.HoldStatus_HOLD_STATUS_CAPTURED, got.GetStatus())
+			assert.Equal(t, gotTransaction.GetId(), got.GetTransactionId())
+			assert.NotNil(t, got.GetSettledAt())
+
+			assert.Equal(t, tt.wantAmountSats, gotTransaction.GetAmountSats())
+			assert.Equal(t, rpc.TransactionType_TRANSACTION_TYPE_WITHDRAWAL, gotTransaction.GetType())
+			assert.Equal(t, "on-chain", gotTransaction.GetDescription())
+
+			// The hold is settled, thus the whole balance is available again.
+			assert.Equal(t, tt.wantBalanceSats, gotBalance.GetBalanceSats())
+			assert.Equal(t, tt.wantBalanceSats, gotBalance.GetAvailableBalanceSats())
+
+			// Check the hold can't be captured twice.
+			_, _, _, err = sut.CaptureHold(context.Background(), tt.args.params)
+			assert.ErrorIs(t, err, datastore.ErrHoldSettled)
+		})
+	}
+}
+
+func TestBTCRepo_ReleaseHold(t *testing.T) {
+	holdID := "5a6b7c8d-0000-4000-8000-000000000003"
+
+	seedHolds(t, holdID, 20, time.Now().Add(time.Hour))
+
+	defer clearHolds(t)
+
+	sut := di.GetBTCRepo()
+
+	got, gotBalance, err := sut.ReleaseHold(context.Background(), holdID)
+	assert.NoError(t, err)
+
+	assert.Equal(t, rpc.HoldStatus_HOLD_STATUS_RELEASED, got.GetStatus())
+	assert.Empty(t, got.GetTransactionId())
+	assert.NotNil(t, got.GetSettledAt())
+
+	// The balance is untouched, and the whole balance is available again.
+	assert.Equal(t, &rpc.UserBalance{
+		Balance:              100.5,
+		BalanceSats:          10_050_000_000,
+		Asset:                "BTC",
+		AvailableBalance:     100.5,
+		AvailableBalanceSats: 10_050_000_000,
+	}, gotBalance)
+
+	hold, err := sut.GetHold(context.Background(), holdID)
+	assert.NoError(t, err)
+	assert.Equal(t, got, hold)
+
+	_, _, err = sut.ReleaseHold(context.Background(), holdID)
+	assert.ErrorIs(t, err, datastore.ErrHoldSettled)
+
+	_, err = sut.GetPendingHold(context.Background(), holdID)
+	assert.ErrorIs(t, err, datastore.ErrHoldSettled)
+
+	_, err = sut.GetHold(context.Background(), "5a6b7c8d-0000-4000-8000-000000000004")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
@@ -0,0 +1,274 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/pkg/asset"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ImportTransactions creates the records for BTC transaction of a chunk of an import within a single database transaction,
+// together with the balances and the checkpoint of the import.
+// The rows are inserted with COPY, and the balances are recomputed once for the chunk by a single journal entry
+// with the net amount of each account, instead of an entry for each row.
+// Since the checkpoint is committed with the chunk, a broken import never leaves the balances out of sync with the rows.
+func (r *btcRepo) ImportTransactions( //nolint: funlen
+	ctx context.Context, params *repository.ImportTransactionsParams,
+) (*repository.ImportTransactionsResult, map[int64][]*rpc.UserBalance, error) {
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+	}()
+
+	// The checkpoint is locked, thus the concurrent streams of the same import are applied one after another.
+	sequence, err := lockImportCheckpoint(ctx, tx, params.ImportID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		result  = new(repository.ImportTransactionsResult)
+		pending []*repository.ImportRow
+		last    = sequence
+	)
+
+	reject := func(row *repository.ImportRow, err error) {
+		result.Rejections = append(result.Rejections, &repository.ImportRejection{Sequence: row.Sequence, Err: err})
+	}
+
+	for _, row := range params.Rows {
+		if row.Sequence <= sequence {
+			result.Skipped++
+			continue
+		}
+
+		last = row.Sequence
+
+		if row.Err != nil {
+			reject(row, row.Err)
+			continue
+		}
+
+		pending = append(pending, row)
+	}
+
+	transactions := make([]*repository.CreateTransactionParams, len(pending))
+
+	for i, row := range pending {
+		transactions[i] = row.Transaction
+	}
+
+	users, err := lockUsers(ctx, tx, transactions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		rows    [][]any
+		created []*rpc.Transaction
+		// net is the net amount of each account of the chunk by the code.
+		net     = make(map[string]*posting)
+		entryID = uuid.NewString()
+	)
+
+	for _, row := range pending {
+		p := row.Transaction
+
+		a, err := lookupAsset(p.Asset)
+		if err != nil {
+			reject(row, err)
+			continue
+		}
+
+		d, err := newData(uuid.NewString(), p, a)
+		if err != nil {
+			reject(row, err)
+			continue
+		}
+
+		user, ok := users[p.UserID]
+		if !ok {
+			reject(row, fmt.Errorf("user id: %d not found: %w", p.UserID, ErrNotFound))
+			continue
+		}
+
+		if user.Deactivated {
+			reject(row, fmt.Errorf("user id: %d: %w", p.UserID, ErrUserDeactivated))
+			continue
+		}
+
+		// The rows are applied in order, thus a debit can be funded by a previous credit of the import.
+		var (
+			balance       = user.balance(a.Code)
+			overdraftSats int64
+		)
+
+		// Only the BTC balance has the overdraft limit.
+		if a.Code == asset.BTC.Code {
+			overdraftSats = user.OverdraftLimitSats
+		}
+
+		// The pending holds are reserved, thus the debit can only spend the available balance.
+		if p.AmountSats < 0 && balance.BalanceSats-balance.HeldSats+balance.DeltaSats+p.AmountSats < -overdraftSats {
+			reject(row, fmt.Errorf("user id: %d: %w", p.UserID, ErrInsufficientBalance))
+			continue
+		}
+
+		balance.DeltaSats += p.AmountSats
+		balance.Changed = true
+
+		// All the rows of the chunk share the journal entry of the chunk.
+		d.JournalEntryID = entryID
+
+		rows = append(rows, []any{
+			d.ID, d.Datetime, d.UserID, pgtype.Numeric{Int: big.NewInt(d.AmountSats), Exp: int32(-a.Decimals), Valid: true}, a.Code,
+			nullIfEmpty(d.Type), nullIfEmpty(d.Description), nullIfEmpty(d.ExternalRef), d.labelsValue(), d.JournalEntryID,
+		})
+
+		for _, account := range []posting{
+			{Account: userAccount(d.UserID, a), AmountSats: d.AmountSats},
+			{Account: counterpartyAccount(d.Type, a), AmountSats: -d.AmountSats},
+		} {
+			code := account.Account.code()
+
+			if _, ok := net[code]; !ok {
+				net[code] = &posting{Account: account.Account}
+			}
+
+			net[code].AmountSats += account.AmountSats
+		}
+
+		created = append(created, d.toProto())
+
+		result.Imported++
+	}
+
+	balances := make(map[int64][]*rpc.UserBalance)
+
+	if len(rows) > 0 {
+		_, err = tx.CopyFrom(ctx,
+			pgx.Identifier{"transactions"},
+			[]string{"id", "datetime", "user_id", "amount", "asset", "type", "description", "external_ref", "labels", "journal_entry_id"},
+			pgx.CopyFromRows(rows),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		entry := &journalEntry{ID: entryID, Kind: journalKindImport, Datetime: time.Now().UTC()}
+
+		// The rows of the same account may cancel out, the zero posting is left out.
+		for _, p := range net {
+			if p.AmountSats != 0 {
+				entry.Postings = append(entry.Postings, *p)
+			}
+		}
+
+		err = postJournalEntries(ctx, tx, []*journalEntry{entry})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		balances, err = getChangedBalances(ctx, tx, users)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = insertOutboxEvents(ctx, tx, created, balances)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// The rows rejected before reaching the repository are reported first, thus they're ordered again.
+	sort.Slice(result.Rejections, func(i, j int) bool {
+		return result.Rejections[i].Sequence < result.Rejections[j].Sequence
+	})
+
+	result.Checkpoint, err = updateImportCheckpoint(ctx, tx, params.ImportID, last, result.Imported, int64(len(result.Rejections)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errCommit := tx.Commit(ctx)
+	if errCommit != nil {
+		return nil, nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
+	}
+
+	return result, balances, nil
+}
+
+// GetImportCheckpoint get the checkpoint of an import by the ID.
+func (r *btcRepo) GetImportCheckpoint(ctx context.Context, importID string) (*rpc.ImportCheckpoint, error) {
+	query := `SELECT import_id, sequence, imported, rejected, updated_at FROM import_checkpoints WHERE import_id = $1`
+
+	checkpoint, err := scanImportCheckpoint(r.dbSlave.QueryRow(ctx, query, importID))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("import id: %s not found: %w", importID, ErrNotFound)
+	}
+
+	return checkpoint, err
+}
+
+// lockImportCheckpoint locks the checkpoint of the import within the given database transaction,
+// the missing checkpoint is created for the first chunk of the import.
+// Returns the sequence of the last committed row.
+func lockImportCheckpoint(ctx context.Context, tx pgx.Tx, importID string) (int64, error) {
+	_, err := tx.Exec(ctx, `INSERT INTO import_checkpoints (import_id) VALUES ($1) ON CONFLICT (import_id) DO NOTHING`, importID)
+	if err != nil {
+		return 0, err
+	}
+
+	var sequence int64
+
+	err = tx.QueryRow(ctx, `SELECT sequence FROM import_checkpoints WHERE import_id = $1 FOR UPDATE`, importID).Scan(&sequence)
+
+	return sequence, err
+}
+
+// updateImportCheckpoint moves the checkpoint of the import into the sequence within the given database transaction,
+// and adds the numbers of the imported and the rejected rows of the chunk.
+func updateImportCheckpoint(
+	ctx context.Context, tx pgx.Tx, importID string, sequence, imported, rejected int64,
+) (*rpc.ImportCheckpoint, error) {
+	query := `UPDATE import_checkpoints
+				SET sequence = $2, imported = imported + $3, rejected = rejected + $4, updated_at = NOW()
+					WHERE import_id = $1
+						RETURNING import_id, sequence, imported, rejected, updated_at`
+
+	return scanImportCheckpoint(tx.QueryRow(ctx, query, importID, sequence, imported, rejected))
+}
+
+// scanImportCheckpoint scans the checkpoint of an import from the row.
+func scanImportCheckpoint(row pgx.Row) (*rpc.ImportCheckpoint, error) {
+	var (
+		checkpoint rpc.ImportCheckpoint
+		updatedAt  time.Time
+	)
+
+	err := row.Scan(&checkpoint.ImportId, &checkpoint.Sequence, &checkpoint.Imported, &checkpoint.Rejected, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint.UpdatedAt = timestamppb.New(updatedAt)
+
+	return &checkpoint, nil
+}
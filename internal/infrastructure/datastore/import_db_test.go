@@ -0,0 +1,274 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBTCRepo_ImportTransactions(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.ImportTransactionsParams
+	}
+
+	type test struct {
+		args     args
+		imported int64
+		skipped  int64
+		// wantRejections is the error of each rejected row by the sequence.
+		wantRejections map[int64]error
+		wantCheckpoint *rpc.ImportCheckpoint
+		wantBalance    float64
+		wantErr        error
+		beforeFunc     func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	userID := int64(1993)
+	importID := "import-1993"
+	datetime := time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+
+	row := func(sequence, userID, amountSats int64) *repository.ImportRow {
+		return &repository.ImportRow{
+			Sequence: sequence,
+			Transaction: &repository.CreateTransactionParams{
+				UserID:     userID,
+				Datetime:   datetime.Add(time.Duration(sequence) * time.Minute),
+				AmountSats: amountSats,
+			},
+		}
+	}
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM import_checkpoints WHERE import_id = $1", importID)
+		assert.NoError(t, err)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+		assert.NoError(t, err)
+
+		clearLedger(t, userID)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Import transactions, When query executed successfully, Return the imported and the rejected rows": func(t *testing.T) test {
+			rejected := row(3, userID, 100)
+			rejected.Err = repository.ErrInvalidAmount
+
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ImportTransactionsParams{
+						ImportID: importID,
+						Rows: []*repository.ImportRow{
+							row(1, userID, 1_000_000_000),
+							row(2, userID, -500_000_000),
+							rejected,
+							row(4, 99999, 100),
+						},
+					},
+				},
+				imported: 2,
+				skipped:  0,
+				wantRejections: map[int64]error{
+					3: repository.ErrInvalidAmount,
+					4: datastore.ErrNotFound,
+				},
+				wantCheckpoint: &rpc.ImportCheckpoint{ImportId: importID, Sequence: 4, Imported: 2, Rejected: 2},
+				wantBalance:    6,
+				wantErr:        nil,
+			}
+		},
+		"Given valid query of Import transactions of a broken import, When query executed successfully, Return the rows up to the checkpoint skipped": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ImportTransactionsParams{
+						ImportID: importID,
+						Rows: []*repository.ImportRow{
+							row(1, userID, 1_000_000_000),
+							row(2, userID, -500_000_000),
+							row(3, userID, 100_000_000),
+						},
+					},
+				},
+				imported:       1,
+				skipped:        2,
+				wantRejections: map[int64]error{},
+				wantCheckpoint: &rpc.ImportCheckpoint{ImportId: importID, Sequence: 3, Imported: 3, Rejected: 0},
+				wantBalance:    2,
+				wantErr:        nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					_, err := db.Exec(context.Background(),
+						"INSERT INTO import_checkpoints (import_id, sequence, imported) VALUES ($1, 2, 2)", importID,
+					)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Import transactions with debit overdraws the balance, When query executed successfully, Return the row rejected": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ImportTransactionsParams{
+						ImportID: importID,
+						Rows: []*repository.ImportRow{
+							row(1, userID, -200_000_000),
+						},
+					},
+				},
+				imported: 0,
+				skipped:  0,
+				wantRejections: map[int64]error{
+					1: datastore.ErrInsufficientBalance,
+				},
+				wantCheckpoint: &rpc.ImportCheckpoint{ImportId: importID, Sequence: 1, Imported: 0, Rejected: 1},
+				wantBalance:    1,
+				wantErr:        nil,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			// Remove existing data, if any.
+			clear(t)
+
+			defer clear(t)
+
+			// Insert test data.
+			_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+			assert.NoError(t, err)
+
+			seedBalance(t, userID, "BTC", 1)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			sut := di.GetBTCRepo()
+
+			got, _, err := sut.ImportTransactions(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.imported, got.Imported)
+			assert.Equal(t, tt.skipped, got.Skipped)
+			assert.Len(t, got.Rejections, len(tt.wantRejections))
+
+			for _, rejection := range got.Rejections {
+				assert.ErrorIs(t, rejection.Err, tt.wantRejections[rejection.Sequence])
+			}
+
+			// The updated_at is set by the database.
+			got.Checkpoint.UpdatedAt = nil
+			assert.Equal(t, tt.wantCheckpoint, got.Checkpoint)
+
+			// The balance is recomputed from the postings of the chunk.
+			var balance float64
+
+			err = db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBalance, balance)
+		})
+	}
+}
+
+func TestBTCRepo_GetImportCheckpoint(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		importID string
+	}
+
+	type test struct {
+		args    args
+		want    *rpc.ImportCheckpoint
+		wantErr error
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	importID := "import-1994"
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM import_checkpoints WHERE import_id = $1", importID)
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Get import checkpoint, When query executed successfully, Return the checkpoint": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx:      context.Background(),
+					importID: importID,
+				},
+				want:    &rpc.ImportCheckpoint{ImportId: importID, Sequence: 1000, Imported: 990, Rejected: 10},
+				wantErr: nil,
+			}
+		},
+		"Given valid query of Get import checkpoint of unknown import, When query executed, Return not found error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx:      context.Background(),
+					importID: "import-unknown",
+				},
+				want:    nil,
+				wantErr: datastore.ErrNotFound,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			// Remove existing data, if any.
+			clear(t)
+
+			defer clear(t)
+
+			// Insert test data.
+			_, err := db.Exec(context.Background(),
+				"INSERT INTO import_checkpoints (import_id, sequence, imported, rejected) VALUES ($1, 1000, 990, 10)", importID,
+			)
+			assert.NoError(t, err)
+
+			sut := di.GetBTCRepo()
+
+			got, err := sut.GetImportCheckpoint(tt.args.ctx, tt.args.importID)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			// The updated_at is set by the database.
+			if got != nil {
+				assert.NotNil(t, got.UpdatedAt)
+				got.UpdatedAt = nil
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
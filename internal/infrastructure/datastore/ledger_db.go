@@ -0,0 +1,274 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/pkg/asset"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	// accountTypeUser is the wallet account of a User.
+	accountTypeUser = "user"
+	// accountTypeFee is the account of the collected fees.
+	accountTypeFee = "fee"
+	// accountTypeHouse is the account of the funds moved in and out of the service, e.g. deposits and withdrawals.
+	accountTypeHouse = "house"
+)
+
+const (
+	// journalKindTransaction is the journal entry of a transaction of a User.
+	journalKindTransaction = "transaction"
+	// journalKindTransfer is the journal entry of a transfer between Users.
+	journalKindTransfer = "transfer"
+	// journalKindReversal is the journal entry of a reversal of a transaction.
+	journalKindReversal = "reversal"
+	// journalKindReconciliation is the journal entry of a repaired balance, which disagreed with the sum of the transactions.
+	journalKindReconciliation = "reconciliation"
+	// journalKindImport is the journal entry of a chunk of an import, with the net amount of each account of the chunk.
+	journalKindImport = "import"
+)
+
+// sqlStateInsufficientBalance is the SQLSTATE raised by the postings trigger when a debit overdraws a balance.
+const sqlStateInsufficientBalance = "BT001"
+
+// errUnbalancedEntry is an error for indicates the postings of a journal entry don't sum to zero.
+var errUnbalancedEntry = errors.New("unbalanced journal entry")
+
+// ledgerAccount is an account of the ledger, it holds a single asset.
+// The UserID is only set for the wallet account of a User.
+type ledgerAccount struct {
+	Type   string
+	UserID int64
+	Asset  asset.Asset
+}
+
+// userAccount returns the wallet account of the User for the asset.
+func userAccount(userID int64, a asset.Asset) ledgerAccount {
+	return ledgerAccount{Type: accountTypeUser, UserID: userID, Asset: a}
+}
+
+// counterpartyAccount returns the account on the other side of a transaction of a User with the type,
+// the fees are collected into the fee account and the others are moved in or out through the house account.
+func counterpartyAccount(transactionType string, a asset.Asset) ledgerAccount {
+	if transactionType == transactionTypeFee {
+		return ledgerAccount{Type: accountTypeFee, Asset: a}
+	}
+
+	return ledgerAccount{Type: accountTypeHouse, Asset: a}
+}
+
+// code returns the unique code of the account, e.g. user:1:BTC or house:BTC.
+func (a ledgerAccount) code() string {
+	if a.Type == accountTypeUser {
+		return fmt.Sprintf("%s:%d:%s", a.Type, a.UserID, a.Asset.Code)
+	}
+
+	return fmt.Sprintf("%s:%s", a.Type, a.Asset.Code)
+}
+
+// posting is an amount of a journal entry posted to an account, positive for a credit and negative for a debit.
+type posting struct {
+	Account    ledgerAccount
+	AmountSats int64
+}
+
+// journalEntry is a set of postings that sum to zero for each asset.
+type journalEntry struct {
+	ID       string
+	Kind     string
+	Datetime time.Time
+	Postings []posting
+}
+
+// transactionEntry returns the journal entry of a transaction of a User,
+// the amount is posted to the User and the opposite amount to the counterparty account.
+func transactionEntry(kind string, d *data, a asset.Asset, transactionType string) *journalEntry {
+	return &journalEntry{
+		ID:       d.JournalEntryID,
+		Kind:     kind,
+		Datetime: d.Datetime,
+		Postings: []posting{
+			{Account: userAccount(d.UserID, a), AmountSats: d.AmountSats},
+			{Account: counterpartyAccount(transactionType, a), AmountSats: -d.AmountSats},
+		},
+	}
+}
+
+// balanced returns whether the postings of the journal entry sum to zero for each asset.
+func (e *journalEntry) balanced() bool {
+	sums := make(map[string]int64)
+
+	for _, p := range e.Postings {
+		sums[p.Account.Asset.Code] += p.AmountSats
+	}
+
+	for _, sum := range sums {
+		if sum != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// postJournalEntries stores the journal entries and their postings within the given database transaction.
+// The missing accounts are opened on the first posting.
+// The balances of the Users are the sums of the postings, the trigger of the postings table
+// rejects the postings with ErrInsufficientBalance when a debit overdraws a balance.
+func postJournalEntries(ctx context.Context, tx pgx.Tx, entries []*journalEntry) error {
+	var (
+		entryIDs, kinds []string
+		datetimes       []time.Time
+
+		accountCodes, accountTypes, accountAssets []string
+		accountUserIDs                            []*int64
+		opened                                    = make(map[string]bool)
+
+		postingEntryIDs, postingCodes []string
+		postingAmounts, postingScales []int64
+	)
+
+	for _, e := range entries {
+		if !e.balanced() {
+			return fmt.Errorf("journal entry id: %s: %w", e.ID, errUnbalancedEntry)
+		}
+
+		entryIDs = append(entryIDs, e.ID)
+		kinds = append(kinds, e.Kind)
+		datetimes = append(datetimes, e.Datetime)
+
+		for _, p := range e.Postings {
+			code := p.Account.code()
+
+			if !opened[code] {
+				opened[code] = true
+
+				var userID *int64
+				if p.Account.Type == accountTypeUser {
+					userID = &p.Account.UserID
+				}
+
+				accountCodes = append(accountCodes, code)
+				accountTypes = append(accountTypes, p.Account.Type)
+				accountUserIDs = append(accountUserIDs, userID)
+				accountAssets = append(accountAssets, p.Account.Asset.Code)
+			}
+
+			postingEntryIDs = append(postingEntryIDs, e.ID)
+			postingCodes = append(postingCodes, code)
+			postingAmounts = append(postingAmounts, p.AmountSats)
+			postingScales = append(postingScales, p.Account.Asset.Scale())
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO ledger_accounts (code, type, user_id, asset)
+				SELECT * FROM unnest($1::text[], $2::text[], $3::bigint[], $4::text[])
+					ON CONFLICT (code) DO NOTHING`
+
+	_, err := tx.Exec(ctx, query, accountCodes, accountTypes, accountUserIDs, accountAssets)
+	if err != nil {
+		return err
+	}
+
+	query = `INSERT INTO journal_entries (id, kind, datetime)
+				SELECT * FROM unnest($1::uuid[], $2::text[], $3::timestamptz[])`
+
+	_, err = tx.Exec(ctx, query, entryIDs, kinds, datetimes)
+	if err != nil {
+		return err
+	}
+
+	// All the postings are inserted by a single statement, thus the balances are updated once for each account.
+	query = `INSERT INTO postings (journal_entry_id, account_code, amount)
+				SELECT c.journal_entry_id, c.account_code, c.amount::bigint / c.scale::numeric
+					FROM unnest($1::uuid[], $2::text[], $3::bigint[], $4::bigint[]) AS c(journal_entry_id, account_code, amount, scale)`
+
+	_, err = tx.Exec(ctx, query, postingEntryIDs, postingCodes, postingAmounts, postingScales)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == sqlStateInsufficientBalance {
+		return fmt.Errorf("%s: %w", pgErr.Message, ErrInsufficientBalance)
+	}
+
+	return err
+}
+
+// getUserBalance get the balance of the User for the asset within the given database transaction.
+func getUserBalance(ctx context.Context, tx pgx.Tx, userID int64, a asset.Asset) (*rpc.UserBalance, error) {
+	balances, err := getUserBalances(ctx, tx, []int64{userID}, []asset.Asset{a})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(balances[userID]) == 0 {
+		return nil, fmt.Errorf("user id: %d not found: %w", userID, ErrNotFound)
+	}
+
+	return balances[userID][0], nil
+}
+
+// getUserBalances get the balance of each pair of the User and the asset within the given database transaction,
+// together with the available balance after the pending holds,
+// and returns the balances by the ID of the User ordered by the asset code.
+// The balances are the sums of the postings of the wallet accounts of the Users.
+func getUserBalances(
+	ctx context.Context, tx pgx.Tx, userIDs []int64, assets []asset.Asset,
+) (map[int64][]*rpc.UserBalance, error) {
+	var (
+		codes  = make([]string, len(assets))
+		scales = make([]int64, len(assets))
+	)
+
+	for i, a := range assets {
+		codes[i] = a.Code
+		scales[i] = a.Scale()
+	}
+
+	query := `SELECT u.id, c.asset,
+				(account_balance(u.id, c.asset) * c.scale::numeric)::bigint,
+				(held_amount(u.id, c.asset) * c.scale::numeric)::bigint
+				FROM unnest($1::bigint[], $2::text[], $3::bigint[]) AS c(user_id, asset, scale)
+					JOIN users u ON u.id = c.user_id
+						ORDER BY u.id, c.asset`
+
+	rows, err := tx.Query(ctx, query, userIDs, codes, scales)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	balances := make(map[int64][]*rpc.UserBalance)
+
+	for rows.Next() {
+		var (
+			id          int64
+			code        string
+			balanceSats int64
+			heldSats    int64
+		)
+
+		if err = rows.Scan(&id, &code, &balanceSats, &heldSats); err != nil {
+			return nil, err
+		}
+
+		a, err := lookupAsset(code)
+		if err != nil {
+			return nil, err
+		}
+
+		balances[id] = append(balances[id], toUserBalance(a, balanceSats, heldSats))
+	}
+
+	return balances, rows.Err()
+}
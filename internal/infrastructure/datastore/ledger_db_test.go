@@ -0,0 +1,215 @@
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// seedBalance posts the opening balance of the User for the asset against the house account,
+// since the balances are derived from the postings.
+func seedBalance(t *testing.T, userID int64, asset string, balance float64) {
+	t.Helper()
+
+	db := datastore.GetDatabaseMaster()
+
+	account := fmt.Sprintf("user:%d:%s", userID, asset)
+
+	_, err := db.Exec(context.Background(),
+		`INSERT INTO ledger_accounts (code, type, user_id, asset) VALUES ($1, 'user', $2, $3), ('house:' || $3, 'house', NULL, $3)
+			ON CONFLICT (code) DO NOTHING`,
+		account, userID, asset,
+	)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(context.Background(),
+		`WITH entry AS (
+			INSERT INTO journal_entries (id, kind, datetime) VALUES (gen_random_uuid(), 'opening', NOW()) RETURNING id
+		)
+		INSERT INTO postings (journal_entry_id, account_code, amount)
+			SELECT id, $1, $3::numeric FROM entry
+			UNION ALL
+			SELECT id, 'house:' || $2, -$3::numeric FROM entry`,
+		account, asset, balance,
+	)
+	assert.NoError(t, err)
+}
+
+// clearLedger removes the journal entries posted to the accounts of the Users together with their postings,
+// thus the balances of the Users start from zero again.
+func clearLedger(t *testing.T, userIDs ...int64) {
+	t.Helper()
+
+	db := datastore.GetDatabaseMaster()
+
+	query := `WITH entries AS (
+					SELECT DISTINCT p.journal_entry_id
+						FROM postings p
+							JOIN ledger_accounts a ON a.code = p.account_code
+								WHERE a.type = 'user' AND a.user_id = ANY($1)
+				), deleted AS (
+					DELETE FROM postings WHERE journal_entry_id IN (SELECT journal_entry_id FROM entries)
+				)
+				DELETE FROM journal_entries WHERE id IN (SELECT journal_entry_id FROM entries)`
+
+	_, err := db.Exec(context.Background(), query, userIDs)
+	assert.NoError(t, err)
+}
+
+func TestLedger_CreateTransaction(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.CreateTransactionParams
+	}
+
+	type test struct {
+		args         args
+		wantPostings map[string]float64
+		wantBalance  float64
+		wantErr      error
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	userID := int64(1990)
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+		assert.NoError(t, err)
+
+		clearLedger(t, userID)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Create transaction of a deposit, When query executed successfully, Return the postings against the house account": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CreateTransactionParams{
+						UserID:     userID,
+						Datetime:   time.Now().UTC(),
+						AmountSats: 10_050_000_000,
+						Type:       rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+					},
+				},
+				wantPostings: map[string]float64{
+					"user:1990:BTC": 100.5,
+					"house:BTC":     -100.5,
+				},
+				wantBalance: 101.5,
+				wantErr:     nil,
+			}
+		},
+		"Given valid query of Create transaction of a fee, When query executed successfully, Return the postings against the fee account": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CreateTransactionParams{
+						UserID:     userID,
+						Datetime:   time.Now().UTC(),
+						AmountSats: -50_000_000,
+						Type:       rpc.TransactionType_TRANSACTION_TYPE_FEE,
+					},
+				},
+				wantPostings: map[string]float64{
+					"user:1990:BTC": -0.5,
+					"fee:BTC":       0.5,
+				},
+				wantBalance: 0.5,
+				wantErr:     nil,
+			}
+		},
+		"Given valid query of Create transaction with debit overdraws the balance, When query executed, Return an error without postings": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CreateTransactionParams{
+						UserID:     userID,
+						Datetime:   time.Now().UTC(),
+						AmountSats: -200_000_000,
+						Type:       rpc.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+					},
+				},
+				wantPostings: nil,
+				wantBalance:  1,
+				wantErr:      datastore.ErrInsufficientBalance,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			// Remove existing data, if any.
+			clear(t)
+
+			defer clear(t)
+
+			// Insert test data.
+			_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+			assert.NoError(t, err)
+
+			seedBalance(t, userID, "BTC", 1)
+
+			sut := di.GetBTCRepo()
+
+			got, _, err := sut.CreateTransaction(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			// The balance is derived from the postings.
+			var balance float64
+
+			err = db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBalance, balance)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			rows, err := db.Query(context.Background(),
+				`SELECT p.account_code, p.amount::float8
+					FROM transactions t
+						JOIN postings p ON p.journal_entry_id = t.journal_entry_id
+							WHERE t.id = $1`,
+				got.GetId(),
+			)
+			assert.NoError(t, err)
+
+			defer rows.Close()
+
+			postings := make(map[string]float64)
+
+			for rows.Next() {
+				var (
+					code   string
+					amount float64
+				)
+
+				assert.NoError(t, rows.Scan(&code, &amount))
+
+				postings[code] = amount
+			}
+
+			assert.NoError(t, rows.Err())
+			assert.Equal(t, tt.wantPostings, postings)
+		})
+	}
+}
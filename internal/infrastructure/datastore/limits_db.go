@@ -0,0 +1,97 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// scanLimits scans the user ID, the asset, the max amount, the max daily outflow, the max count
+// and the count window of the row.
+func scanLimits(row pgx.Row) (*rpc.TransactionLimits, error) {
+	limits := new(rpc.TransactionLimits)
+
+	err := row.Scan(&limits.UserId, &limits.Asset, &limits.MaxAmountSats, &limits.MaxDailyOutflowSats,
+		&limits.MaxCount, &limits.CountWindowSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+// SetTransactionLimits stores the limits of the debits of a User for an asset, replacing the previous limits.
+// The limit of 0 is stored as NULL, thus it falls back to the default limit.
+// Returns the stored limits, without the default limits.
+func (r *btcRepo) SetTransactionLimits(
+	ctx context.Context, params *repository.SetTransactionLimitsParams,
+) (*rpc.TransactionLimits, error) {
+	a, err := lookupAsset(params.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	// The default limits have no User, thus only the limits of an existing User are stored.
+	// The amounts are converted between minor units and units of the asset by the database to keep them exact.
+	query := `INSERT INTO transaction_limits (user_id, asset, max_amount, max_daily_outflow, max_count, count_window_seconds)
+				SELECT $1, $2, NULLIF($3::bigint, 0) / $7::numeric, NULLIF($4::bigint, 0) / $7::numeric,
+					NULLIF($5::bigint, 0), NULLIF($6::bigint, 0)
+					WHERE $1 = 0 OR EXISTS (SELECT 1 FROM users WHERE id = $1)
+				ON CONFLICT (user_id, asset) DO UPDATE SET
+					max_amount = EXCLUDED.max_amount,
+					max_daily_outflow = EXCLUDED.max_daily_outflow,
+					max_count = EXCLUDED.max_count,
+					count_window_seconds = EXCLUDED.count_window_seconds,
+					updated_at = NOW()
+				RETURNING user_id, asset, (COALESCE(max_amount, 0) * $7::numeric)::bigint,
+					(COALESCE(max_daily_outflow, 0) * $7::numeric)::bigint, COALESCE(max_count, 0), COALESCE(count_window_seconds, 0)`
+
+	limits, err := scanLimits(r.dbMaster.QueryRow(ctx, query,
+		params.UserID, a.Code, params.MaxAmountSats, params.MaxDailyOutflowSats, params.MaxCount,
+		int64(params.CountWindow.Seconds()), a.Scale(),
+	))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("user id: %d not found: %w", params.UserID, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+// GetTransactionLimits get the effective limits of the debits of a User for an asset,
+// the limits that aren't set for the User are the default limits of the asset.
+// The limits that are set for neither are 0, which is unlimited.
+func (r *btcRepo) GetTransactionLimits(ctx context.Context, userID int64, code string) (*rpc.TransactionLimits, error) {
+	a, err := lookupAsset(code)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT c.user_id, c.asset,
+				(COALESCE(u.max_amount, d.max_amount, 0) * $3::numeric)::bigint,
+				(COALESCE(u.max_daily_outflow, d.max_daily_outflow, 0) * $3::numeric)::bigint,
+				COALESCE(u.max_count, d.max_count, 0),
+				COALESCE(u.count_window_seconds, d.count_window_seconds, 0)
+				FROM (SELECT $1::integer AS user_id, $2::text AS asset) c
+					LEFT JOIN transaction_limits u ON u.user_id = c.user_id AND u.asset = c.asset
+					LEFT JOIN transaction_limits d ON d.user_id = 0 AND d.asset = c.asset
+						WHERE c.user_id = 0 OR EXISTS (SELECT 1 FROM users WHERE id = c.user_id)`
+
+	limits, err := scanLimits(r.dbSlave.QueryRow(ctx, query, userID, a.Code, a.Scale()))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("user id: %d not found: %w", userID, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
@@ -0,0 +1,98 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// limitsUserID is the User of the limits tests.
+const limitsUserID = int64(1999)
+
+// clearLimits removes the limits, the default limits and the User of the limits tests.
+func clearLimits(t *testing.T) {
+	t.Helper()
+
+	db := datastore.GetDatabaseMaster()
+
+	_, err := db.Exec(context.Background(), "DELETE FROM transaction_limits WHERE user_id IN (0, $1)", limitsUserID)
+	assert.NoError(t, err)
+
+	clearLedger(t, limitsUserID)
+
+	_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", limitsUserID)
+	assert.NoError(t, err)
+}
+
+func TestBTCRepo_TransactionLimits(t *testing.T) {
+	// Remove existing data, if any.
+	clearLimits(t)
+
+	defer clearLimits(t)
+
+	db := datastore.GetDatabaseMaster()
+
+	_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", limitsUserID)
+	assert.NoError(t, err)
+
+	seedBalance(t, limitsUserID, "BTC", 100.5)
+
+	sut := di.GetBTCRepo()
+
+	// The limits of the User without the default limits are unlimited.
+	got, err := sut.GetTransactionLimits(context.Background(), limitsUserID, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &rpc.TransactionLimits{UserId: limitsUserID, Asset: "BTC"}, got)
+
+	_, err = sut.SetTransactionLimits(context.Background(), &repository.SetTransactionLimitsParams{
+		Asset:               "BTC",
+		MaxAmountSats:       1_000_000_000,
+		MaxDailyOutflowSats: 5_000_000_000,
+		MaxCount:            10,
+		CountWindow:         24 * time.Hour,
+	})
+	assert.NoError(t, err)
+
+	got, err = sut.SetTransactionLimits(context.Background(), &repository.SetTransactionLimitsParams{
+		UserID:              limitsUserID,
+		Asset:               "BTC",
+		MaxDailyOutflowSats: 250_000_001,
+		CountWindow:         time.Hour,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &rpc.TransactionLimits{
+		UserId:              limitsUserID,
+		Asset:               "BTC",
+		MaxDailyOutflowSats: 250_000_001,
+		CountWindowSeconds:  3600,
+	}, got)
+
+	// The limits that aren't set for the User fall back to the default limits.
+	got, err = sut.GetTransactionLimits(context.Background(), limitsUserID, "BTC")
+	assert.NoError(t, err)
+	assert.Equal(t, &rpc.TransactionLimits{
+		UserId:              limitsUserID,
+		Asset:               "BTC",
+		MaxAmountSats:       1_000_000_000,
+		MaxDailyOutflowSats: 250_000_001,
+		MaxCount:            10,
+		CountWindowSeconds:  3600,
+	}, got)
+
+	_, err = sut.SetTransactionLimits(context.Background(), &repository.SetTransactionLimitsParams{
+		UserID:   99999,
+		Asset:    "BTC",
+		MaxCount: 10,
+	})
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	_, err = sut.GetTransactionLimits(context.Background(), 99999, "BTC")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
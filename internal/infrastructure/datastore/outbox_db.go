@@ -0,0 +1,192 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// The classes of the advisory locks of the outbox, the second key is the User ID or 0 for the relay.
+const (
+	outboxLockUser  int32 = 1
+	outboxLockRelay int32 = 2
+)
+
+// insertOutboxEvents writes the TransactionCreated event of each transaction, then the BalanceChanged event of each balance
+// within the given database transaction, thus the events are only relayed once the change is committed.
+//
+// The Users of the events are locked until the commit before the events get the ID, thus the ID of the events
+// of a User follows the order of the commits, even for the transactions of different assets.
+func insertOutboxEvents(
+	ctx context.Context, tx pgx.Tx, transactions []*rpc.Transaction, balances map[int64][]*rpc.UserBalance,
+) error {
+	var (
+		userIDs  []int64
+		types    []string
+		payloads []string
+	)
+
+	for _, t := range transactions {
+		b, err := protojson.Marshal(&rpc.TransactionCreatedEvent{Transaction: t})
+		if err != nil {
+			return err
+		}
+
+		userIDs = append(userIDs, t.GetUserId())
+		types = append(types, repository.OutboxEventTransactionCreated)
+		payloads = append(payloads, string(b))
+	}
+
+	balanceUserIDs := make([]int64, 0, len(balances))
+	for id := range balances {
+		balanceUserIDs = append(balanceUserIDs, id)
+	}
+
+	sort.Slice(balanceUserIDs, func(i, j int) bool { return balanceUserIDs[i] < balanceUserIDs[j] })
+
+	for _, id := range balanceUserIDs {
+		for _, balance := range balances[id] {
+			b, err := protojson.Marshal(&rpc.BalanceChangedEvent{UserId: id, Balance: balance})
+			if err != nil {
+				return err
+			}
+
+			userIDs = append(userIDs, id)
+			types = append(types, repository.OutboxEventBalanceChanged)
+			payloads = append(payloads, string(b))
+		}
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	// The locks are taken in the order of the User ID, thus the concurrent transactions of many Users can't deadlock.
+	query := `SELECT pg_advisory_xact_lock($1::int, u::int)
+				FROM (SELECT DISTINCT u FROM unnest($2::bigint[]) AS u ORDER BY u) AS users`
+
+	_, err := tx.Exec(ctx, query, outboxLockUser, userIDs)
+	if err != nil {
+		return err
+	}
+
+	query = `INSERT INTO outbox_events (user_id, event_type, payload)
+				SELECT e.user_id, e.event_type, e.payload::jsonb
+					FROM unnest($1::bigint[], $2::text[], $3::text[]) WITH ORDINALITY AS e(user_id, event_type, payload, n)
+						ORDER BY e.n`
+
+	_, err = tx.Exec(ctx, query, userIDs, types, payloads)
+
+	return err
+}
+
+// RelayOutboxEvents publishes the unpublished events in the order of the ID, up to the limit,
+// and marks the published events. The relay stops at the first event that failed to publish,
+// thus the following events of the same User aren't published before it.
+// Returns the number of the published events, 0 if another relay is already running.
+//
+// The events are marked once they're published, thus an event is published again if the mark isn't committed,
+// i.e. the delivery is at-least-once.
+func (r *btcRepo) RelayOutboxEvents(
+	ctx context.Context, limit int, publish func(*repository.OutboxEvent) error,
+) (int, error) {
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+	}()
+
+	// A single relay publishes at a time, otherwise the events of a User may be published out of order.
+	var locked bool
+
+	err = tx.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock($1::int, 0)", outboxLockRelay).Scan(&locked)
+	if err != nil {
+		return 0, err
+	}
+
+	if !locked {
+		_ = tx.Rollback(ctx)
+
+		return 0, nil
+	}
+
+	events, err := getUnpublishedEvents(ctx, tx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		ids        []int64
+		errPublish error
+	)
+
+	for _, e := range events {
+		if errPublish = publish(e); errPublish != nil {
+			errPublish = fmt.Errorf("outbox event id: %d: %w", e.ID, errPublish)
+			break
+		}
+
+		ids = append(ids, e.ID)
+	}
+
+	if len(ids) > 0 {
+		_, err = tx.Exec(ctx, "UPDATE outbox_events SET published_at = NOW() WHERE id = ANY($1)", ids)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	errCommit := tx.Commit(ctx)
+	if errCommit != nil {
+		return 0, fmt.Errorf("unable to commit transaction: %v", errCommit)
+	}
+
+	return len(ids), errPublish
+}
+
+// getUnpublishedEvents get the unpublished events ordered by the ID within the given database transaction.
+func getUnpublishedEvents(ctx context.Context, tx pgx.Tx, limit int) ([]*repository.OutboxEvent, error) {
+	query := `SELECT id, user_id, event_type, payload::text, created_at
+				FROM outbox_events
+					WHERE published_at IS NULL
+						ORDER BY id
+							LIMIT $1`
+
+	rows, err := tx.Query(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*repository.OutboxEvent
+
+	for rows.Next() {
+		var (
+			e       repository.OutboxEvent
+			payload string
+		)
+
+		err = rows.Scan(&e.ID, &e.UserID, &e.Type, &payload, &e.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Payload = []byte(payload)
+
+		events = append(events, &e)
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,113 @@
+package datastore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// outboxUserID is the User of the outbox tests.
+const outboxUserID = int64(2001)
+
+// clearOutbox removes the outbox events, the transactions and the User of the outbox tests.
+func clearOutbox(t *testing.T) {
+	t.Helper()
+
+	db := datastore.GetDatabaseMaster()
+
+	_, err := db.Exec(context.Background(), "DELETE FROM outbox_events WHERE user_id = $1", outboxUserID)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", outboxUserID)
+	assert.NoError(t, err)
+
+	clearLedger(t, outboxUserID)
+
+	_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", outboxUserID)
+	assert.NoError(t, err)
+}
+
+// seedOutbox inserts the User of the outbox tests, and marks the events of the other tests as published.
+func seedOutbox(t *testing.T) {
+	t.Helper()
+
+	db := datastore.GetDatabaseMaster()
+
+	// Remove existing data, if any.
+	clearOutbox(t)
+
+	_, err := db.Exec(context.Background(), "UPDATE outbox_events SET published_at = NOW() WHERE published_at IS NULL")
+	assert.NoError(t, err)
+
+	_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", outboxUserID)
+	assert.NoError(t, err)
+
+	seedBalance(t, outboxUserID, "BTC", 100.5)
+}
+
+func TestBTCRepo_RelayOutboxEvents(t *testing.T) {
+	seedOutbox(t)
+
+	defer clearOutbox(t)
+
+	sut := di.GetBTCRepo()
+
+	transaction, balance, err := sut.CreateTransaction(context.Background(), &repository.CreateTransactionParams{
+		UserID:     outboxUserID,
+		Datetime:   time.Now().UTC(),
+		AmountSats: 150_000_000,
+	})
+	assert.NoError(t, err)
+
+	// The failed event stays unpublished, thus it's published again by the next relay.
+	errPublish := errors.New("error")
+
+	n, err := sut.RelayOutboxEvents(context.Background(), 100, func(*repository.OutboxEvent) error {
+		return errPublish
+	})
+	assert.ErrorIs(t, err, errPublish)
+	assert.Equal(t, 0, n)
+
+	var events []*repository.OutboxEvent
+
+	n, err = sut.RelayOutboxEvents(context.Background(), 100, func(e *repository.OutboxEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, outboxUserID, events[0].UserID)
+		assert.Equal(t, repository.OutboxEventTransactionCreated, events[0].Type)
+		assert.Equal(t, outboxUserID, events[1].UserID)
+		assert.Equal(t, repository.OutboxEventBalanceChanged, events[1].Type)
+		assert.Less(t, events[0].ID, events[1].ID)
+
+		created := new(rpc.TransactionCreatedEvent)
+		assert.NoError(t, protojson.Unmarshal(events[0].Payload, created))
+		assert.Equal(t, transaction.GetId(), created.GetTransaction().GetId())
+		assert.Equal(t, int64(150_000_000), created.GetTransaction().GetAmountSats())
+
+		changed := new(rpc.BalanceChangedEvent)
+		assert.NoError(t, protojson.Unmarshal(events[1].Payload, changed))
+		assert.Equal(t, outboxUserID, changed.GetUserId())
+		assert.Equal(t, balance.GetBalanceSats(), changed.GetBalance().GetBalanceSats())
+	}
+
+	// The published events aren't published again.
+	n, err = sut.RelayOutboxEvents(context.Background(), 100, func(*repository.OutboxEvent) error {
+		return errPublish
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
@@ -0,0 +1,89 @@
+package datastore
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultPageSize is the number of records returned when the page size is not set.
+	defaultPageSize = 100
+	// maxPageSize is the maximum number of records returned in a single page.
+	maxPageSize = 1000
+)
+
+// pageLimit returns the number of records for a single page based on the given page size.
+func pageLimit(pageSize int32) int {
+	switch {
+	case pageSize <= 0:
+		return defaultPageSize
+	case pageSize > maxPageSize:
+		return maxPageSize
+	default:
+		return int(pageSize)
+	}
+}
+
+// pageCursor is the position of the last returned record.
+// The ID is only set for the raw records, since the datetime of raw records is not unique.
+type pageCursor struct {
+	Datetime time.Time
+	ID       string
+}
+
+// encodePageToken encodes the cursor into an opaque page token.
+func encodePageToken(cursor pageCursor) string {
+	token := strconv.FormatInt(cursor.Datetime.UnixMicro(), 10)
+
+	if cursor.ID != "" {
+		token += ":" + cursor.ID
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+// decodePageToken decodes the opaque page token into the cursor.
+func decodePageToken(token string) (pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageCursor{}, err
+	}
+
+	micro, id, _ := strings.Cut(string(b), ":")
+
+	datetime, err := strconv.ParseInt(micro, 10, 64)
+	if err != nil {
+		return pageCursor{}, err
+	}
+
+	if id != "" {
+		if _, err = uuid.Parse(id); err != nil {
+			return pageCursor{}, err
+		}
+	}
+
+	return pageCursor{
+		Datetime: time.UnixMicro(datetime).UTC(),
+		ID:       id,
+	}, nil
+}
+
+// encodeIDPageToken encodes the ID of the last returned record into an opaque page token.
+// It's used for the records that are ordered by a numeric ID, e.g. the Users.
+func encodeIDPageToken(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+// decodeIDPageToken decodes the opaque page token into the ID of the last returned record.
+func decodeIDPageToken(token string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(string(b), 10, 64)
+}
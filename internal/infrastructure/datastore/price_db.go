@@ -0,0 +1,69 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/moemoe89/btc/internal/entities/repository"
+)
+
+type priceRepo struct {
+	*BaseRepo
+}
+
+// NewPriceRepo returns PriceSource backed by the historical prices table.
+func NewPriceRepo(base *BaseRepo) repository.PriceSource {
+	return &priceRepo{
+		BaseRepo: base,
+	}
+}
+
+// GetPrices get the latest price at or before each of the datetimes, in the same order as the datetimes.
+// Returns ErrPriceNotFound if there's no price for any of the datetimes.
+func (r *priceRepo) GetPrices(ctx context.Context, params *repository.GetPricesParams) ([]*repository.Price, error) {
+	// Each datetime looks up its own latest price, thus the prices of a whole page are got in a single query.
+	query := `SELECT p.datetime, p.price::float8
+				FROM unnest($3::timestamptz[]) WITH ORDINALITY AS d(datetime, i)
+					LEFT JOIN LATERAL (
+						SELECT datetime, price
+							FROM prices
+								WHERE asset = $1 AND quote_currency = $2 AND datetime <= d.datetime
+									ORDER BY datetime DESC
+										LIMIT 1
+					) p ON true
+						ORDER BY d.i`
+
+	rows, err := r.dbSlave.Query(ctx, query, params.Asset, params.QuoteCurrency, params.Datetimes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prices := make([]*repository.Price, 0, len(params.Datetimes))
+
+	for rows.Next() {
+		var (
+			datetime *time.Time
+			price    *float64
+		)
+
+		err = rows.Scan(&datetime, &price)
+		if err != nil {
+			return nil, err
+		}
+
+		if datetime == nil || price == nil {
+			return nil, fmt.Errorf("%s/%s at %s: %w",
+				params.Asset, params.QuoteCurrency, params.Datetimes[len(prices)].Format(time.RFC3339), ErrPriceNotFound,
+			)
+		}
+
+		prices = append(prices, &repository.Price{
+			Datetime: *datetime,
+			Price:    *price,
+		})
+	}
+
+	return prices, rows.Err()
+}
@@ -0,0 +1,123 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceRepo_GetPrices(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.GetPricesParams
+	}
+
+	type test struct {
+		args       args
+		want       []*repository.Price
+		wantErr    error
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	datetime1 := time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+	datetime2 := datetime1.Add(time.Hour)
+
+	seed := func(t *testing.T) {
+		t.Helper()
+
+		// Remove existing data, if any.
+		_, err := db.Exec(context.Background(), "DELETE FROM prices WHERE asset = 'BTC' AND quote_currency = 'USD'")
+		assert.NoError(t, err)
+
+		// Insert test data.
+		_, err = db.Exec(context.Background(),
+			"INSERT INTO prices (asset, quote_currency, datetime, price) VALUES ('BTC', 'USD', $1, 20000), ('BTC', 'USD', $2, 21000.5)",
+			datetime1, datetime2,
+		)
+		assert.NoError(t, err)
+	}
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM prices WHERE asset = 'BTC' AND quote_currency = 'USD'")
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Get prices, When query executed successfully, Return the latest price at or before each datetime": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.GetPricesParams{
+						Asset:         "BTC",
+						QuoteCurrency: "USD",
+						Datetimes:     []time.Time{datetime2.Add(time.Minute), datetime1, datetime1.Add(time.Minute)},
+					},
+				},
+				want: []*repository.Price{
+					{Datetime: datetime2, Price: 21000.5},
+					{Datetime: datetime1, Price: 20000},
+					{Datetime: datetime1, Price: 20000},
+				},
+				wantErr:    nil,
+				beforeFunc: seed,
+				afterFunc:  clear,
+			}
+		},
+		"Given valid query of Get prices before the first price, When query executed, Return price not found error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.GetPricesParams{
+						Asset:         "BTC",
+						QuoteCurrency: "USD",
+						Datetimes:     []time.Time{datetime1, datetime1.Add(-time.Minute)},
+					},
+				},
+				want:       nil,
+				wantErr:    datastore.ErrPriceNotFound,
+				beforeFunc: seed,
+				afterFunc:  clear,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetPriceSource()
+
+			got, err := sut.GetPrices(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			// The datetime is compared as the instant, since the time zone depends on the database session.
+			for i := range got {
+				got[i].Datetime = got[i].Datetime.UTC()
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
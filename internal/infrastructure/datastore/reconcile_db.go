@@ -0,0 +1,158 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type reconcileRepo struct {
+	*BaseRepo
+}
+
+// NewReconcileRepo returns ReconcileRepo.
+func NewReconcileRepo(base *BaseRepo) repository.ReconcileRepo {
+	return &reconcileRepo{
+		BaseRepo: base,
+	}
+}
+
+// FindBalanceMismatches compares the balance of each User and asset with the sum of its transactions,
+// and returns the mismatches ordered by the User ID and the asset.
+// The master is queried, since a lagging replica would report the mismatches that don't exist.
+func (r *reconcileRepo) FindBalanceMismatches(ctx context.Context) ([]*repository.BalanceMismatch, error) {
+	// The balance is the sum of the postings of the wallet account of the User.
+	query := `WITH balances AS (
+					SELECT a.user_id, a.asset, SUM(p.amount) AS balance
+						FROM postings p
+							JOIN ledger_accounts a ON a.code = p.account_code
+								WHERE a.type = $1
+									GROUP BY a.user_id, a.asset
+				), sums AS (
+					SELECT user_id, asset, SUM(amount) AS amount FROM transactions GROUP BY user_id, asset
+				)
+				SELECT COALESCE(b.user_id, s.user_id) AS user_id, COALESCE(b.asset, s.asset) AS asset,
+					trim_scale(COALESCE(b.balance, 0))::text, trim_scale(COALESCE(s.amount, 0))::text,
+					trim_scale(COALESCE(s.amount, 0) - COALESCE(b.balance, 0))::text
+					FROM balances b
+						FULL JOIN sums s ON s.user_id = b.user_id AND s.asset = b.asset
+							WHERE COALESCE(b.balance, 0) <> COALESCE(s.amount, 0)
+								ORDER BY user_id, asset`
+
+	rows, err := r.dbMaster.Query(ctx, query, accountTypeUser)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mismatches []*repository.BalanceMismatch
+
+	for rows.Next() {
+		var m repository.BalanceMismatch
+
+		err = rows.Scan(&m.UserID, &m.Asset, &m.Balance, &m.Expected, &m.Difference)
+		if err != nil {
+			return nil, err
+		}
+
+		mismatches = append(mismatches, &m)
+	}
+
+	return mismatches, rows.Err()
+}
+
+// RepairBalance rewrites the balance of the User for the asset into the sum of its transactions under lock.
+// Since the balances are derived from the ledger, the difference is posted as a reconciliation journal entry
+// against the house account instead of overwriting the balance.
+// Returns the repaired mismatch, nil if the balance already agrees once it's locked.
+func (r *reconcileRepo) RepairBalance(ctx context.Context, userID int64, code string) (*repository.BalanceMismatch, error) {
+	a, err := lookupAsset(code)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+	}()
+
+	err = lockUser(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The mismatch is computed again under lock, thus the concurrent transactions are either fully counted or not at all.
+	query := `SELECT trim_scale(b.balance)::text, trim_scale(s.amount)::text, trim_scale(s.amount - b.balance)::text
+				FROM (
+					SELECT account_balance($1, $2) AS balance
+				) b, (
+					SELECT COALESCE(SUM(amount), 0) AS amount FROM transactions WHERE user_id = $1 AND asset = $2
+				) s`
+
+	mismatch := &repository.BalanceMismatch{UserID: userID, Asset: a.Code}
+
+	err = tx.QueryRow(ctx, query, userID, a.Code).Scan(&mismatch.Balance, &mismatch.Expected, &mismatch.Difference)
+	if err != nil {
+		return nil, err
+	}
+
+	differenceSats, err := a.ParseUnits(mismatch.Difference)
+	if err != nil {
+		err = fmt.Errorf("user id: %d asset: %s difference: %s: %w", userID, a.Code, mismatch.Difference, err)
+
+		return nil, err
+	}
+
+	if differenceSats == 0 {
+		_ = tx.Rollback(ctx)
+
+		return nil, nil
+	}
+
+	entry := &journalEntry{
+		ID:       uuid.NewString(),
+		Kind:     journalKindReconciliation,
+		Datetime: time.Now().UTC(),
+		Postings: []posting{
+			{Account: userAccount(userID, a), AmountSats: differenceSats},
+			{Account: ledgerAccount{Type: accountTypeHouse, Asset: a}, AmountSats: -differenceSats},
+		},
+	}
+
+	err = postJournalEntries(ctx, tx, []*journalEntry{entry})
+	if err != nil {
+		return nil, err
+	}
+
+	errCommit := tx.Commit(ctx)
+	if errCommit != nil {
+		return nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
+	}
+
+	return mismatch, nil
+}
+
+// lockUser locks the User within the given database transaction, the same way as the batch of transactions,
+// thus the postings of the User can't change until the commit.
+func lockUser(ctx context.Context, tx pgx.Tx, userID int64) error {
+	var id int64
+
+	err := tx.QueryRow(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return fmt.Errorf("user id: %d not found: %w", userID, ErrNotFound)
+	}
+
+	return err
+}
@@ -0,0 +1,228 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcileRepo_FindBalanceMismatches(t *testing.T) {
+	type test struct {
+		want       *repository.BalanceMismatch
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	userID := int64(1991)
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+		assert.NoError(t, err)
+
+		clearLedger(t, userID)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given the balance disagrees with the transactions, When query executed successfully, Return the mismatch": func(t *testing.T) test {
+			return test{
+				want: &repository.BalanceMismatch{
+					UserID:     userID,
+					Asset:      "BTC",
+					Balance:    "100.5",
+					Expected:   "40",
+					Difference: "-60.5",
+				},
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clear(t)
+
+					// Insert test data.
+					_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					seedBalance(t, userID, "BTC", 100.5)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO transactions (id, datetime, user_id, amount) VALUES (gen_random_uuid(), $1, $2, $3)",
+						time.Now().UTC(), userID, 40,
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: clear,
+			}
+		},
+		"Given the balance of ETH agrees with the transactions, When query executed successfully, Return no mismatch of the User": func(t *testing.T) test {
+			return test{
+				want: nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clear(t)
+
+					// Insert test data.
+					_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+
+					seedBalance(t, userID, "ETH", 1.5)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO transactions (id, datetime, user_id, amount, asset) VALUES (gen_random_uuid(), $1, $2, $3, 'ETH')",
+						time.Now().UTC(), userID, 1.5,
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: clear,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetReconcileRepo()
+
+			mismatches, err := sut.FindBalanceMismatches(context.Background())
+			assert.NoError(t, err)
+
+			// The other Users may have mismatches as well, thus only the mismatch of the test User is compared.
+			var got *repository.BalanceMismatch
+
+			for _, m := range mismatches {
+				if m.UserID == userID {
+					got = m
+				}
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReconcileRepo_RepairBalance(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userID int64
+		asset  string
+	}
+
+	type test struct {
+		args        args
+		want        *repository.BalanceMismatch
+		wantBalance float64
+		wantErr     error
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	userID := int64(1991)
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+		assert.NoError(t, err)
+
+		clearLedger(t, userID)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given the balance disagrees with the transactions, When repaired successfully, Return the mismatch and rewrite the balance": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx:    context.Background(),
+					userID: userID,
+					asset:  "BTC",
+				},
+				want: &repository.BalanceMismatch{
+					UserID:     userID,
+					Asset:      "BTC",
+					Balance:    "100.5",
+					Expected:   "40",
+					Difference: "-60.5",
+				},
+				wantBalance: 40,
+				wantErr:     nil,
+			}
+		},
+		"Given the User doesn't exist, When repaired, Return not found error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx:    context.Background(),
+					userID: 99999,
+					asset:  "BTC",
+				},
+				want:        nil,
+				wantBalance: 100.5,
+				wantErr:     datastore.ErrNotFound,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			// Remove existing data, if any.
+			clear(t)
+
+			defer clear(t)
+
+			// Insert test data.
+			_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+			assert.NoError(t, err)
+
+			seedBalance(t, userID, "BTC", 100.5)
+
+			_, err = db.Exec(context.Background(),
+				"INSERT INTO transactions (id, datetime, user_id, amount) VALUES (gen_random_uuid(), $1, $2, $3)",
+				time.Now().UTC(), userID, 40,
+			)
+			assert.NoError(t, err)
+
+			sut := di.GetReconcileRepo()
+
+			got, err := sut.RepairBalance(tt.args.ctx, tt.args.userID, tt.args.asset)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+
+			// The balance is rewritten by the postings of the reconciliation journal entry.
+			var balance float64
+
+			err = db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBalance, balance)
+		})
+	}
+}
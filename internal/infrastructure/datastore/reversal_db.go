@@ -0,0 +1,122 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ReverseTransaction creates a compensating transaction with the opposite amount of a transaction
+// within a single database transaction.
+// Returns the balance of the User right after the reversal is committed.
+func (r *btcRepo) ReverseTransaction(
+	ctx context.Context, params *repository.ReverseTransactionParams,
+) (*rpc.Transaction, *rpc.UserBalance, error) {
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+	}()
+
+	var original data
+
+	err = original.scan(tx.QueryRow(ctx, `SELECT `+transactionColumns+` FROM transactions WHERE id = $1`, params.ID))
+	if err == pgx.ErrNoRows {
+		err = fmt.Errorf("transaction id: %s not found: %w", params.ID, ErrNotFound)
+
+		return nil, nil, err
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Reversing a leg of a transfer leaves the other User untouched, thus it's refused as well as a reversal of a reversal.
+	if original.ReversedID != "" || original.TransferID != "" {
+		err = fmt.Errorf("transaction id: %s is a reversal or a part of a transfer: %w", params.ID, ErrInvalidReversal)
+
+		return nil, nil, err
+	}
+
+	a, err := lookupAsset(original.Asset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reversal := data{
+		ID:             uuid.NewString(),
+		JournalEntryID: uuid.NewString(),
+		Datetime:       time.Now().UTC(),
+		UserID:         original.UserID,
+		AmountSats:     -original.AmountSats,
+		ReversedID:     original.ID,
+		ReversalReason: params.Reason,
+		Asset:          a.Code,
+	}
+
+	// The primary key makes a concurrent reversal of the same transaction wait until this one is done.
+	tag, err := tx.Exec(ctx,
+		`INSERT INTO transaction_reversals (transaction_id, reversal_id) VALUES ($1, $2) ON CONFLICT (transaction_id) DO NOTHING`,
+		original.ID, reversal.ID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tag.RowsAffected() == 0 {
+		err = fmt.Errorf("transaction id: %s: %w", params.ID, ErrAlreadyReversed)
+
+		return nil, nil, err
+	}
+
+	query := `INSERT INTO transactions (id, datetime, user_id, amount, reversed_id, reversal_reason, asset, journal_entry_id)
+				VALUES ($1, $2, $3, $4::bigint / $7::numeric, $5, $6, $8, $9)`
+
+	_, err = tx.Exec(ctx, query,
+		reversal.ID, reversal.Datetime, reversal.UserID, reversal.AmountSats, reversal.ReversedID, reversal.ReversalReason,
+		a.Scale(), a.Code, reversal.JournalEntryID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The reversal is posted against the same counterparty account as the original, e.g. a reversed fee is refunded
+	// from the fee account.
+	// Reversing a credit is a debit, thus it's rejected the same way when it overdraws the balance.
+	err = postJournalEntries(ctx, tx, []*journalEntry{transactionEntry(journalKindReversal, &reversal, a, original.Type)})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balance, err := getUserBalance(ctx, tx, reversal.UserID, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transaction := reversal.toProto()
+
+	err = insertOutboxEvents(ctx, tx, []*rpc.Transaction{transaction},
+		map[int64][]*rpc.UserBalance{reversal.UserID: {balance}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errCommit := tx.Commit(ctx)
+	if errCommit != nil {
+		return nil, nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
+	}
+
+	return transaction, balance, nil
+}
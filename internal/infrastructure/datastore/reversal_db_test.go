@@ -0,0 +1,198 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBTCRepo_ReverseTransaction(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.ReverseTransactionParams
+	}
+
+	type test struct {
+		args        args
+		want        *rpc.Transaction
+		wantBalance *rpc.UserBalance
+		wantErr     error
+		beforeFunc  func(*testing.T)
+		afterFunc   func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	userID := int64(1989)
+	transactionID := "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a"
+	datetime := time.Now().UTC().Add(-time.Hour)
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM transaction_reversals WHERE transaction_id = $1", transactionID)
+		assert.NoError(t, err)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+		assert.NoError(t, err)
+
+		clearLedger(t, userID)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+		assert.NoError(t, err)
+	}
+
+	seed := func(t *testing.T) {
+		t.Helper()
+
+		// Remove existing data, if any.
+		clear(t)
+
+		// Insert test data.
+		_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+		assert.NoError(t, err)
+
+		seedBalance(t, userID, "BTC", 100.5)
+
+		_, err = db.Exec(context.Background(),
+			"INSERT INTO transactions (id, datetime, user_id, amount) VALUES ($1, $2, $3, $4)", transactionID, datetime, userID, 40,
+		)
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Reverse transaction, When query executed successfully, Return the compensating transaction": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ReverseTransactionParams{
+						ID:     transactionID,
+						Reason: "duplicated deposit",
+					},
+				},
+				want: &rpc.Transaction{
+					UserId:         userID,
+					Amount:         -40,
+					AmountSats:     -4_000_000_000,
+					ReversedId:     transactionID,
+					ReversalReason: "duplicated deposit",
+					Asset:          "BTC",
+				},
+				wantBalance: &rpc.UserBalance{
+					Balance:              60.5,
+					BalanceSats:          6_050_000_000,
+					Asset:                "BTC",
+					AvailableBalance:     60.5,
+					AvailableBalanceSats: 6_050_000_000,
+				},
+				wantErr:    nil,
+				beforeFunc: seed,
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the reversal is linked to the original.
+					var count int
+
+					err := db.QueryRow(context.Background(),
+						"SELECT COUNT(*) FROM transactions WHERE reversed_id = $1", transactionID,
+					).Scan(&count)
+					assert.NoError(t, err)
+					assert.Equal(t, 1, count)
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+		"Given valid query of Reverse transaction already reversed, When query executed, Return an error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ReverseTransactionParams{
+						ID:     transactionID,
+						Reason: "duplicated deposit",
+					},
+				},
+				want:    nil,
+				wantErr: datastore.ErrAlreadyReversed,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					seed(t)
+
+					_, err := db.Exec(context.Background(),
+						"INSERT INTO transaction_reversals (transaction_id, reversal_id) VALUES ($1, $2)",
+						transactionID, "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the balance is untouched.
+					var balance float64
+
+					err := db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+					assert.NoError(t, err)
+					assert.Equal(t, 100.5, balance)
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+		"Given valid query of Reverse transaction, When query executed successfully with no Transaction found, Return an error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ReverseTransactionParams{
+						ID:     "6e7f8a9b-0c1d-4e2f-a3b4-c5d6e7f8a9b0",
+						Reason: "duplicated deposit",
+					},
+				},
+				want:    nil,
+				wantErr: datastore.ErrNotFound,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetBTCRepo()
+
+			got, gotBalance, err := sut.ReverseTransaction(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			// The ID and datetime are generated by server, thus only make sure they're not empty.
+			if tt.want != nil {
+				assert.NotEmpty(t, got.GetId())
+				assert.NotNil(t, got.GetDatetime())
+
+				tt.want.Id, tt.want.Datetime = got.GetId(), got.GetDatetime()
+			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantBalance, gotBalance)
+		})
+	}
+}
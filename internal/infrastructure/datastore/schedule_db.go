@@ -0,0 +1,375 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// scheduleStatusActive is the stored status of a schedule with the next run.
+	scheduleStatusActive = "active"
+	// scheduleStatusCompleted is the stored status of a schedule without more runs.
+	scheduleStatusCompleted = "completed"
+	// scheduleStatusCancelled is the stored status of a schedule cancelled before it has no more runs.
+	scheduleStatusCancelled = "cancelled"
+)
+
+// scheduleStatuses maps the stored status of a schedule into proto enum.
+var scheduleStatuses = map[string]rpc.ScheduleStatus{
+	scheduleStatusActive:    rpc.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+	scheduleStatusCompleted: rpc.ScheduleStatus_SCHEDULE_STATUS_COMPLETED,
+	scheduleStatusCancelled: rpc.ScheduleStatus_SCHEDULE_STATUS_CANCELLED,
+}
+
+// scheduleIntervals maps the stored interval of a schedule into proto enum.
+var scheduleIntervals = map[string]rpc.ScheduleInterval{
+	"once":    rpc.ScheduleInterval_SCHEDULE_INTERVAL_ONCE,
+	"daily":   rpc.ScheduleInterval_SCHEDULE_INTERVAL_DAILY,
+	"weekly":  rpc.ScheduleInterval_SCHEDULE_INTERVAL_WEEKLY,
+	"monthly": rpc.ScheduleInterval_SCHEDULE_INTERVAL_MONTHLY,
+}
+
+// scheduleIntervalName returns the stored interval of the proto enum.
+func scheduleIntervalName(interval rpc.ScheduleInterval) (string, error) {
+	for name, v := range scheduleIntervals {
+		if v == interval {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("interval: %s: %w", interval, ErrInvalidSchedule)
+}
+
+// scheduleColumns is the selected columns for scanning a schedule row.
+// The amount is selected as the exact decimal, since the minor unit depends on the asset of the row.
+const scheduleColumns = `id::text, user_id, asset, amount::text, COALESCE(type, ''), COALESCE(description, ''), labels,
+	interval, start_at, end_at, next_run_at, status, run_count, last_run_at, COALESCE(last_transaction_id::text, ''),
+	COALESCE(last_error, ''), created_at, cancelled_at`
+
+// scheduleData is a struct for scanning a schedule row.
+type scheduleData struct {
+	ID                string
+	UserID            int64
+	Asset             string
+	AmountSats        int64
+	Type              string
+	Description       string
+	Labels            map[string]string
+	Interval          string
+	StartAt           time.Time
+	EndAt             *time.Time
+	NextRunAt         *time.Time
+	Status            string
+	RunCount          int64
+	LastRunAt         *time.Time
+	LastTransactionID string
+	LastError         string
+	CreatedAt         time.Time
+	CancelledAt       *time.Time
+}
+
+// scan scans the scheduleColumns of the row.
+func (s *scheduleData) scan(row pgx.Row) error {
+	var amount string
+
+	err := row.Scan(&s.ID, &s.UserID, &s.Asset, &amount, &s.Type, &s.Description, &s.Labels, &s.Interval,
+		&s.StartAt, &s.EndAt, &s.NextRunAt, &s.Status, &s.RunCount, &s.LastRunAt, &s.LastTransactionID,
+		&s.LastError, &s.CreatedAt, &s.CancelledAt)
+	if err != nil {
+		return err
+	}
+
+	a, err := lookupAsset(s.Asset)
+	if err != nil {
+		return err
+	}
+
+	s.AmountSats, err = a.ParseUnits(amount)
+
+	return err
+}
+
+// optionalTimestamp converts the optional date and time into proto message, nil if it's not set.
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// toProto converts the scanned schedule row into proto message.
+func (s *scheduleData) toProto() *rpc.Schedule {
+	schedule := &rpc.Schedule{
+		Id:                s.ID,
+		UserId:            s.UserID,
+		Asset:             s.Asset,
+		AmountSats:        s.AmountSats,
+		Type:              transactionTypes[s.Type],
+		Description:       s.Description,
+		Interval:          scheduleIntervals[s.Interval],
+		StartAt:           timestamppb.New(s.StartAt),
+		EndAt:             optionalTimestamp(s.EndAt),
+		NextRunAt:         optionalTimestamp(s.NextRunAt),
+		Status:            scheduleStatuses[s.Status],
+		RunCount:          s.RunCount,
+		LastRunAt:         optionalTimestamp(s.LastRunAt),
+		LastTransactionId: s.LastTransactionID,
+		LastError:         s.LastError,
+		CreatedAt:         timestamppb.New(s.CreatedAt),
+		CancelledAt:       optionalTimestamp(s.CancelledAt),
+	}
+
+	// The empty labels are left unset, the same as a schedule without labels.
+	if len(s.Labels) > 0 {
+		schedule.Labels = s.Labels
+	}
+
+	return schedule
+}
+
+// CreateSchedule creates a schedule of a User, the first run is at the start time.
+func (r *btcRepo) CreateSchedule(ctx context.Context, params *repository.CreateScheduleParams) (*rpc.Schedule, error) {
+	a, err := lookupAsset(params.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	typeName, err := transactionTypeName(params.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := scheduleIntervalName(params.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	var endAt *time.Time
+
+	if !params.EndAt.IsZero() {
+		endAt = &params.EndAt
+	}
+
+	labels := params.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	var s scheduleData
+
+	// The amount is stored in units of the asset, the conversion from minor units is done by the database to keep it exact.
+	query := `INSERT INTO schedules (id, user_id, asset, amount, type, description, labels, interval, start_at, end_at, next_run_at)
+				SELECT $1::uuid, $2::integer, $3::text, $4::bigint / $5::numeric, NULLIF($6::text, ''), NULLIF($7::text, ''),
+					$8::jsonb, $9::text, $10::timestamptz, $11::timestamptz, $10::timestamptz
+					WHERE EXISTS (SELECT 1 FROM users WHERE id = $2)
+				RETURNING ` + scheduleColumns
+
+	err = s.scan(r.dbMaster.QueryRow(ctx, query,
+		uuid.NewString(), params.UserID, a.Code, params.AmountSats, a.Scale(), typeName, params.Description,
+		labels, interval, params.StartAt, endAt,
+	))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("user id: %d not found: %w", params.UserID, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toProto(), nil
+}
+
+// ListSchedules get the list of schedules ordered by the created time and the ID.
+// The schedules can be filtered by the User and the status.
+func (r *btcRepo) ListSchedules(ctx context.Context, params *repository.ListSchedulesParams) ([]*rpc.Schedule, string, error) {
+	cursor := pageCursor{ID: uuid.Nil.String()}
+
+	if params.PageToken != "" {
+		var err error
+
+		cursor, err = decodePageToken(params.PageToken)
+		if err != nil || cursor.ID == "" {
+			return nil, "", fmt.Errorf("page token: %s: %w", params.PageToken, ErrInvalidPageToken)
+		}
+	}
+
+	var status string
+
+	if params.Status != rpc.ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED {
+		for s, v := range scheduleStatuses {
+			if v == params.Status {
+				status = s
+			}
+		}
+
+		if status == "" {
+			return nil, "", fmt.Errorf("status: %s: %w", params.Status, ErrInvalidSchedule)
+		}
+	}
+
+	limit := pageLimit(params.PageSize)
+
+	query := `SELECT ` + scheduleColumns + `
+				FROM schedules
+					WHERE ($1::integer = 0 OR user_id = $1) AND ($2::text = '' OR status = $2)
+						AND (created_at, id) > ($3::timestamptz, $4::uuid)
+							ORDER BY created_at, id
+								LIMIT $5`
+
+	// Query one more record to know whether there's a next page.
+	rows, err := r.dbSlave.Query(ctx, query, params.UserID, status, cursor.Datetime, cursor.ID, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var schedules []*rpc.Schedule
+
+	for rows.Next() {
+		var s scheduleData
+
+		err = s.scan(rows)
+		if err != nil {
+			return nil, "", err
+		}
+
+		schedules = append(schedules, s.toProto())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(schedules) <= limit {
+		return schedules, "", nil
+	}
+
+	schedules = schedules[:limit]
+	last := schedules[limit-1]
+
+	return schedules, encodePageToken(pageCursor{
+		Datetime: last.GetCreatedAt().AsTime(),
+		ID:       last.GetId(),
+	}), nil
+}
+
+// CancelSchedule cancels an active schedule, thus the next runs aren't created.
+// Cancelling an already cancelled schedule returns the schedule as is,
+// while the completed schedule returns ErrScheduleFinished.
+func (r *btcRepo) CancelSchedule(ctx context.Context, id string) (*rpc.Schedule, error) {
+	var s scheduleData
+
+	query := `UPDATE schedules SET status = $2, next_run_at = NULL, locked_until = NULL, cancelled_at = NOW()
+				WHERE id = $1 AND status = $3
+					RETURNING ` + scheduleColumns
+
+	err := s.scan(r.dbMaster.QueryRow(ctx, query, id, scheduleStatusCancelled, scheduleStatusActive))
+	if err == nil {
+		return s.toProto(), nil
+	}
+
+	if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	// The schedule isn't active, thus it's read from the master to report the current status.
+	err = s.scan(r.dbMaster.QueryRow(ctx, `SELECT `+scheduleColumns+` FROM schedules WHERE id = $1`, id))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("schedule id: %s not found: %w", id, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Status == scheduleStatusCompleted {
+		return nil, fmt.Errorf("schedule id: %s is %s: %w", id, s.Status, ErrScheduleFinished)
+	}
+
+	return s.toProto(), nil
+}
+
+// ClaimDueSchedules locks the active schedules with the next run at or before now for the lease duration,
+// thus the other replicas skip them until the run is finished or the lease ends.
+// The due schedules locked by a concurrent claim are skipped instead of waited for.
+func (r *btcRepo) ClaimDueSchedules(ctx context.Context, limit int, lease time.Duration) ([]*rpc.Schedule, error) {
+	// The clock of the database is used, thus the replicas agree on the due schedules and the leases.
+	query := `UPDATE schedules SET locked_until = NOW() + make_interval(secs => $2)
+				WHERE id IN (
+					SELECT id FROM schedules
+						WHERE status = $3 AND next_run_at <= NOW() AND (locked_until IS NULL OR locked_until <= NOW())
+							ORDER BY next_run_at
+								LIMIT $1
+									FOR UPDATE SKIP LOCKED
+				)
+					RETURNING ` + scheduleColumns
+
+	rows, err := r.dbMaster.Query(ctx, query, limit, lease.Seconds(), scheduleStatusActive)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schedules []*rpc.Schedule
+
+	for rows.Next() {
+		var s scheduleData
+
+		err = s.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		schedules = append(schedules, s.toProto())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// FinishScheduleRun records the run of a claimed schedule and moves it into the next run, then releases the lease.
+// The schedule without the next run is completed.
+// Returns ErrScheduleFinished if the run is already finished or the schedule isn't active anymore.
+func (r *btcRepo) FinishScheduleRun(ctx context.Context, params *repository.FinishScheduleRunParams) (*rpc.Schedule, error) {
+	var (
+		s         scheduleData
+		nextRunAt *time.Time
+		status    = scheduleStatusCompleted
+	)
+
+	if !params.NextRunAt.IsZero() {
+		nextRunAt = &params.NextRunAt
+		status = scheduleStatusActive
+	}
+
+	// The next run is part of the condition, thus a run finished twice is only recorded once.
+	query := `UPDATE schedules SET next_run_at = $3, status = $4, run_count = run_count + 1, last_run_at = $2,
+				last_transaction_id = NULLIF($5::text, '')::uuid, last_error = NULLIF($6::text, ''), locked_until = NULL
+					WHERE id = $1 AND status = $7 AND next_run_at = $2
+						RETURNING ` + scheduleColumns
+
+	err := s.scan(r.dbMaster.QueryRow(ctx, query,
+		params.ID, params.RunAt, nextRunAt, status, params.TransactionID, params.Error, scheduleStatusActive,
+	))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("schedule id: %s run at: %s: %w", params.ID, params.RunAt.Format(time.RFC3339), ErrScheduleFinished)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toProto(), nil
+}
@@ -0,0 +1,169 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// scheduleUserID is the User of the schedule tests.
+const scheduleUserID = int64(2000)
+
+// clearSchedules removes the schedules and the User of the schedule tests.
+func clearSchedules(t *testing.T) {
+	t.Helper()
+
+	db := datastore.GetDatabaseMaster()
+
+	_, err := db.Exec(context.Background(), "DELETE FROM schedules WHERE user_id = $1", scheduleUserID)
+	assert.NoError(t, err)
+
+	clearLedger(t, scheduleUserID)
+
+	_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", scheduleUserID)
+	assert.NoError(t, err)
+}
+
+// seedSchedules inserts the User of the schedule tests.
+func seedSchedules(t *testing.T) {
+	t.Helper()
+
+	// Remove existing data, if any.
+	clearSchedules(t)
+
+	_, err := datastore.GetDatabaseMaster().Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", scheduleUserID)
+	assert.NoError(t, err)
+
+	seedBalance(t, scheduleUserID, "BTC", 100.5)
+}
+
+func TestBTCRepo_CreateSchedule(t *testing.T) {
+	seedSchedules(t)
+
+	defer clearSchedules(t)
+
+	sut := di.GetBTCRepo()
+
+	startAt := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+
+	got, err := sut.CreateSchedule(context.Background(), &repository.CreateScheduleParams{
+		UserID:      scheduleUserID,
+		AmountSats:  -150_000_001,
+		Interval:    rpc.ScheduleInterval_SCHEDULE_INTERVAL_WEEKLY,
+		StartAt:     startAt,
+		Type:        rpc.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+		Description: "savings",
+		Labels:      map[string]string{"product": "savings"},
+	})
+	assert.NoError(t, err)
+
+	assert.NotEmpty(t, got.GetId())
+	assert.Equal(t, "BTC", got.GetAsset())
+	assert.Equal(t, int64(-150_000_001), got.GetAmountSats())
+	assert.Equal(t, rpc.TransactionType_TRANSACTION_TYPE_WITHDRAWAL, got.GetType())
+	assert.Equal(t, map[string]string{"product": "savings"}, got.GetLabels())
+	assert.Equal(t, rpc.ScheduleInterval_SCHEDULE_INTERVAL_WEEKLY, got.GetInterval())
+	assert.Equal(t, startAt, got.GetStartAt().AsTime())
+	assert.Equal(t, startAt, got.GetNextRunAt().AsTime())
+	assert.Nil(t, got.GetEndAt())
+	assert.Equal(t, rpc.ScheduleStatus_SCHEDULE_STATUS_ACTIVE, got.GetStatus())
+
+	_, err = sut.CreateSchedule(context.Background(), &repository.CreateScheduleParams{
+		UserID:     99999,
+		AmountSats: 1,
+		Interval:   rpc.ScheduleInterval_SCHEDULE_INTERVAL_ONCE,
+		StartAt:    startAt,
+	})
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	list, nextPageToken, err := sut.ListSchedules(context.Background(), &repository.ListSchedulesParams{
+		UserID: scheduleUserID,
+		Status: rpc.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*rpc.Schedule{got}, list)
+	assert.Empty(t, nextPageToken)
+}
+
+func TestBTCRepo_ClaimDueSchedules(t *testing.T) {
+	seedSchedules(t)
+
+	defer clearSchedules(t)
+
+	sut := di.GetBTCRepo()
+
+	runAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Microsecond)
+
+	// The schedule is created in the future, then moved into the past to be due.
+	schedule, err := sut.CreateSchedule(context.Background(), &repository.CreateScheduleParams{
+		UserID:     scheduleUserID,
+		AmountSats: 1_000_000,
+		Interval:   rpc.ScheduleInterval_SCHEDULE_INTERVAL_DAILY,
+		StartAt:    time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+
+	_, err = datastore.GetDatabaseMaster().Exec(context.Background(),
+		"UPDATE schedules SET start_at = $2, next_run_at = $2 WHERE id = $1", schedule.GetId(), runAt)
+	assert.NoError(t, err)
+
+	claimed, err := sut.ClaimDueSchedules(context.Background(), 100, time.Minute)
+	assert.NoError(t, err)
+
+	var ids []string
+	for _, s := range claimed {
+		ids = append(ids, s.GetId())
+	}
+
+	assert.Contains(t, ids, schedule.GetId())
+
+	// The claimed schedule is locked for the lease, thus it's skipped by the next claim.
+	claimed, err = sut.ClaimDueSchedules(context.Background(), 100, time.Minute)
+	assert.NoError(t, err)
+
+	for _, s := range claimed {
+		assert.NotEqual(t, schedule.GetId(), s.GetId())
+	}
+
+	got, err := sut.FinishScheduleRun(context.Background(), &repository.FinishScheduleRunParams{
+		ID:        schedule.GetId(),
+		RunAt:     runAt,
+		NextRunAt: runAt.AddDate(0, 0, 1),
+		Error:     "insufficient balance",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, rpc.ScheduleStatus_SCHEDULE_STATUS_ACTIVE, got.GetStatus())
+	assert.Equal(t, runAt.AddDate(0, 0, 1), got.GetNextRunAt().AsTime())
+	assert.Equal(t, runAt, got.GetLastRunAt().AsTime())
+	assert.Equal(t, int64(1), got.GetRunCount())
+	assert.Equal(t, "insufficient balance", got.GetLastError())
+
+	// Check the run can't be finished twice.
+	_, err = sut.FinishScheduleRun(context.Background(), &repository.FinishScheduleRunParams{
+		ID:    schedule.GetId(),
+		RunAt: runAt,
+	})
+	assert.ErrorIs(t, err, datastore.ErrScheduleFinished)
+
+	got, err = sut.CancelSchedule(context.Background(), schedule.GetId())
+	assert.NoError(t, err)
+	assert.Equal(t, rpc.ScheduleStatus_SCHEDULE_STATUS_CANCELLED, got.GetStatus())
+	assert.Nil(t, got.GetNextRunAt())
+	assert.NotNil(t, got.GetCancelledAt())
+
+	// Cancelling an already cancelled schedule returns the schedule as is.
+	cancelled, err := sut.CancelSchedule(context.Background(), schedule.GetId())
+	assert.NoError(t, err)
+	assert.Equal(t, got, cancelled)
+
+	_, err = sut.CancelSchedule(context.Background(), "6b7c8d9e-0000-4000-8000-000000000009")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
@@ -0,0 +1,132 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetStatement get the opening balance, the transactions, the totals and the closing balance of the period
+// for a specific User.
+// The opening balance and the transactions are read within a single read-only repeatable read transaction,
+// thus a transaction committed meanwhile, even a backdated one, can't make the balances disagree with the transactions.
+// The closing balance is derived from the opening balance and the transactions, instead of being read again.
+func (r *btcRepo) GetStatement(ctx context.Context, params *repository.GetStatementParams) (*rpc.Statement, error) {
+	a, err := lookupAsset(params.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.dbSlave.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	// Nothing is written, thus the transaction is always rolled back.
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var openingSats int64
+
+	query := `SELECT (COALESCE((
+					SELECT SUM(amount) FROM transactions WHERE user_id = u.id AND asset = $2 AND datetime < $3::timestamptz
+				), 0) * $4::numeric)::bigint
+				FROM users u
+					WHERE u.id = $1`
+
+	err = tx.QueryRow(ctx, query, params.UserID, a.Code, params.StartDatetime, a.Scale()).Scan(&openingSats)
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("user id: %d not found: %w", params.UserID, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	query = `SELECT ` + transactionColumns + `
+				FROM transactions
+					WHERE user_id = $1 AND asset = $2 AND datetime >= $3::timestamptz AND datetime < $4::timestamptz
+						ORDER BY datetime, id`
+
+	rows, err := tx.Query(ctx, query, params.UserID, a.Code, params.StartDatetime, params.EndDatetime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		transactions = make([]*rpc.Transaction, 0)
+		inSats       int64
+		outSats      int64
+	)
+
+	for rows.Next() {
+		var d data
+
+		if err = d.scan(rows); err != nil {
+			return nil, err
+		}
+
+		if d.AmountSats > 0 {
+			inSats += d.AmountSats
+		} else {
+			outSats -= d.AmountSats
+		}
+
+		transactions = append(transactions, d.toProto())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	closingSats := openingSats + inSats - outSats
+
+	return &rpc.Statement{
+		UserId:             params.UserID,
+		Asset:              a.Code,
+		StartDatetime:      timestamppb.New(params.StartDatetime),
+		EndDatetime:        timestamppb.New(params.EndDatetime),
+		OpeningBalance:     a.ToUnits(openingSats),
+		OpeningBalanceSats: openingSats,
+		TotalIn:            a.ToUnits(inSats),
+		TotalInSats:        inSats,
+		TotalOut:           a.ToUnits(outSats),
+		TotalOutSats:       outSats,
+		ClosingBalance:     a.ToUnits(closingSats),
+		ClosingBalanceSats: closingSats,
+		Transactions:       transactions,
+		GeneratedAt:        timestamppb.Now(),
+	}, nil
+}
+
+// ListStatementAccounts get the Users and the assets with any transaction before the end date and time,
+// ordered by the User ID and the asset.
+func (r *btcRepo) ListStatementAccounts(ctx context.Context, endDatetime time.Time) ([]*repository.StatementAccount, error) {
+	query := `SELECT DISTINCT user_id, asset FROM transactions WHERE datetime < $1::timestamptz ORDER BY user_id, asset`
+
+	rows, err := r.dbSlave.Query(ctx, query, endDatetime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []*repository.StatementAccount
+
+	for rows.Next() {
+		var account repository.StatementAccount
+
+		if err = rows.Scan(&account.UserID, &account.Asset); err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, &account)
+	}
+
+	return accounts, rows.Err()
+}
@@ -0,0 +1,206 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBTCRepo_GetStatement(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.GetStatementParams
+	}
+
+	type test struct {
+		args            args
+		wantOpeningSats int64
+		wantInSats      int64
+		wantOutSats     int64
+		wantClosingSats int64
+		// wantIDs is the IDs of the transactions of the statement in order.
+		wantIDs []string
+		wantErr error
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	userID := int64(1997)
+	startDatetime := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	endDatetime := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	ids := []string{
+		"2d3e4f5a-0000-4000-8000-000000000001",
+		"2d3e4f5a-0000-4000-8000-000000000002",
+		"2d3e4f5a-0000-4000-8000-000000000003",
+		"2d3e4f5a-0000-4000-8000-000000000004",
+		"2d3e4f5a-0000-4000-8000-000000000005",
+	}
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+		assert.NoError(t, err)
+
+		clearLedger(t, userID)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Get statement, When query executed successfully, Return the balances derived from the transactions": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.GetStatementParams{
+						UserID:        userID,
+						StartDatetime: startDatetime,
+						EndDatetime:   endDatetime,
+					},
+				},
+				wantOpeningSats: 5_000_000_000,
+				wantInSats:      10_050_000_000,
+				wantOutSats:     2_000_000_000,
+				wantClosingSats: 13_050_000_000,
+				wantIDs:         ids[1:4],
+				wantErr:         nil,
+			}
+		},
+		"Given valid query of Get statement of ETH, When query executed successfully, Return the statement of the asset": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.GetStatementParams{
+						UserID:        userID,
+						StartDatetime: startDatetime,
+						EndDatetime:   endDatetime,
+						Asset:         "ETH",
+					},
+				},
+				wantOpeningSats: 0,
+				wantInSats:      0,
+				wantOutSats:     0,
+				wantClosingSats: 0,
+				wantIDs:         []string{},
+				wantErr:         nil,
+			}
+		},
+		"Given valid query of Get statement of unknown User, When query executed, Return not found error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.GetStatementParams{
+						UserID:        99999,
+						StartDatetime: startDatetime,
+						EndDatetime:   endDatetime,
+					},
+				},
+				wantErr: datastore.ErrNotFound,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			// Remove existing data, if any.
+			clear(t)
+
+			defer clear(t)
+
+			// Insert test data, one transaction before the period, three within, and one at the exclusive end.
+			_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+			assert.NoError(t, err)
+
+			_, err = db.Exec(context.Background(),
+				`INSERT INTO transactions (id, datetime, user_id, amount, asset) VALUES
+					($1, $6::timestamptz - interval '1 day', $8, 50, 'BTC'),
+					($2, $6, $8, 100.5, 'BTC'),
+					($3, $6::timestamptz + interval '1 day', $8, -20, 'BTC'),
+					($4, $7::timestamptz - interval '1 second', $8, 0, 'BTC'),
+					($5, $7, $8, 1, 'BTC')`,
+				ids[0], ids[1], ids[2], ids[3], ids[4], startDatetime, endDatetime, userID,
+			)
+			assert.NoError(t, err)
+
+			sut := di.GetBTCRepo()
+
+			got, err := sut.GetStatement(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.wantOpeningSats, got.GetOpeningBalanceSats())
+			assert.Equal(t, tt.wantInSats, got.GetTotalInSats())
+			assert.Equal(t, tt.wantOutSats, got.GetTotalOutSats())
+			assert.Equal(t, tt.wantClosingSats, got.GetClosingBalanceSats())
+
+			gotIDs := make([]string, 0, len(got.GetTransactions()))
+
+			for _, transaction := range got.GetTransactions() {
+				gotIDs = append(gotIDs, transaction.GetId())
+			}
+
+			assert.Equal(t, tt.wantIDs, gotIDs)
+		})
+	}
+}
+
+func TestBTCRepo_ListStatementAccounts(t *testing.T) {
+	db := datastore.GetDatabaseMaster()
+
+	userID := int64(1997)
+	endDatetime := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id = $1", userID)
+		assert.NoError(t, err)
+	}
+
+	// Remove existing data, if any.
+	clear(t)
+
+	defer clear(t)
+
+	// Insert test data, the USDT transaction is after the end, thus the USDT has no statement yet.
+	_, err := db.Exec(context.Background(),
+		`INSERT INTO transactions (id, datetime, user_id, amount, asset) VALUES
+			(gen_random_uuid(), $1::timestamptz - interval '1 day', $2, 1, 'ETH'),
+			(gen_random_uuid(), $1::timestamptz - interval '2 day', $2, 1, 'BTC'),
+			(gen_random_uuid(), $1::timestamptz - interval '3 day', $2, 2, 'BTC'),
+			(gen_random_uuid(), $1, $2, 1, 'USDT')`,
+		endDatetime, userID,
+	)
+	assert.NoError(t, err)
+
+	sut := di.GetBTCRepo()
+
+	got, err := sut.ListStatementAccounts(context.Background(), endDatetime)
+	assert.NoError(t, err)
+
+	// The other tests may leave the transactions of the other Users, thus only the accounts of the User are compared.
+	var accounts []*repository.StatementAccount
+
+	for _, account := range got {
+		if account.UserID == userID {
+			accounts = append(accounts, account)
+		}
+	}
+
+	assert.Equal(t, []*repository.StatementAccount{
+		{UserID: userID, Asset: "BTC"},
+		{UserID: userID, Asset: "ETH"},
+	}, accounts)
+}
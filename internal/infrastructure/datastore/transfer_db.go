@@ -0,0 +1,229 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Transfer moves an asset from a User to another User within a single database transaction.
+// Returns the balances of the Users right after the transfer is committed,
+// nil if the balances are unchanged because of a replay with the same idempotency key.
+func (r *btcRepo) Transfer(
+	ctx context.Context, params *repository.TransferParams,
+) (*rpc.TransferResponse, *repository.TransferBalances, error) {
+	a, err := lookupAsset(params.Asset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := r.dbMaster.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to begin transaction: %v", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			return
+		}
+	}()
+
+	// The IDs are generated before the insert, thus they can be stored together with the idempotency key.
+	var (
+		transferID = uuid.NewString()
+		debit      = data{ID: uuid.NewString(), Datetime: params.Datetime, UserID: params.FromUserID, AmountSats: -params.AmountSats}
+		credit     = data{ID: uuid.NewString(), Datetime: params.Datetime, UserID: params.ToUserID, AmountSats: params.AmountSats}
+	)
+
+	debit.Asset, credit.Asset = a.Code, a.Code
+
+	debit.TransferID, credit.TransferID = transferID, transferID
+
+	// Lock both Users in the order of the ID, thus the concurrent transfers in opposite directions can't deadlock.
+	// The Users are locked before the idempotency key, since the key references them.
+	rows, err := tx.Query(ctx,
+		`SELECT id, status FROM users WHERE id IN ($1, $2) ORDER BY id FOR UPDATE`, params.FromUserID, params.ToUserID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := make(map[int64]string, 2)
+
+	for rows.Next() {
+		var (
+			id     int64
+			status string
+		)
+
+		if err = rows.Scan(&id, &status); err != nil {
+			rows.Close()
+
+			return nil, nil, err
+		}
+
+		statuses[id] = status
+	}
+
+	rows.Close()
+
+	// A read error ends the rows early, thus a locked User would look like a missing User.
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, userID := range []int64{params.FromUserID, params.ToUserID} {
+		status, ok := statuses[userID]
+		if !ok {
+			err = fmt.Errorf("user id: %d not found: %w", userID, ErrNotFound)
+
+			return nil, nil, err
+		}
+
+		if status == userStatusDeactivated {
+			err = fmt.Errorf("user id: %d: %w", userID, ErrUserDeactivated)
+
+			return nil, nil, err
+		}
+	}
+
+	if params.IdempotencyKey != "" {
+		var original *idempotencyClaim
+
+		original, err = r.claimIdempotencyKey(ctx, tx, &idempotencyClaim{
+			Key:                params.IdempotencyKey,
+			TransactionID:      debit.ID,
+			TransferID:         transferID,
+			UserID:             params.FromUserID,
+			CounterpartyUserID: params.ToUserID,
+			Datetime:           params.Datetime,
+			AmountSats:         params.AmountSats,
+			Asset:              a,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The key was already used by the same payload, returns the original transfer
+		// and leave the balances untouched.
+		if original != nil {
+			var transfer *rpc.TransferResponse
+
+			transfer, err = getTransfer(ctx, tx, original.TransferID)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			_ = tx.Rollback(ctx)
+
+			return transfer, nil, nil
+		}
+	}
+
+	// Both legs of the transfer are a single journal entry, thus the asset moves between the Users without a counterparty.
+	entry := &journalEntry{
+		ID:       uuid.NewString(),
+		Kind:     journalKindTransfer,
+		Datetime: params.Datetime,
+		Postings: []posting{
+			{Account: userAccount(debit.UserID, a), AmountSats: debit.AmountSats},
+			{Account: userAccount(credit.UserID, a), AmountSats: credit.AmountSats},
+		},
+	}
+
+	query := `INSERT INTO transactions (id, datetime, user_id, amount, transfer_id, asset, journal_entry_id)
+				VALUES ($1, $2, $3, $4::bigint / $9::numeric, $5, $10, $11), ($6, $2, $7, $8::bigint / $9::numeric, $5, $10, $11)`
+
+	_, err = tx.Exec(ctx, query,
+		debit.ID, params.Datetime, debit.UserID, debit.AmountSats, transferID,
+		credit.ID, credit.UserID, credit.AmountSats, a.Scale(), a.Code, entry.ID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = postJournalEntries(ctx, tx, []*journalEntry{entry})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balances := new(repository.TransferBalances)
+
+	balances.From, err = getUserBalance(ctx, tx, debit.UserID, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balances.To, err = getUserBalance(ctx, tx, credit.UserID, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transfer := &rpc.TransferResponse{
+		Id:     transferID,
+		Debit:  debit.toProto(),
+		Credit: credit.toProto(),
+	}
+
+	err = insertOutboxEvents(ctx, tx, []*rpc.Transaction{transfer.Debit, transfer.Credit},
+		map[int64][]*rpc.UserBalance{debit.UserID: {balances.From}, credit.UserID: {balances.To}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	errCommit := tx.Commit(ctx)
+	if errCommit != nil {
+		return nil, nil, fmt.Errorf("unable to commit transaction: %v", errCommit)
+	}
+
+	return transfer, balances, nil
+}
+
+// getTransfer get the debit and credit transactions of the transfer within the given database transaction.
+func getTransfer(ctx context.Context, tx pgx.Tx, transferID string) (*rpc.TransferResponse, error) {
+	query := `SELECT ` + transactionColumns + `
+				FROM transactions
+					WHERE transfer_id = $1`
+
+	rows, err := tx.Query(ctx, query, transferID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transfer := &rpc.TransferResponse{
+		Id: transferID,
+	}
+
+	for rows.Next() {
+		var d data
+
+		err = d.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		// The debit is the negative amount of the from User.
+		if d.AmountSats < 0 {
+			transfer.Debit = d.toProto()
+		} else {
+			transfer.Credit = d.toProto()
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if transfer.Debit == nil || transfer.Credit == nil {
+		return nil, fmt.Errorf("transfer id: %s not found: %w", transferID, ErrNotFound)
+	}
+
+	return transfer, nil
+}
@@ -0,0 +1,343 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBTCRepo_Transfer(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.TransferParams
+	}
+
+	type test struct {
+		args         args
+		want         *rpc.TransferResponse
+		wantBalances *repository.TransferBalances
+		wantErr      error
+		beforeFunc   func(*testing.T)
+		afterFunc    func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	fromUserID, toUserID := int64(1992), int64(1993)
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM idempotency_keys WHERE user_id IN ($1, $2)", fromUserID, toUserID)
+		assert.NoError(t, err)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM transactions WHERE user_id IN ($1, $2)", fromUserID, toUserID)
+		assert.NoError(t, err)
+
+		clearLedger(t, fromUserID, toUserID)
+
+		_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id IN ($1, $2)", fromUserID, toUserID)
+		assert.NoError(t, err)
+	}
+
+	checkBalance := func(t *testing.T, userID int64, want float64) {
+		t.Helper()
+
+		var balance float64
+
+		err := db.QueryRow(context.Background(), "SELECT account_balance($1, 'BTC')", userID).Scan(&balance)
+		assert.NoError(t, err)
+		assert.Equal(t, want, balance)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Transfer, When query executed successfully, Return the linked transactions": func(t *testing.T) test {
+			datetime := time.Now().UTC().Truncate(time.Microsecond)
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.TransferParams{
+					FromUserID: fromUserID,
+					ToUserID:   toUserID,
+					Datetime:   datetime,
+					AmountSats: 4_000_000_000,
+				},
+			}
+
+			want := &rpc.TransferResponse{
+				Debit: &rpc.Transaction{
+					UserId:     fromUserID,
+					Datetime:   timestamppb.New(datetime),
+					Amount:     -40,
+					AmountSats: -4_000_000_000,
+					Asset:      "BTC",
+				},
+				Credit: &rpc.Transaction{
+					UserId:     toUserID,
+					Datetime:   timestamppb.New(datetime),
+					Amount:     40,
+					AmountSats: 4_000_000_000,
+					Asset:      "BTC",
+				},
+			}
+
+			wantBalances := &repository.TransferBalances{
+				From: &rpc.UserBalance{
+					Balance:              60.5,
+					BalanceSats:          6_050_000_000,
+					Asset:                "BTC",
+					AvailableBalance:     60.5,
+					AvailableBalanceSats: 6_050_000_000,
+				},
+				To: &rpc.UserBalance{
+					Balance:              40,
+					BalanceSats:          4_000_000_000,
+					Asset:                "BTC",
+					AvailableBalance:     40,
+					AvailableBalanceSats: 4_000_000_000,
+				},
+			}
+
+			return test{
+				args:         args,
+				want:         want,
+				wantBalances: wantBalances,
+				wantErr:      nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clear(t)
+
+					// Insert test data.
+					_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1), ($2)", fromUserID, toUserID)
+					assert.NoError(t, err)
+
+					seedBalance(t, fromUserID, "BTC", 100.5)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					checkBalance(t, fromUserID, 60.5)
+					checkBalance(t, toUserID, 40)
+
+					// Check both transactions are linked by the transfer ID.
+					var count int
+
+					err := db.QueryRow(context.Background(),
+						"SELECT COUNT(DISTINCT transfer_id) FROM transactions WHERE user_id IN ($1, $2)", fromUserID, toUserID,
+					).Scan(&count)
+					assert.NoError(t, err)
+					assert.Equal(t, 1, count)
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+		"Given valid query of Transfer with debit overdraws the balance, When query executed, Return an error": func(t *testing.T) test {
+			args := args{
+				ctx: context.Background(),
+				params: &repository.TransferParams{
+					FromUserID: fromUserID,
+					ToUserID:   toUserID,
+					Datetime:   time.Now().UTC(),
+					AmountSats: 10_050_000_001,
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrInsufficientBalance,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clear(t)
+
+					// Insert test data.
+					_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1), ($2)", fromUserID, toUserID)
+					assert.NoError(t, err)
+
+					seedBalance(t, fromUserID, "BTC", 100.5)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the balances are untouched.
+					checkBalance(t, fromUserID, 100.5)
+					checkBalance(t, toUserID, 0)
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+		"Given valid query of Transfer with used Idempotency key, When query executed successfully, Return the original transfer": func(t *testing.T) test {
+			datetime := time.Now().UTC().Truncate(time.Microsecond)
+			idempotencyKey := "idempotency-key-1992"
+			transferID := "7f0b9d3e-2c4a-4e8b-9a1d-5c6e7f8a9b0c"
+			debitID := "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+			creditID := "6e7f8a9b-0c1d-4e2f-a3b4-c5d6e7f8a9b0"
+
+			args := args{
+				ctx: context.Background(),
+				params: &repository.TransferParams{
+					FromUserID:     fromUserID,
+					ToUserID:       toUserID,
+					Datetime:       datetime,
+					AmountSats:     4_000_000_000,
+					IdempotencyKey: idempotencyKey,
+				},
+			}
+
+			want := &rpc.TransferResponse{
+				Id: transferID,
+				Debit: &rpc.Transaction{
+					Id:         debitID,
+					UserId:     fromUserID,
+					Datetime:   timestamppb.New(datetime),
+					Amount:     -40,
+					AmountSats: -4_000_000_000,
+					TransferId: transferID,
+					Asset:      "BTC",
+				},
+				Credit: &rpc.Transaction{
+					Id:         creditID,
+					UserId:     toUserID,
+					Datetime:   timestamppb.New(datetime),
+					Amount:     40,
+					AmountSats: 4_000_000_000,
+					TransferId: transferID,
+					Asset:      "BTC",
+				},
+			}
+
+			return test{
+				args:         args,
+				want:         want,
+				wantBalances: nil,
+				wantErr:      nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clear(t)
+
+					// Insert test data.
+					_, err := db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1), ($2)", fromUserID, toUserID)
+					assert.NoError(t, err)
+
+					seedBalance(t, fromUserID, "BTC", 60.5)
+					seedBalance(t, toUserID, "BTC", 40)
+
+					_, err = db.Exec(context.Background(),
+						"INSERT INTO transactions (id, datetime, user_id, amount, transfer_id) VALUES ($1, $2, $3, $4, $5), ($6, $2, $7, $8, $5)",
+						debitID, datetime, fromUserID, -40, transferID, creditID, toUserID, 40,
+					)
+					assert.NoError(t, err)
+
+					_, err = db.Exec(context.Background(),
+						`INSERT INTO idempotency_keys (idempotency_key, transaction_id, transfer_id, user_id, counterparty_user_id, datetime, amount)
+							VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+						idempotencyKey, debitID, transferID, fromUserID, toUserID, datetime, 40,
+					)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check the balances are not transferred twice.
+					checkBalance(t, fromUserID, 60.5)
+					checkBalance(t, toUserID, 40)
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+		"Given valid query of Transfer, When query executed successfully with no User found, Return an error": func(t *testing.T) test {
+			args := args{
+				ctx: context.Background(),
+				params: &repository.TransferParams{
+					FromUserID: fromUserID,
+					ToUserID:   999,
+					Datetime:   time.Now().UTC(),
+					AmountSats: 4_000_000_000,
+				},
+			}
+
+			return test{
+				args:    args,
+				want:    nil,
+				wantErr: datastore.ErrNotFound,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clear(t)
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", 999)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", fromUserID)
+					assert.NoError(t, err)
+
+					seedBalance(t, fromUserID, "BTC", 100.5)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Clear data.
+					clear(t)
+				},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetBTCRepo()
+
+			got, gotBalances, err := sut.Transfer(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			// The IDs are generated by server, thus only make sure they're not empty and linked.
+			if tt.want != nil && tt.want.Id == "" {
+				assert.NotEmpty(t, got.GetId())
+				assert.NotEmpty(t, got.GetDebit().GetId())
+				assert.NotEmpty(t, got.GetCredit().GetId())
+
+				tt.want.Id = got.GetId()
+				tt.want.Debit.Id, tt.want.Debit.TransferId = got.GetDebit().GetId(), got.GetId()
+				tt.want.Credit.Id, tt.want.Credit.TransferId = got.GetCredit().GetId(), got.GetId()
+			}
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantBalances, gotBalances)
+		})
+	}
+}
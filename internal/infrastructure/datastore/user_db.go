@@ -0,0 +1,190 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// userStatusActive is the stored status of an active User.
+	userStatusActive = "active"
+	// userStatusDeactivated is the stored status of a deactivated User.
+	userStatusDeactivated = "deactivated"
+)
+
+// userStatuses maps the stored status into proto enum.
+var userStatuses = map[string]rpc.UserStatus{
+	userStatusActive:      rpc.UserStatus_USER_STATUS_ACTIVE,
+	userStatusDeactivated: rpc.UserStatus_USER_STATUS_DEACTIVATED,
+}
+
+// userColumns is the selected columns for scanning a user row.
+const userColumns = `id, COALESCE(external_ref, ''), status, created_at, deactivated_at`
+
+// userData is a struct for scanning a user row.
+type userData struct {
+	ID            int64
+	ExternalRef   string
+	Status        string
+	CreatedAt     time.Time
+	DeactivatedAt *time.Time
+}
+
+// scan scans the userColumns of the row.
+func (d *userData) scan(row pgx.Row) error {
+	return row.Scan(&d.ID, &d.ExternalRef, &d.Status, &d.CreatedAt, &d.DeactivatedAt)
+}
+
+// toProto converts the scanned user row into proto message.
+func (d *userData) toProto() *rpc.User {
+	user := &rpc.User{
+		Id:          d.ID,
+		ExternalRef: d.ExternalRef,
+		Status:      userStatuses[d.Status],
+		CreatedAt:   timestamppb.New(d.CreatedAt),
+	}
+
+	if d.DeactivatedAt != nil {
+		user.DeactivatedAt = timestamppb.New(*d.DeactivatedAt)
+	}
+
+	return user
+}
+
+// CreateUser creates a new User, the User is active once created.
+func (r *btcRepo) CreateUser(ctx context.Context, params *repository.CreateUserParams) (*rpc.User, error) {
+	var d userData
+
+	query := `INSERT INTO users (external_ref) VALUES (NULLIF($1, ''))
+				RETURNING ` + userColumns
+
+	err := d.scan(r.dbMaster.QueryRow(ctx, query, params.ExternalRef))
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return nil, fmt.Errorf("external ref: %s: %w", params.ExternalRef, ErrAlreadyExists)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d.toProto(), nil
+}
+
+// GetUser get a single User by the ID.
+func (r *btcRepo) GetUser(ctx context.Context, id int64) (*rpc.User, error) {
+	var d userData
+
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+
+	err := d.scan(r.dbSlave.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("user id: %d not found: %w", id, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d.toProto(), nil
+}
+
+// ListUsers get the list of Users ordered by the ID.
+// Returns the token of the next page, empty if there are no more records.
+func (r *btcRepo) ListUsers(ctx context.Context, params *repository.ListUsersParams) ([]*rpc.User, string, error) {
+	var (
+		lastID int64
+		status string
+		err    error
+	)
+
+	if params.PageToken != "" {
+		lastID, err = decodeIDPageToken(params.PageToken)
+		if err != nil {
+			return nil, "", fmt.Errorf("page token: %s: %w", params.PageToken, ErrInvalidPageToken)
+		}
+	}
+
+	if params.Status != rpc.UserStatus_USER_STATUS_UNSPECIFIED {
+		for s, v := range userStatuses {
+			if v == params.Status {
+				status = s
+			}
+		}
+
+		if status == "" {
+			return nil, "", fmt.Errorf("status: %s: %w", params.Status, ErrInvalidUserStatus)
+		}
+	}
+
+	limit := pageLimit(params.PageSize)
+
+	query := `SELECT ` + userColumns + `
+				FROM users
+					WHERE id > $1 AND ($2 = '' OR status = $2)
+						ORDER BY id
+							LIMIT $3`
+
+	// Query one more record to know whether there's a next page.
+	rows, err := r.dbSlave.Query(ctx, query, lastID, status, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var users []*rpc.User
+
+	for rows.Next() {
+		var d userData
+
+		err = d.scan(rows)
+		if err != nil {
+			return nil, "", err
+		}
+
+		users = append(users, d.toProto())
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(users) <= limit {
+		return users, "", nil
+	}
+
+	users = users[:limit]
+
+	return users, encodeIDPageToken(users[limit-1].GetId()), nil
+}
+
+// DeactivateUser deactivates a User, thus the User can't create transactions anymore.
+// Deactivating an already deactivated User keeps the original deactivation time.
+func (r *btcRepo) DeactivateUser(ctx context.Context, id int64) (*rpc.User, error) {
+	var d userData
+
+	query := `UPDATE users SET status = $2, deactivated_at = COALESCE(deactivated_at, NOW())
+				WHERE id = $1
+					RETURNING ` + userColumns
+
+	err := d.scan(r.dbMaster.QueryRow(ctx, query, id, userStatusDeactivated))
+	if err == pgx.ErrNoRows {
+		return nil, fmt.Errorf("user id: %d not found: %w", id, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d.toProto(), nil
+}
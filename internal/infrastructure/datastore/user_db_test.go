@@ -0,0 +1,336 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/di"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/internal/infrastructure/datastore"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBTCRepo_CreateUser(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.CreateUserParams
+	}
+
+	type test struct {
+		args       args
+		want       *rpc.User
+		wantErr    error
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	externalRef := "external-ref-1996"
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM users WHERE external_ref = $1", externalRef)
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Create User, When query executed successfully, Return the active User": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CreateUserParams{
+						ExternalRef: externalRef,
+					},
+				},
+				want: &rpc.User{
+					ExternalRef: externalRef,
+					Status:      rpc.UserStatus_USER_STATUS_ACTIVE,
+				},
+				wantErr:    nil,
+				beforeFunc: clear,
+				afterFunc:  clear,
+			}
+		},
+		"Given valid query of Create User with used external ref, When query executed, Return an error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.CreateUserParams{
+						ExternalRef: externalRef,
+					},
+				},
+				want:    nil,
+				wantErr: datastore.ErrAlreadyExists,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					clear(t)
+
+					// Insert test data.
+					_, err := db.Exec(context.Background(), "INSERT INTO users (id, external_ref) VALUES ($1, $2)", 1996, externalRef)
+					assert.NoError(t, err)
+				},
+				afterFunc: clear,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetBTCRepo()
+
+			got, err := sut.CreateUser(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			// The ID and created at are generated by server, thus only make sure they're not empty.
+			if tt.want != nil {
+				assert.NotZero(t, got.GetId())
+				assert.NotNil(t, got.GetCreatedAt())
+
+				tt.want.Id, tt.want.CreatedAt = got.GetId(), got.GetCreatedAt()
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCRepo_ListUsers(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.ListUsersParams
+	}
+
+	type test struct {
+		args          args
+		want          []int64
+		wantPageToken bool
+		wantErr       error
+		beforeFunc    func(*testing.T)
+		afterFunc     func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	// The Users are created after the seeded Users, thus the page token starts after them.
+	userIDs := []int64{1997, 1998, 1999}
+	pageToken := "MTk5Ng"
+
+	seed := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = ANY($1)", userIDs)
+		assert.NoError(t, err)
+
+		_, err = db.Exec(context.Background(),
+			`INSERT INTO users (id, status, deactivated_at) VALUES ($1, 'active', NULL), ($2, 'deactivated', NOW()), ($3, 'active', NULL)`,
+			userIDs[0], userIDs[1], userIDs[2],
+		)
+		assert.NoError(t, err)
+	}
+
+	clear := func(t *testing.T) {
+		t.Helper()
+
+		_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = ANY($1)", userIDs)
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of List Users with page size, When query executed successfully, Return the first page": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ListUsersParams{
+						PageSize:  2,
+						PageToken: pageToken,
+					},
+				},
+				want:          []int64{1997, 1998},
+				wantPageToken: true,
+				beforeFunc:    seed,
+				afterFunc:     clear,
+			}
+		},
+		"Given valid query of List Users with status, When query executed successfully, Return the filtered Users": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ListUsersParams{
+						PageToken: pageToken,
+						Status:    rpc.UserStatus_USER_STATUS_DEACTIVATED,
+					},
+				},
+				want:       []int64{1998},
+				beforeFunc: seed,
+				afterFunc:  clear,
+			}
+		},
+		"Given invalid page token of List Users, When query executed, Return an error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					params: &repository.ListUsersParams{
+						PageToken: "invalid",
+					},
+				},
+				wantErr: datastore.ErrInvalidPageToken,
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetBTCRepo()
+
+			got, nextPageToken, err := sut.ListUsers(tt.args.ctx, tt.args.params)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			var ids []int64
+
+			for _, user := range got {
+				ids = append(ids, user.GetId())
+			}
+
+			assert.Equal(t, tt.want, ids)
+			assert.Equal(t, tt.wantPageToken, nextPageToken != "")
+		})
+	}
+}
+
+func TestBTCRepo_DeactivateUser(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+
+	type test struct {
+		args       args
+		want       *rpc.User
+		wantErr    error
+		beforeFunc func(*testing.T)
+		afterFunc  func(*testing.T)
+	}
+
+	db := datastore.GetDatabaseMaster()
+
+	userID := int64(1996)
+
+	tests := map[string]func(t *testing.T) test{
+		"Given valid query of Deactivate User, When query executed successfully, Return the deactivated User": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					id:  userID,
+				},
+				want: &rpc.User{
+					Id:     userID,
+					Status: rpc.UserStatus_USER_STATUS_DEACTIVATED,
+				},
+				wantErr: nil,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Remove existing data, if any.
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+
+					// Insert test data.
+					_, err = db.Exec(context.Background(), "INSERT INTO users (id) VALUES ($1)", userID)
+					assert.NoError(t, err)
+				},
+				afterFunc: func(t *testing.T) {
+					t.Helper()
+
+					// Check GetUser returns the same status.
+					user, err := di.GetBTCRepo().GetUser(context.Background(), userID)
+					assert.NoError(t, err)
+					assert.Equal(t, rpc.UserStatus_USER_STATUS_DEACTIVATED, user.GetStatus())
+
+					// Clear data.
+					_, err = db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", userID)
+					assert.NoError(t, err)
+				},
+			}
+		},
+		"Given valid query of Deactivate User, When query executed successfully with no User found, Return an error": func(t *testing.T) test {
+			return test{
+				args: args{
+					ctx: context.Background(),
+					id:  999,
+				},
+				want:    nil,
+				wantErr: datastore.ErrNotFound,
+				beforeFunc: func(t *testing.T) {
+					t.Helper()
+
+					_, err := db.Exec(context.Background(), "DELETE FROM users WHERE id = $1", 999)
+					assert.NoError(t, err)
+				},
+			}
+		},
+	}
+
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt := fn(t)
+
+			if tt.beforeFunc != nil {
+				tt.beforeFunc(t)
+			}
+
+			if tt.afterFunc != nil {
+				defer tt.afterFunc(t)
+			}
+
+			sut := di.GetBTCRepo()
+
+			got, err := sut.DeactivateUser(tt.args.ctx, tt.args.id)
+
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+
+			// The created at and deactivated at are generated by server, thus only make sure they're not empty.
+			if tt.want != nil {
+				assert.NotNil(t, got.GetCreatedAt())
+				assert.NotNil(t, got.GetDeactivatedAt())
+
+				tt.want.CreatedAt, tt.want.DeactivatedAt = got.GetCreatedAt(), got.GetDeactivatedAt()
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -8,10 +8,12 @@ import (
 
 	rpc "github.com/moemoe89/btc/api/go/grpc"
 	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/pkg/asset"
 
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CreateTransaction creates a new record for BTC transaction.
@@ -20,7 +22,120 @@ func (u *btcUsecase) CreateTransaction(ctx context.Context, params *repository.C
 	ctx, span := u.trace.StartSpan(ctx, "UC.CreateTransaction", nil)
 	defer span.End()
 
-	return u.btcRepo.CreateTransaction(ctx, params)
+	release, err := u.reserveLimits(ctx, params.UserID, params.Asset, params.AmountSats, params.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, balance, err := u.btcRepo.CreateTransaction(ctx, params)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// The balance is nil when it's unchanged, thus there's nothing to notify.
+	// The replayed transaction was already counted by the original, thus the reservation is given back.
+	if balance == nil {
+		release()
+	} else {
+		u.publishUserBalance(ctx, params.UserID, balance)
+	}
+
+	return transaction, nil
+}
+
+// CreateTransactions creates many records for BTC transaction in a single database transaction.
+// Returns the result of each entry in the same order as the params.
+func (u *btcUsecase) CreateTransactions(
+	ctx context.Context, params *repository.CreateTransactionsParams,
+) ([]*repository.CreateTransactionsResult, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.CreateTransactions", nil)
+	defer span.End()
+
+	releases, rejections, err := u.reserveDebits(ctx, params.Transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	// The debits exceeding a limit are rejected before reaching the repository, the same way as the repository rejects an entry.
+	var (
+		results  = make([]*repository.CreateTransactionsResult, len(params.Transactions))
+		accepted = &repository.CreateTransactionsParams{BestEffort: params.BestEffort}
+		indexes  []int // the index of each accepted entry in the params
+	)
+
+	for i, p := range params.Transactions {
+		if rejections[i] == nil {
+			accepted.Transactions = append(accepted.Transactions, p)
+			indexes = append(indexes, i)
+
+			continue
+		}
+
+		if !params.BestEffort {
+			releaseDebits(releases)
+			return nil, fmt.Errorf("transactions[%d]: %w", i, rejections[i])
+		}
+
+		results[i] = &repository.CreateTransactionsResult{Err: rejections[i]}
+	}
+
+	if len(accepted.Transactions) == 0 {
+		return results, nil
+	}
+
+	created, balances, err := u.btcRepo.CreateTransactions(ctx, accepted)
+	if err != nil {
+		releaseDebits(releases)
+		return nil, err
+	}
+
+	for j, i := range indexes {
+		results[i] = created[j]
+
+		// The rejected entry isn't committed, thus its reservation is given back.
+		if created[j].Err != nil && releases[i] != nil {
+			releases[i]()
+		}
+	}
+
+	// Only the changed balances are returned, thus the replayed and rejected entries aren't notified.
+	for userID, userBalances := range balances {
+		for _, balance := range userBalances {
+			u.publishUserBalance(ctx, userID, balance)
+		}
+	}
+
+	return results, nil
+}
+
+// Transfer moves BTC from a User to another User.
+// The debit and credit transactions are created in a single database transaction.
+func (u *btcUsecase) Transfer(ctx context.Context, params *repository.TransferParams) (*rpc.TransferResponse, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.Transfer", nil)
+	defer span.End()
+
+	// Only the debit of the from User is limited.
+	release, err := u.reserveLimits(ctx, params.FromUserID, params.Asset, -params.AmountSats, params.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, balances, err := u.btcRepo.Transfer(ctx, params)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// The balances are nil when they're unchanged, thus there's nothing to notify.
+	if balances == nil {
+		release()
+	} else {
+		u.publishUserBalance(ctx, params.FromUserID, balances.From)
+		u.publishUserBalance(ctx, params.ToUserID, balances.To)
+	}
+
+	return transfer, nil
 }
 
 // ListTransaction get the list of records for BTC transaction.
@@ -32,18 +147,33 @@ func (u *btcUsecase) ListTransaction( //nolint: funlen
 	defer span.End()
 
 	var (
-		list         = new(rpc.ListTransactionResponse)
-		transactions []*rpc.Transaction
-		err          error
+		list          = new(rpc.ListTransactionResponse)
+		transactions  []*rpc.Transaction
+		nextPageToken string
+		err           error
 	)
 
-	// Create key for user balance cache based on User ID.
-	key := fmt.Sprintf("user:transactions:%d:%d:%d",
+	// Create key for user transactions cache based on User ID, asset, date range, bucket and page.
+	key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s",
 		params.UserID,
+		assetCode(params.Asset),
 		params.StartDatetime.UnixNano(),
 		params.EndDatetime.UnixNano(),
+		params.Bucket,
+		params.PageSize,
+		params.PageToken,
 	)
 
+	// The valued list uses a separate key, thus it never collides with the raw list.
+	if params.QuoteCurrency != "" {
+		key = fmt.Sprintf("%s:%s", key, params.QuoteCurrency)
+	}
+
+	// The filtered list uses a separate key as well, thus it never collides with the unfiltered list.
+	if params.Type != rpc.TransactionType_TRANSACTION_TYPE_UNSPECIFIED || params.ExternalRef != "" {
+		key = fmt.Sprintf("%s:filter:%d:%s", key, params.Type, params.ExternalRef)
+	}
+
 	// Gets the cache from Redis.
 	val, err := u.redis.Get(ctx, key)
 	if err != nil && !errors.Is(err, redis.Nil) {
@@ -66,13 +196,35 @@ func (u *btcUsecase) ListTransaction( //nolint: funlen
 		u.logger.Warn("failed to unmarshal balance proto", zap.Error(err))
 	}
 
-	transactions, err = u.btcRepo.ListTransaction(ctx, params)
+	transactions, nextPageToken, err = u.btcRepo.ListTransaction(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
+	// The transactions are valued before caching, thus the cached list already has the quotes.
+	if params.QuoteCurrency != "" && len(transactions) > 0 {
+		datetimes := make([]time.Time, len(transactions))
+		amounts := make([]int64, len(transactions))
+
+		// The aggregated transaction is valued at the start of its bucket.
+		for i, transaction := range transactions {
+			datetimes[i] = transaction.GetDatetime().AsTime()
+			amounts[i] = transaction.GetAmountSats()
+		}
+
+		quotes, err := u.quote(ctx, assetCode(params.Asset), params.QuoteCurrency, datetimes, amounts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, quote := range quotes {
+			transactions[i].Quote = quote
+		}
+	}
+
 	list = &rpc.ListTransactionResponse{
-		Transactions: transactions,
+		Transactions:  transactions,
+		NextPageToken: nextPageToken,
 	}
 
 	// Marshal the proto message to []byte
@@ -91,8 +243,111 @@ func (u *btcUsecase) ListTransaction( //nolint: funlen
 	return list, nil
 }
 
+// GetTransaction get a single record of BTC transaction by the ID.
+func (u *btcUsecase) GetTransaction(ctx context.Context, id string) (*rpc.Transaction, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.GetTransaction", nil)
+	defer span.End()
+
+	return u.btcRepo.GetTransaction(ctx, id)
+}
+
+// ReverseTransaction creates a compensating transaction with the opposite amount of a transaction.
+// A transaction can only be reversed once.
+func (u *btcUsecase) ReverseTransaction(
+	ctx context.Context, params *repository.ReverseTransactionParams,
+) (*rpc.Transaction, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.ReverseTransaction", nil)
+	defer span.End()
+
+	reversal, balance, err := u.btcRepo.ReverseTransaction(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	u.publishUserBalance(ctx, reversal.GetUserId(), balance)
+
+	return reversal, nil
+}
+
+// CreateHold reserves an amount of a User until it's captured, released or expired.
+// The hold reduces the available balance of the User, but not the balance.
+func (u *btcUsecase) CreateHold(ctx context.Context, params *repository.CreateHoldParams) (*rpc.Hold, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.CreateHold", nil)
+	defer span.End()
+
+	hold, balance, err := u.btcRepo.CreateHold(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	u.publishUserBalance(ctx, params.UserID, balance)
+
+	return hold, nil
+}
+
+// GetHold get a single Hold by the ID.
+func (u *btcUsecase) GetHold(ctx context.Context, id string) (*rpc.Hold, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.GetHold", nil)
+	defer span.End()
+
+	return u.btcRepo.GetHold(ctx, id)
+}
+
+// CaptureHold settles a pending hold by a withdrawal transaction of the captured amount.
+// The captured amount may be less than the hold, the rest is released.
+func (u *btcUsecase) CaptureHold(ctx context.Context, params *repository.CaptureHoldParams) (*rpc.CaptureHoldResponse, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.CaptureHold", nil)
+	defer span.End()
+
+	// The hold is read from the master, since the capture often follows the hold right away.
+	hold, err := u.btcRepo.GetPendingHold(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	amountSats := params.AmountSats
+	if amountSats == 0 {
+		amountSats = hold.GetAmountSats()
+	}
+
+	// The captured amount is a debit of the User, thus it's limited the same way as a withdrawal.
+	release, err := u.reserveLimits(ctx, hold.GetUserId(), hold.GetAsset(), -amountSats, "")
+	if err != nil {
+		return nil, err
+	}
+
+	hold, transaction, balance, err := u.btcRepo.CaptureHold(ctx, params)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	u.publishUserBalance(ctx, hold.GetUserId(), balance)
+
+	return &rpc.CaptureHoldResponse{
+		Hold:        hold,
+		Transaction: transaction,
+	}, nil
+}
+
+// ReleaseHold cancels a pending hold without a debit.
+func (u *btcUsecase) ReleaseHold(ctx context.Context, id string) (*rpc.Hold, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.ReleaseHold", nil)
+	defer span.End()
+
+	hold, balance, err := u.btcRepo.ReleaseHold(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u.publishUserBalance(ctx, hold.GetUserId(), balance)
+
+	return hold, nil
+}
+
 // GetUserBalance get the latest balance for a specific User.
-func (u *btcUsecase) GetUserBalance(ctx context.Context, userID int64) (*rpc.UserBalance, error) {
+// If the AsOf is set, get the balance at that point in time instead.
+func (u *btcUsecase) GetUserBalance(ctx context.Context, params *repository.GetUserBalanceParams) (*rpc.UserBalance, error) {
 	ctx, span := u.trace.StartSpan(ctx, "UC.GetUserBalance", nil)
 	defer span.End()
 
@@ -101,8 +356,19 @@ func (u *btcUsecase) GetUserBalance(ctx context.Context, userID int64) (*rpc.Use
 		err     error
 	)
 
-	// Create key for user balance cache based on User ID.
-	key := fmt.Sprintf("user:balance:%d", userID)
+	// Create key for user balance cache based on User ID and asset.
+	key := fmt.Sprintf("user:balance:%d:%s", params.UserID, assetCode(params.Asset))
+
+	// The point in time balance uses a separate namespace,
+	// thus it never collides with the latest balance.
+	if !params.AsOf.IsZero() {
+		key = fmt.Sprintf("user:balance:asof:%d:%s:%d", params.UserID, assetCode(params.Asset), params.AsOf.UnixNano())
+	}
+
+	// The valued balance uses a separate key, thus it never collides with the raw balance.
+	if params.QuoteCurrency != "" {
+		key = fmt.Sprintf("%s:%s", key, params.QuoteCurrency)
+	}
 
 	// Gets the cache from Redis.
 	val, err := u.redis.Get(ctx, key)
@@ -126,11 +392,28 @@ func (u *btcUsecase) GetUserBalance(ctx context.Context, userID int64) (*rpc.Use
 		u.logger.Warn("failed to unmarshal balance proto", zap.Error(err))
 	}
 
-	balance, err = u.btcRepo.GetUserBalance(ctx, userID)
+	balance, err = u.btcRepo.GetUserBalance(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
+	// The latest balance is valued at the latest price.
+	if params.QuoteCurrency != "" {
+		datetime := params.AsOf
+		if datetime.IsZero() {
+			datetime = time.Now()
+		}
+
+		quotes, err := u.quote(ctx, assetCode(params.Asset), params.QuoteCurrency,
+			[]time.Time{datetime}, []int64{balance.GetBalanceSats()},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		balance.Quote = quotes[0]
+	}
+
 	// Marshal the proto message to []byte
 	b, err := protojson.Marshal(balance)
 	if err != nil {
@@ -146,3 +429,294 @@ func (u *btcUsecase) GetUserBalance(ctx context.Context, userID int64) (*rpc.Use
 
 	return balance, nil
 }
+
+// GetBalanceHistory get the closing balance at the end of each bucket for a specific User.
+// The buckets without transactions are omitted, since the balance is unchanged.
+func (u *btcUsecase) GetBalanceHistory(
+	ctx context.Context, params *repository.GetBalanceHistoryParams,
+) (*rpc.GetBalanceHistoryResponse, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.GetBalanceHistory", nil)
+	defer span.End()
+
+	var (
+		history  = new(rpc.GetBalanceHistoryResponse)
+		balances []*rpc.BalancePoint
+		err      error
+	)
+
+	// Create key for user balance history cache based on User ID, asset, date range and bucket.
+	key := fmt.Sprintf("user:balance:history:%d:%s:%d:%d:%d",
+		params.UserID,
+		assetCode(params.Asset),
+		params.StartDatetime.UnixNano(),
+		params.EndDatetime.UnixNano(),
+		params.Bucket,
+	)
+
+	// Gets the cache from Redis.
+	val, err := u.redis.Get(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		// Ignore the error and just log it, because it supposed to not blocking.
+		u.logger.Warn("failed gets user balance history from redis", zap.Error(err))
+	}
+
+	_, ok := val.(string)
+
+	// If no error and value is string, assume cache is exists.
+	if ok && err == nil {
+		// Unmarshal the cache []byte to proto message.
+		// This is also should not become blocker,
+		// thus if error happen we should do the normal query.
+		err = protojson.Unmarshal([]byte(val.(string)), history)
+		if err == nil {
+			return history, err
+		}
+
+		u.logger.Warn("failed to unmarshal balance history proto", zap.Error(err))
+	}
+
+	balances, err = u.btcRepo.GetBalanceHistory(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	history = &rpc.GetBalanceHistoryResponse{
+		Balances: balances,
+	}
+
+	// Marshal the proto message to []byte
+	b, err := protojson.Marshal(history)
+	if err != nil {
+		u.logger.Warn("failed to marshal balance history proto", zap.Error(err))
+	}
+
+	// Store the cache with expires 1 second.
+	// The number can be changed later based on the traffic.
+	_, err = u.redis.Set(ctx, key, b, time.Second*1)
+	if err != nil {
+		u.logger.Warn("failed stores user balance history to redis", zap.Error(err))
+	}
+
+	return history, nil
+}
+
+// GetStatement get the account statement of a User for an asset and a period.
+// The statement isn't cached, since it's generated once for a period rather than polled.
+func (u *btcUsecase) GetStatement(ctx context.Context, params *repository.GetStatementParams) (*rpc.Statement, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.GetStatement", nil)
+	defer span.End()
+
+	return u.btcRepo.GetStatement(ctx, params)
+}
+
+// ImportTransactions creates the records for BTC transaction of a chunk of an import within a single database transaction.
+// The rows up to the checkpoint of the import are skipped, and the rejected rows are reported without aborting the chunk.
+func (u *btcUsecase) ImportTransactions(
+	ctx context.Context, params *repository.ImportTransactionsParams,
+) (*repository.ImportTransactionsResult, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.ImportTransactions", nil)
+	defer span.End()
+
+	// The rows up to the checkpoint are skipped by the repository, thus they aren't reserved again.
+	var sequence int64
+
+	checkpoint, err := u.btcRepo.GetImportCheckpoint(ctx, params.ImportID)
+
+	switch {
+	case err == nil:
+		sequence = checkpoint.GetSequence()
+	case !errors.Is(err, repository.ErrNotFound):
+		return nil, err
+	}
+
+	debits := make([]*repository.CreateTransactionParams, len(params.Rows))
+	indexes := make(map[int64]int, len(params.Rows)) // the index of each row by the sequence
+
+	for i, row := range params.Rows {
+		if row.Err == nil && row.Sequence > sequence {
+			debits[i] = row.Transaction
+		}
+
+		indexes[row.Sequence] = i
+	}
+
+	releases, rejections, err := u.reserveDebits(ctx, debits)
+	if err != nil {
+		return nil, err
+	}
+
+	// The rows exceeding a limit are rejected before reaching the repository, thus they're still counted into the checkpoint.
+	rows := make([]*repository.ImportRow, len(params.Rows))
+
+	for i, row := range params.Rows {
+		rows[i] = row
+
+		if rejections[i] != nil {
+			rows[i] = &repository.ImportRow{Sequence: row.Sequence, Transaction: row.Transaction, Err: rejections[i]}
+		}
+	}
+
+	result, balances, err := u.btcRepo.ImportTransactions(ctx, &repository.ImportTransactionsParams{
+		ImportID: params.ImportID,
+		Rows:     rows,
+	})
+	if err != nil {
+		releaseDebits(releases)
+		return nil, err
+	}
+
+	// The rejected rows aren't committed, thus their reservations are given back.
+	for _, rejection := range result.Rejections {
+		if i, ok := indexes[rejection.Sequence]; ok && releases[i] != nil {
+			releases[i]()
+		}
+	}
+
+	// The balances are changed once for the chunk, thus the watchers are notified once for the chunk as well.
+	for userID, userBalances := range balances {
+		for _, balance := range userBalances {
+			u.publishUserBalance(ctx, userID, balance)
+		}
+	}
+
+	return result, nil
+}
+
+// GetImportCheckpoint get the checkpoint of an import by the ID.
+// The checkpoint isn't cached, since it's used to resume a broken import.
+func (u *btcUsecase) GetImportCheckpoint(ctx context.Context, importID string) (*rpc.ImportCheckpoint, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.GetImportCheckpoint", nil)
+	defer span.End()
+
+	return u.btcRepo.GetImportCheckpoint(ctx, importID)
+}
+
+// ExportTransactions sends each un-aggregated record for BTC transaction ordered by the datetime and the ID.
+// The records aren't cached, since the export is read once and can be arbitrarily large.
+func (u *btcUsecase) ExportTransactions(
+	ctx context.Context, params *repository.ExportTransactionsParams, send func(*rpc.Transaction) error,
+) error {
+	ctx, span := u.trace.StartSpan(ctx, "UC.ExportTransactions", nil)
+	defer span.End()
+
+	return u.btcRepo.ExportTransactions(ctx, params, send)
+}
+
+// WatchUserBalance sends the latest balance of the asset for a specific User,
+// then a new balance whenever a transaction of the asset for the User is committed, until the context is done.
+func (u *btcUsecase) WatchUserBalance(
+	ctx context.Context, userID int64, asset string, send func(*rpc.UserBalance) error,
+) error {
+	ctx, span := u.trace.StartSpan(ctx, "UC.WatchUserBalance", nil)
+	defer span.End()
+
+	// Subscribe before getting the latest balance, thus no transaction committed in between is missed.
+	sub, err := u.pubsub.Subscribe(ctx, userBalanceChannel(userID, assetCode(asset)))
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = sub.Close() }()
+
+	balance, err := u.btcRepo.GetUserBalance(ctx, &repository.GetUserBalanceParams{
+		UserID: userID,
+		Asset:  asset,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = send(balance); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				return nil
+			}
+
+			balance = new(rpc.UserBalance)
+
+			// The invalid message should not stop the stream, thus just log it and wait for the next one.
+			if err = protojson.Unmarshal(msg, balance); err != nil {
+				u.logger.Warn("failed to unmarshal balance proto", zap.Error(err))
+				continue
+			}
+
+			if err = send(balance); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// quote values the amounts in minor units of the asset in the quote currency, each at the price of its datetime.
+// The values are exact decimals rounded to the cents, see asset.QuoteValue.
+func (u *btcUsecase) quote(
+	ctx context.Context, code, quoteCurrency string, datetimes []time.Time, amounts []int64,
+) ([]*rpc.Quote, error) {
+	a, err := asset.Lookup(code)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := u.priceSource.GetPrices(ctx, &repository.GetPricesParams{
+		Asset:         code,
+		QuoteCurrency: quoteCurrency,
+		Datetimes:     datetimes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(prices) != len(datetimes) {
+		return nil, fmt.Errorf("price source returns %d prices for %d datetimes", len(prices), len(datetimes))
+	}
+
+	quotes := make([]*rpc.Quote, len(prices))
+
+	for i, price := range prices {
+		quotes[i] = &rpc.Quote{
+			Currency: quoteCurrency,
+			Price:    price.Price,
+			Value:    a.QuoteValue(amounts[i], price.Price),
+			Datetime: timestamppb.New(price.Datetime),
+		}
+	}
+
+	return quotes, nil
+}
+
+// publishUserBalance publishes the balance to the watchers of the asset of the User.
+// The transaction is already committed, thus the error is only logged.
+func (u *btcUsecase) publishUserBalance(ctx context.Context, userID int64, balance *rpc.UserBalance) {
+	b, err := protojson.Marshal(balance)
+	if err != nil {
+		u.logger.Warn("failed to marshal balance proto", zap.Error(err))
+		return
+	}
+
+	err = u.pubsub.Publish(ctx, userBalanceChannel(userID, assetCode(balance.GetAsset())), b)
+	if err != nil {
+		u.logger.Warn("failed publishes user balance", zap.Error(err))
+	}
+}
+
+// userBalanceChannel returns the pub/sub channel of the balance changes of the asset for a specific User.
+func userBalanceChannel(userID int64, asset string) string {
+	return fmt.Sprintf("user:balance:watch:%d:%s", userID, asset)
+}
+
+// assetCode returns the code of the asset, the empty code is the default asset.
+// Thus the cache and the channel of a request without asset are shared with the default asset.
+func assetCode(code string) string {
+	if code == "" {
+		return asset.Default.Code
+	}
+
+	return code
+}
@@ -10,11 +10,14 @@ import (
 	rpc "github.com/moemoe89/btc/api/go/grpc"
 	"github.com/moemoe89/btc/internal/entities/repository"
 	"github.com/moemoe89/btc/pkg/kvs"
+	"github.com/moemoe89/btc/pkg/pubsub"
+	"github.com/moemoe89/btc/pkg/pubsub/local"
 
 	"github.com/golang/mock/gomock"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -42,24 +45,117 @@ func TestBTCUC_CreateTransaction(t *testing.T) {
 			args := args{
 				ctx: ctx,
 				params: &repository.CreateTransactionParams{
-					UserID:   1,
-					Datetime: now,
-					Amount:   100,
+					UserID:     1,
+					Datetime:   now,
+					AmountSats: 10_000_000_000,
 				},
 			}
 
 			want := &rpc.Transaction{
-				UserId:   1,
-				Datetime: timestamppb.New(now),
-				Amount:   100,
+				UserId:     1,
+				Datetime:   timestamppb.New(now),
+				Amount:     100,
+				AmountSats: 10_000_000_000,
+			}
+
+			balance := &rpc.UserBalance{
+				Balance:     100,
+				BalanceSats: 10_000_000_000,
+			}
+
+			b, err := protojson.Marshal(balance)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().CreateTransaction(args.ctx, args.params).Return(want, balance, nil)
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:1:BTC", b).Return(nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  mockPubSub,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Create transaction, When repository executed successfully but failed to publish the balance, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			now := time.Now()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionParams{
+					UserID:     1,
+					Datetime:   now,
+					AmountSats: 10_000_000_000,
+				},
+			}
+
+			want := &rpc.Transaction{
+				UserId:     1,
+				Datetime:   timestamppb.New(now),
+				Amount:     100,
+				AmountSats: 10_000_000_000,
+			}
+
+			balance := &rpc.UserBalance{
+				Balance:     100,
+				BalanceSats: 10_000_000_000,
+			}
+
+			b, err := protojson.Marshal(balance)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().CreateTransaction(args.ctx, args.params).Return(want, balance, nil)
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:1:BTC", b).Return(errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  mockPubSub,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Create transaction with used Idempotency key, When repository returns the original transaction, Return no error without publish": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			now := time.Now()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionParams{
+					UserID:         1,
+					Datetime:       now,
+					AmountSats:     10_000_000_000,
+					IdempotencyKey: "key-1",
+				},
+			}
+
+			want := &rpc.Transaction{
+				UserId:     1,
+				Datetime:   timestamppb.New(now),
+				Amount:     100,
+				AmountSats: 10_000_000_000,
 			}
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().CreateTransaction(args.ctx, args.params).Return(want, nil)
+			mockJourneyRepo.EXPECT().CreateTransaction(args.ctx, args.params).Return(want, nil, nil)
 
 			return test{
 				fields: fields{
 					btcRepo: mockJourneyRepo,
+					pubsub:  pubsub.NewGoMockClient(ctrl),
 				},
 				args:    args,
 				want:    want,
@@ -74,24 +170,162 @@ func TestBTCUC_CreateTransaction(t *testing.T) {
 			args := args{
 				ctx: ctx,
 				params: &repository.CreateTransactionParams{
-					UserID:   1,
-					Datetime: now,
-					Amount:   100,
+					UserID:     1,
+					Datetime:   now,
+					AmountSats: 10_000_000_000,
+				},
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().CreateTransaction(args.ctx, args.params).Return(nil, nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+		"Given valid request of Create debit exceeds the max amount, When validated against the limits, Return limit exceeded error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionParams{
+					UserID:     1,
+					Datetime:   time.Now(),
+					AmountSats: -10_000_000_001,
+				},
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, int64(1), "").
+				Return(&rpc.TransactionLimits{UserId: 1, Asset: "BTC", MaxAmountSats: 10_000_000_000}, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: repository.ErrLimitExceeded,
+			}
+		},
+		"Given valid request of Create debit exceeds the max daily outflow, When the counter incremented, Return limit exceeded error and release the counter": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionParams{
+					UserID:     1,
+					Datetime:   time.Now(),
+					AmountSats: -5_000_000_000,
+				},
+			}
+
+			key := fmt.Sprintf("user:limits:outflow:1:BTC:%s", time.Now().UTC().Format("2006-01-02"))
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, int64(1), "").
+				Return(&rpc.TransactionLimits{UserId: 1, Asset: "BTC", MaxDailyOutflowSats: 10_000_000_000, MaxCount: 10}, nil)
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			gomock.InOrder(
+				redisKVS.EXPECT().IncrBy(args.ctx, key, int64(5_000_000_000), 25*time.Hour).Return(int64(12_000_000_000), nil),
+				redisKVS.EXPECT().IncrBy(args.ctx, key, int64(-5_000_000_000), 25*time.Hour).Return(int64(7_000_000_000), nil),
+			)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: repository.ErrLimitExceeded,
+			}
+		},
+		"Given valid request of Create debit within the limits, When repository failed to executed, Return an error and release the counters": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			now := time.Now().UTC()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionParams{
+					UserID:     1,
+					Datetime:   now,
+					AmountSats: -5_000_000_000,
 				},
 			}
 
+			outflowKey := fmt.Sprintf("user:limits:outflow:1:BTC:%s", now.Format("2006-01-02"))
+			countKey := fmt.Sprintf("user:limits:count:1:BTC:3600:%d", now.Truncate(time.Hour).Unix())
+
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().CreateTransaction(args.ctx, args.params).Return(nil, errInternal)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, int64(1), "").Return(&rpc.TransactionLimits{
+				UserId:              1,
+				Asset:               "BTC",
+				MaxDailyOutflowSats: 10_000_000_000,
+				MaxCount:            10,
+				CountWindowSeconds:  3600,
+			}, nil)
+			mockJourneyRepo.EXPECT().CreateTransaction(args.ctx, args.params).Return(nil, nil, errInternal)
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			gomock.InOrder(
+				redisKVS.EXPECT().IncrBy(args.ctx, outflowKey, int64(5_000_000_000), 25*time.Hour).Return(int64(5_000_000_000), nil),
+				redisKVS.EXPECT().IncrBy(args.ctx, countKey, int64(1), time.Hour).Return(int64(1), nil),
+				redisKVS.EXPECT().IncrBy(args.ctx, outflowKey, int64(-5_000_000_000), 25*time.Hour).Return(int64(0), nil),
+				redisKVS.EXPECT().IncrBy(args.ctx, countKey, int64(-1), time.Hour).Return(int64(0), nil),
+			)
 
 			return test{
 				fields: fields{
 					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
 				},
 				args:    args,
 				want:    nil,
 				wantErr: errInternal,
 			}
 		},
+		"Given valid request of Create debit with used Idempotency key, When repository returns the original transaction, Return the original without reserving the limits": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionParams{
+					UserID:         1,
+					Datetime:       time.Now(),
+					AmountSats:     -5_000_000_000,
+					IdempotencyKey: "key-1",
+				},
+			}
+
+			want := &rpc.Transaction{
+				Id:         "0e6b7a48-5d7e-4c1b-9a0e-3f2d1c4b5a69",
+				UserId:     1,
+				AmountSats: -5_000_000_000,
+			}
+
+			// The original was already counted by the limits, thus the replay isn't rejected by the max count.
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().FindIdempotencyKeys(args.ctx, []string{"key-1"}).Return(map[string]bool{"key-1": true}, nil)
+			mockJourneyRepo.EXPECT().CreateTransaction(args.ctx, args.params).Return(want, nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   kvs.NewGoMockClient(ctrl),
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
 	}
 
 	for name, testFn := range tests {
@@ -146,9 +380,10 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 
 			transactions := []*rpc.Transaction{
 				{
-					UserId:   userID,
-					Datetime: timestamppb.New(now),
-					Amount:   100,
+					UserId:     userID,
+					Datetime:   timestamppb.New(now),
+					Amount:     100,
+					AmountSats: 10_000_000_000,
 				},
 			}
 
@@ -160,12 +395,78 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 			assert.NoError(t, err)
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, nil)
+			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, "", nil)
+
+			key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s",
+				userID,
+				"BTC",
+				now.UnixNano(),
+				now.UnixNano(),
+				0,
+				0,
+				"",
+			)
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
+			redisKVS.EXPECT().Set(ctx, key, b, time.Second*1).Return(nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of List transactions with bucket and page, When repository executed successfully without cache, Return no error with next page token": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			userID := int64(1)
+			now := time.Now()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ListTransactionParams{
+					UserID:        userID,
+					StartDatetime: now,
+					EndDatetime:   now,
+					PageSize:      1,
+					PageToken:     "page-token",
+					Bucket:        rpc.Bucket_BUCKET_DAY,
+				},
+			}
+
+			transactions := []*rpc.Transaction{
+				{
+					UserId:     userID,
+					Datetime:   timestamppb.New(now),
+					Amount:     100,
+					AmountSats: 10_000_000_000,
+				},
+			}
+
+			want := &rpc.ListTransactionResponse{
+				Transactions:  transactions,
+				NextPageToken: "next-page-token",
+			}
+
+			b, err := protojson.Marshal(want)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, "next-page-token", nil)
 
-			key := fmt.Sprintf("user:transactions:%d:%d:%d",
+			key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s",
 				userID,
+				"BTC",
 				now.UnixNano(),
 				now.UnixNano(),
+				rpc.Bucket_BUCKET_DAY,
+				1,
+				"page-token",
 			)
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
@@ -199,9 +500,10 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 
 			transactions := []*rpc.Transaction{
 				{
-					UserId:   userID,
-					Datetime: timestamppb.New(now),
-					Amount:   100,
+					UserId:     userID,
+					Datetime:   timestamppb.New(now),
+					Amount:     100,
+					AmountSats: 10_000_000_000,
 				},
 			}
 
@@ -213,12 +515,16 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 			assert.NoError(t, err)
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, nil)
+			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, "", nil)
 
-			key := fmt.Sprintf("user:transactions:%d:%d:%d",
+			key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s",
 				userID,
+				"BTC",
 				now.UnixNano(),
 				now.UnixNano(),
+				0,
+				0,
+				"",
 			)
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
@@ -252,9 +558,10 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 
 			transactions := []*rpc.Transaction{
 				{
-					UserId:   userID,
-					Datetime: timestamppb.New(now),
-					Amount:   100,
+					UserId:     userID,
+					Datetime:   timestamppb.New(now),
+					Amount:     100,
+					AmountSats: 10_000_000_000,
 				},
 			}
 
@@ -265,10 +572,14 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 			b, err := protojson.Marshal(want)
 			assert.NoError(t, err)
 
-			key := fmt.Sprintf("user:transactions:%d:%d:%d",
+			key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s",
 				userID,
+				"BTC",
 				now.UnixNano(),
 				now.UnixNano(),
+				0,
+				0,
+				"",
 			)
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
@@ -300,9 +611,10 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 
 			transactions := []*rpc.Transaction{
 				{
-					UserId:   userID,
-					Datetime: timestamppb.New(now),
-					Amount:   100,
+					UserId:     userID,
+					Datetime:   timestamppb.New(now),
+					Amount:     100,
+					AmountSats: 10_000_000_000,
 				},
 			}
 
@@ -314,12 +626,16 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 			assert.NoError(t, err)
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, nil)
+			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, "", nil)
 
-			key := fmt.Sprintf("user:transactions:%d:%d:%d",
+			key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s",
 				userID,
+				"BTC",
 				now.UnixNano(),
 				now.UnixNano(),
+				0,
+				0,
+				"",
 			)
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
@@ -353,9 +669,10 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 
 			transactions := []*rpc.Transaction{
 				{
-					UserId:   userID,
-					Datetime: timestamppb.New(now),
-					Amount:   100,
+					UserId:     userID,
+					Datetime:   timestamppb.New(now),
+					Amount:     100,
+					AmountSats: 10_000_000_000,
 				},
 			}
 
@@ -367,12 +684,16 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 			assert.NoError(t, err)
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, nil)
+			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, "", nil)
 
-			key := fmt.Sprintf("user:transactions:%d:%d:%d",
+			key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s",
 				userID,
+				"BTC",
 				now.UnixNano(),
 				now.UnixNano(),
+				0,
+				0,
+				"",
 			)
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
@@ -405,12 +726,16 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 			}
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(nil, errInternal)
+			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(nil, "", errInternal)
 
-			key := fmt.Sprintf("user:transactions:%d:%d:%d",
+			key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s",
 				userID,
+				"BTC",
 				now.UnixNano(),
 				now.UnixNano(),
+				0,
+				0,
+				"",
 			)
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
@@ -426,62 +751,68 @@ func TestBTCUC_ListTransaction(t *testing.T) {
 				wantErr: errInternal,
 			}
 		},
-	}
-
-	for name, testFn := range tests {
-		t.Run(name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			tt := testFn(t, ctrl)
+		"Given valid request of List transactions in USD, When repository and price source executed successfully, Return the transactions valued at the bucket time": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
 
-			sut := sut(tt.fields)
+			userID := int64(1)
+			bucket1 := time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+			bucket2 := bucket1.Add(24 * time.Hour)
 
-			got, err := sut.ListTransaction(tt.args.ctx, tt.args.params)
-			if tt.wantErr != nil {
-				assert.ErrorIs(t, err, tt.wantErr)
-			} else {
-				assert.NoError(t, err)
+			args := args{
+				ctx: ctx,
+				params: &repository.ListTransactionParams{
+					UserID:        userID,
+					StartDatetime: bucket1,
+					EndDatetime:   bucket2,
+					Bucket:        rpc.Bucket_BUCKET_DAY,
+					QuoteCurrency: "USD",
+				},
 			}
 
-			assert.Equal(t, tt.want, got)
-		})
-	}
-}
-
-func TestBTCUC_GetUserBalance(t *testing.T) {
-	type args struct {
-		ctx    context.Context
-		userID int64
-	}
-
-	type test struct {
-		fields  fields
-		args    args
-		want    *rpc.UserBalance
-		wantErr error
-	}
-
-	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
-		"Given valid request of Get User balance, When repository executed successfully without cache, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
-			ctx := context.Background()
-
-			args := args{
-				ctx:    ctx,
-				userID: 1,
+			transactions := []*rpc.Transaction{
+				{UserId: userID, Datetime: timestamppb.New(bucket1), Amount: 1, AmountSats: 100_000_000, Asset: "BTC"},
+				{UserId: userID, Datetime: timestamppb.New(bucket2), Amount: -0.5, AmountSats: -50_000_000, Asset: "BTC"},
 			}
 
-			want := &rpc.UserBalance{
-				Balance: 100,
+			want := &rpc.ListTransactionResponse{
+				Transactions: []*rpc.Transaction{
+					{
+						UserId: userID, Datetime: timestamppb.New(bucket1), Amount: 1, AmountSats: 100_000_000, Asset: "BTC",
+						Quote: &rpc.Quote{Currency: "USD", Price: 20_000, Value: 20_000, Datetime: timestamppb.New(bucket1)},
+					},
+					{
+						UserId: userID, Datetime: timestamppb.New(bucket2), Amount: -0.5, AmountSats: -50_000_000, Asset: "BTC",
+						Quote: &rpc.Quote{Currency: "USD", Price: 22_000, Value: -11_000, Datetime: timestamppb.New(bucket2)},
+					},
+				},
 			}
 
 			b, err := protojson.Marshal(want)
 			assert.NoError(t, err)
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.userID).Return(want, nil)
-
-			key := fmt.Sprintf("user:balance:%d", args.userID)
+			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, "", nil)
+
+			mockPriceSource := repository.NewGoMockPriceSource(ctrl)
+			mockPriceSource.EXPECT().GetPrices(args.ctx, &repository.GetPricesParams{
+				Asset:         "BTC",
+				QuoteCurrency: "USD",
+				Datetimes:     []time.Time{bucket1, bucket2},
+			}).Return([]*repository.Price{
+				{Datetime: bucket1, Price: 20_000},
+				{Datetime: bucket2, Price: 22_000},
+			}, nil)
+
+			key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s:%s",
+				userID,
+				"BTC",
+				bucket1.UnixNano(),
+				bucket2.UnixNano(),
+				rpc.Bucket_BUCKET_DAY,
+				0,
+				"",
+				"USD",
+			)
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
 			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
@@ -489,23 +820,249 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 
 			return test{
 				fields: fields{
-					btcRepo: mockJourneyRepo,
-					redis:   redisKVS,
+					btcRepo:     mockJourneyRepo,
+					priceSource: mockPriceSource,
+					redis:       redisKVS,
 				},
 				args:    args,
 				want:    want,
 				wantErr: nil,
 			}
 		},
-		"Given valid request of Get User balance, When repository executed successfully without cache and failed store cache, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+		"Given valid request of List transactions filtered by type and external reference, When repository executed successfully without cache, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
 			ctx := context.Background()
 
-			args := args{
-				ctx:    ctx,
-				userID: 1,
-			}
+			userID := int64(1)
+			now := time.Now()
 
-			want := &rpc.UserBalance{
+			args := args{
+				ctx: ctx,
+				params: &repository.ListTransactionParams{
+					UserID:        userID,
+					StartDatetime: now,
+					EndDatetime:   now,
+					Bucket:        rpc.Bucket_BUCKET_RAW,
+					Type:          rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+					ExternalRef:   "invoice-1988",
+				},
+			}
+
+			transactions := []*rpc.Transaction{
+				{
+					UserId:      userID,
+					Datetime:    timestamppb.New(now),
+					Amount:      100,
+					AmountSats:  10_000_000_000,
+					Type:        rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+					ExternalRef: "invoice-1988",
+				},
+			}
+
+			want := &rpc.ListTransactionResponse{
+				Transactions: transactions,
+			}
+
+			b, err := protojson.Marshal(want)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ListTransaction(args.ctx, args.params).Return(transactions, "", nil)
+
+			key := fmt.Sprintf("user:transactions:%d:%s:%d:%d:%d:%d:%s:filter:%d:%s",
+				userID,
+				"BTC",
+				now.UnixNano(),
+				now.UnixNano(),
+				rpc.Bucket_BUCKET_RAW,
+				0,
+				"",
+				rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+				"invoice-1988",
+			)
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
+			redisKVS.EXPECT().Set(ctx, key, b, time.Second*1).Return(nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.ListTransaction(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_GetTransaction(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.Transaction
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Get transaction, When repository executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				id:  "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+			}
+
+			want := &rpc.Transaction{
+				Id:         args.id,
+				UserId:     1,
+				Datetime:   timestamppb.New(time.Now()),
+				Amount:     100,
+				AmountSats: 10_000_000_000,
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetTransaction(args.ctx, args.id).Return(want, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Get transaction, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				id:  "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetTransaction(args.ctx, args.id).Return(nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.GetTransaction(tt.args.ctx, tt.args.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_GetUserBalance(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.GetUserBalanceParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.UserBalance
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Get User balance, When repository executed successfully without cache, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetUserBalanceParams{
+					UserID: 1,
+				},
+			}
+
+			want := &rpc.UserBalance{
+				Balance: 100,
+			}
+
+			b, err := protojson.Marshal(want)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.params).Return(want, nil)
+
+			key := fmt.Sprintf("user:balance:%d:%s", args.params.UserID, "BTC")
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
+			redisKVS.EXPECT().Set(ctx, key, b, time.Second*1).Return(nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Get User balance, When repository executed successfully without cache and failed store cache, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetUserBalanceParams{
+					UserID: 1,
+				},
+			}
+
+			want := &rpc.UserBalance{
 				Balance: 100,
 			}
 
@@ -513,9 +1070,9 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 			assert.NoError(t, err)
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.userID).Return(want, nil)
+			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.params).Return(want, nil)
 
-			key := fmt.Sprintf("user:balance:%d", args.userID)
+			key := fmt.Sprintf("user:balance:%d:%s", args.params.UserID, "BTC")
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
 			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
@@ -535,8 +1092,10 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 			ctx := context.Background()
 
 			args := args{
-				ctx:    ctx,
-				userID: 1,
+				ctx: ctx,
+				params: &repository.GetUserBalanceParams{
+					UserID: 1,
+				},
 			}
 
 			want := &rpc.UserBalance{
@@ -547,9 +1106,9 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 			assert.NoError(t, err)
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.userID).Return(want, nil)
+			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.params).Return(want, nil)
 
-			key := fmt.Sprintf("user:balance:%d", args.userID)
+			key := fmt.Sprintf("user:balance:%d:%s", args.params.UserID, "BTC")
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
 			redisKVS.EXPECT().Get(ctx, key).Return(nil, errors.New("error"))
@@ -569,8 +1128,10 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 			ctx := context.Background()
 
 			args := args{
-				ctx:    ctx,
-				userID: 1,
+				ctx: ctx,
+				params: &repository.GetUserBalanceParams{
+					UserID: 1,
+				},
 			}
 
 			want := &rpc.UserBalance{
@@ -580,7 +1141,7 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 			b, err := protojson.Marshal(want)
 			assert.NoError(t, err)
 
-			key := fmt.Sprintf("user:balance:%d", args.userID)
+			key := fmt.Sprintf("user:balance:%d:%s", args.params.UserID, "BTC")
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
 			redisKVS.EXPECT().Get(ctx, key).Return(string(b), nil)
@@ -598,8 +1159,10 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 			ctx := context.Background()
 
 			args := args{
-				ctx:    ctx,
-				userID: 1,
+				ctx: ctx,
+				params: &repository.GetUserBalanceParams{
+					UserID: 1,
+				},
 			}
 
 			want := &rpc.UserBalance{
@@ -610,9 +1173,9 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 			assert.NoError(t, err)
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.userID).Return(want, nil)
+			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.params).Return(want, nil)
 
-			key := fmt.Sprintf("user:balance:%d", args.userID)
+			key := fmt.Sprintf("user:balance:%d:%s", args.params.UserID, "BTC")
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
 			redisKVS.EXPECT().Get(ctx, key).Return(nil, nil)
@@ -628,18 +1191,58 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 				wantErr: nil,
 			}
 		},
+		"Given valid request of Get User balance as of a point in time, When repository executed successfully without cache, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetUserBalanceParams{
+					UserID: 1,
+					AsOf:   time.Date(2023, 2, 12, 23, 59, 59, 0, time.UTC),
+				},
+			}
+
+			want := &rpc.UserBalance{
+				Balance:     100,
+				BalanceSats: 10_000_000_000,
+			}
+
+			b, err := protojson.Marshal(want)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.params).Return(want, nil)
+
+			key := fmt.Sprintf("user:balance:asof:%d:%s:%d", args.params.UserID, "BTC", args.params.AsOf.UnixNano())
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
+			redisKVS.EXPECT().Set(ctx, key, b, time.Second*1).Return(nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
 		"Given valid request of Get User balance, When repository failed to executed with no cache, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
 			ctx := context.Background()
 
 			args := args{
-				ctx:    ctx,
-				userID: 1,
+				ctx: ctx,
+				params: &repository.GetUserBalanceParams{
+					UserID: 1,
+				},
 			}
 
 			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
-			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.userID).Return(nil, errInternal)
+			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.params).Return(nil, errInternal)
 
-			key := fmt.Sprintf("user:balance:%d", args.userID)
+			key := fmt.Sprintf("user:balance:%d:%s", args.params.UserID, "BTC")
 
 			redisKVS := kvs.NewGoMockClient(ctrl)
 			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
@@ -654,6 +1257,1604 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 				wantErr: errInternal,
 			}
 		},
+		"Given valid request of Get User balance as of a point in time in USD, When repository and price source executed successfully, Return the valued balance": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+			asOf := time.Date(2023, 2, 12, 0, 0, 0, 0, time.UTC)
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetUserBalanceParams{
+					UserID:        1,
+					AsOf:          asOf,
+					Asset:         "BTC",
+					QuoteCurrency: "USD",
+				},
+			}
+
+			want := &rpc.UserBalance{
+				Balance:     1.5,
+				BalanceSats: 150_000_000,
+				Asset:       "BTC",
+				Quote: &rpc.Quote{
+					Currency: "USD",
+					Price:    20_000,
+					Value:    30_000,
+					Datetime: timestamppb.New(asOf.Add(-time.Minute)),
+				},
+			}
+
+			b, err := protojson.Marshal(want)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.params).Return(&rpc.UserBalance{
+				Balance:     1.5,
+				BalanceSats: 150_000_000,
+				Asset:       "BTC",
+			}, nil)
+
+			mockPriceSource := repository.NewGoMockPriceSource(ctrl)
+			mockPriceSource.EXPECT().GetPrices(args.ctx, &repository.GetPricesParams{
+				Asset:         "BTC",
+				QuoteCurrency: "USD",
+				Datetimes:     []time.Time{asOf},
+			}).Return([]*repository.Price{{Datetime: asOf.Add(-time.Minute), Price: 20_000}}, nil)
+
+			key := fmt.Sprintf("user:balance:asof:%d:%s:%d:%s", args.params.UserID, "BTC", asOf.UnixNano(), "USD")
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
+			redisKVS.EXPECT().Set(ctx, key, b, time.Second*1).Return(nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo:     mockJourneyRepo,
+					priceSource: mockPriceSource,
+					redis:       redisKVS,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Get User balance in USD, When price source returns price not found error, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetUserBalanceParams{
+					UserID:        1,
+					QuoteCurrency: "USD",
+				},
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetUserBalance(args.ctx, args.params).Return(&rpc.UserBalance{
+				Balance:     1.5,
+				BalanceSats: 150_000_000,
+				Asset:       "BTC",
+			}, nil)
+
+			mockPriceSource := repository.NewGoMockPriceSource(ctrl)
+			mockPriceSource.EXPECT().GetPrices(args.ctx, gomock.Any()).Return(nil, repository.ErrPriceNotFound)
+
+			key := fmt.Sprintf("user:balance:%d:%s:%s", args.params.UserID, "BTC", "USD")
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
+
+			return test{
+				fields: fields{
+					btcRepo:     mockJourneyRepo,
+					priceSource: mockPriceSource,
+					redis:       redisKVS,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: repository.ErrPriceNotFound,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.GetUserBalance(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_GetBalanceHistory(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.GetBalanceHistoryParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.GetBalanceHistoryResponse
+		wantErr error
+	}
+
+	now := time.Now()
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Get Balance history, When repository executed successfully without cache, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetBalanceHistoryParams{
+					UserID:        1,
+					StartDatetime: now.Add(-24 * time.Hour),
+					EndDatetime:   now,
+					Bucket:        rpc.Bucket_BUCKET_DAY,
+				},
+			}
+
+			balances := []*rpc.BalancePoint{
+				{
+					Datetime:    timestamppb.New(now),
+					Balance:     100,
+					BalanceSats: 10_000_000_000,
+				},
+			}
+
+			want := &rpc.GetBalanceHistoryResponse{
+				Balances: balances,
+			}
+
+			b, err := protojson.Marshal(want)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetBalanceHistory(args.ctx, args.params).Return(balances, nil)
+
+			key := fmt.Sprintf("user:balance:history:%d:%s:%d:%d:%d",
+				args.params.UserID,
+				"BTC",
+				args.params.StartDatetime.UnixNano(),
+				args.params.EndDatetime.UnixNano(),
+				args.params.Bucket,
+			)
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
+			redisKVS.EXPECT().Set(ctx, key, b, time.Second*1).Return(nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Get Balance history, When cache exists, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetBalanceHistoryParams{
+					UserID:        1,
+					StartDatetime: now.Add(-24 * time.Hour),
+					EndDatetime:   now,
+				},
+			}
+
+			want := &rpc.GetBalanceHistoryResponse{
+				Balances: []*rpc.BalancePoint{
+					{
+						Datetime:    timestamppb.New(now),
+						Balance:     100,
+						BalanceSats: 10_000_000_000,
+					},
+				},
+			}
+
+			b, err := protojson.Marshal(want)
+			assert.NoError(t, err)
+
+			key := fmt.Sprintf("user:balance:history:%d:%s:%d:%d:%d",
+				args.params.UserID,
+				"BTC",
+				args.params.StartDatetime.UnixNano(),
+				args.params.EndDatetime.UnixNano(),
+				args.params.Bucket,
+			)
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			redisKVS.EXPECT().Get(ctx, key).Return(string(b), nil)
+
+			return test{
+				fields: fields{
+					redis: redisKVS,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Get Balance history, When repository failed to executed with no cache, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetBalanceHistoryParams{
+					UserID:        1,
+					StartDatetime: now.Add(-24 * time.Hour),
+					EndDatetime:   now,
+				},
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetBalanceHistory(args.ctx, args.params).Return(nil, errInternal)
+
+			key := fmt.Sprintf("user:balance:history:%d:%s:%d:%d:%d",
+				args.params.UserID,
+				"BTC",
+				args.params.StartDatetime.UnixNano(),
+				args.params.EndDatetime.UnixNano(),
+				args.params.Bucket,
+			)
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			redisKVS.EXPECT().Get(ctx, key).Return(nil, redis.Nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.GetBalanceHistory(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_WatchUserBalance(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userID int64
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    []*rpc.UserBalance
+		wantErr error
+		// sendFunc is called for each sent balance, with the number of sent balances so far.
+		sendFunc func(n int) error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Watch User balance, When a new balance is published, Return the latest and the new balance": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+
+			args := args{
+				ctx:    ctx,
+				userID: 1,
+			}
+
+			latest := &rpc.UserBalance{
+				Balance:     100,
+				BalanceSats: 10_000_000_000,
+			}
+
+			updated := &rpc.UserBalance{
+				Balance:     100.5,
+				BalanceSats: 10_050_000_000,
+			}
+
+			b, err := protojson.Marshal(updated)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetUserBalance(gomock.Any(), &repository.GetUserBalanceParams{
+				UserID: args.userID,
+				Asset:  "BTC",
+			}).Return(latest, nil)
+
+			ps := local.New()
+			t.Cleanup(func() { _ = ps.Close() })
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  ps,
+				},
+				args: args,
+				want: []*rpc.UserBalance{latest, updated},
+				sendFunc: func(n int) error {
+					switch n {
+					case 1:
+						// Simulates a transaction committed by another instance.
+						return ps.Publish(context.Background(), "user:balance:watch:1:BTC", b)
+					default:
+						cancel()
+						return nil
+					}
+				},
+			}
+		},
+		"Given valid request of Watch User balance, When failed to subscribe, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx:    ctx,
+				userID: 1,
+			}
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Subscribe(gomock.Any(), "user:balance:watch:1:BTC").Return(nil, errInternal)
+
+			return test{
+				fields: fields{
+					pubsub: mockPubSub,
+				},
+				args:    args,
+				wantErr: errInternal,
+			}
+		},
+		"Given valid request of Watch User balance, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx:    ctx,
+				userID: 1,
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetUserBalance(gomock.Any(), &repository.GetUserBalanceParams{
+				UserID: args.userID,
+				Asset:  "BTC",
+			}).Return(nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  local.New(),
+				},
+				args:    args,
+				wantErr: errInternal,
+			}
+		},
+		"Given valid request of Watch User balance, When failed to send, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx:    ctx,
+				userID: 1,
+			}
+
+			latest := &rpc.UserBalance{
+				Balance:     100,
+				BalanceSats: 10_000_000_000,
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetUserBalance(gomock.Any(), &repository.GetUserBalanceParams{
+				UserID: args.userID,
+				Asset:  "BTC",
+			}).Return(latest, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  local.New(),
+				},
+				args:    args,
+				want:    []*rpc.UserBalance{latest},
+				wantErr: errInternal,
+				sendFunc: func(n int) error {
+					return errInternal
+				},
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			var got []*rpc.UserBalance
+
+			err := sut.WatchUserBalance(tt.args.ctx, tt.args.userID, "BTC", func(balance *rpc.UserBalance) error {
+				got = append(got, balance)
+
+				return tt.sendFunc(len(got))
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, len(tt.want), len(got))
+
+			for i := range tt.want {
+				assert.True(t, proto.Equal(tt.want[i], got[i]))
+			}
+		})
+	}
+}
+
+func TestBTCUC_Transfer(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.TransferParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.TransferResponse
+		wantErr error
+	}
+
+	now := time.Now()
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Transfer, When repository executed successfully, Return no error and publish both balances": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.TransferParams{
+					FromUserID: 1,
+					ToUserID:   2,
+					Datetime:   now,
+					AmountSats: 10_000_000_000,
+				},
+			}
+
+			want := &rpc.TransferResponse{
+				Id: "7f0b9d3e-2c4a-4e8b-9a1d-5c6e7f8a9b0c",
+				Debit: &rpc.Transaction{
+					UserId:     1,
+					Datetime:   timestamppb.New(now),
+					Amount:     -100,
+					AmountSats: -10_000_000_000,
+					TransferId: "7f0b9d3e-2c4a-4e8b-9a1d-5c6e7f8a9b0c",
+				},
+				Credit: &rpc.Transaction{
+					UserId:     2,
+					Datetime:   timestamppb.New(now),
+					Amount:     100,
+					AmountSats: 10_000_000_000,
+					TransferId: "7f0b9d3e-2c4a-4e8b-9a1d-5c6e7f8a9b0c",
+				},
+			}
+
+			balances := &repository.TransferBalances{
+				From: &rpc.UserBalance{
+					Balance:     0,
+					BalanceSats: 0,
+				},
+				To: &rpc.UserBalance{
+					Balance:     100,
+					BalanceSats: 10_000_000_000,
+				},
+			}
+
+			from, err := protojson.Marshal(balances.From)
+			assert.NoError(t, err)
+
+			to, err := protojson.Marshal(balances.To)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, args.params.FromUserID, args.params.Asset).
+				Return(&rpc.TransactionLimits{UserId: args.params.FromUserID, Asset: "BTC"}, nil)
+			mockJourneyRepo.EXPECT().Transfer(args.ctx, args.params).Return(want, balances, nil)
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:1:BTC", from).Return(nil)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:2:BTC", to).Return(nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  mockPubSub,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Transfer with used Idempotency key, When repository returns the original transfer, Return no error without publish": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.TransferParams{
+					FromUserID:     1,
+					ToUserID:       2,
+					Datetime:       now,
+					AmountSats:     10_000_000_000,
+					IdempotencyKey: "key-1",
+				},
+			}
+
+			want := &rpc.TransferResponse{
+				Id: "7f0b9d3e-2c4a-4e8b-9a1d-5c6e7f8a9b0c",
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().FindIdempotencyKeys(args.ctx, []string{"key-1"}).Return(map[string]bool{"key-1": true}, nil)
+			mockJourneyRepo.EXPECT().Transfer(args.ctx, args.params).Return(want, nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  pubsub.NewGoMockClient(ctrl),
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Transfer, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.TransferParams{
+					FromUserID: 1,
+					ToUserID:   2,
+					Datetime:   now,
+					AmountSats: 10_000_000_000,
+				},
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, args.params.FromUserID, args.params.Asset).
+				Return(&rpc.TransactionLimits{UserId: args.params.FromUserID, Asset: "BTC"}, nil)
+			mockJourneyRepo.EXPECT().Transfer(args.ctx, args.params).Return(nil, nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.Transfer(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_CreateTransactions(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.CreateTransactionsParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    []*repository.CreateTransactionsResult
+		wantErr error
+	}
+
+	now := time.Now()
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of CreateTransactions, When repository executed successfully, Return no error and publish the changed balances": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionsParams{
+					Transactions: []*repository.CreateTransactionParams{
+						{UserID: 1, Datetime: now, AmountSats: 10_000_000_000},
+						{UserID: 2, Datetime: now, AmountSats: -10_000_000_000},
+					},
+					BestEffort: true,
+				},
+			}
+
+			want := []*repository.CreateTransactionsResult{
+				{
+					Transaction: &rpc.Transaction{
+						Id:         "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+						UserId:     1,
+						Datetime:   timestamppb.New(now),
+						Amount:     100,
+						AmountSats: 10_000_000_000,
+					},
+				},
+				{
+					Err: fmt.Errorf("user id: 2: %w", repository.ErrInsufficientBalance),
+				},
+			}
+
+			balances := map[int64][]*rpc.UserBalance{
+				1: {
+					{
+						Balance:     100,
+						BalanceSats: 10_000_000_000,
+						Asset:       "BTC",
+					},
+					{
+						Balance:     2.5,
+						BalanceSats: 2_500_000_000,
+						Asset:       "ETH",
+					},
+				},
+			}
+
+			btcBalance, err := protojson.Marshal(balances[1][0])
+			assert.NoError(t, err)
+
+			ethBalance, err := protojson.Marshal(balances[1][1])
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, int64(2), "").Return(&rpc.TransactionLimits{Asset: "BTC"}, nil)
+			mockJourneyRepo.EXPECT().CreateTransactions(args.ctx, args.params).Return(want, balances, nil)
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:1:BTC", btcBalance).Return(nil)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:1:ETH", ethBalance).Return(nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  mockPubSub,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of CreateTransactions in best effort mode, When a debit exceeds the max amount, Return the rejection of the debit and create the others": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionsParams{
+					Transactions: []*repository.CreateTransactionParams{
+						{UserID: 1, Datetime: now, AmountSats: 10_000_000_000},
+						{UserID: 2, Datetime: now, AmountSats: -10_000_000_000},
+					},
+					BestEffort: true,
+				},
+			}
+
+			created := []*repository.CreateTransactionsResult{
+				{
+					Transaction: &rpc.Transaction{
+						Id:         "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+						UserId:     1,
+						Datetime:   timestamppb.New(now),
+						Amount:     100,
+						AmountSats: 10_000_000_000,
+					},
+				},
+			}
+
+			limitErr := &repository.LimitError{
+				Limit:  repository.LimitMaxAmount,
+				UserID: 2,
+				Asset:  "BTC",
+				Value:  5_000_000_000,
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, int64(2), "").
+				Return(&rpc.TransactionLimits{Asset: "BTC", MaxAmountSats: 5_000_000_000}, nil)
+			mockJourneyRepo.EXPECT().CreateTransactions(args.ctx, &repository.CreateTransactionsParams{
+				Transactions: args.params.Transactions[:1],
+				BestEffort:   true,
+			}).Return(created, nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    []*repository.CreateTransactionsResult{created[0], {Err: limitErr}},
+				wantErr: nil,
+			}
+		},
+		"Given valid request of CreateTransactions in all or nothing mode, When a debit exceeds the daily outflow, Return an error and release the reservations": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionsParams{
+					Transactions: []*repository.CreateTransactionParams{
+						{UserID: 1, Asset: "BTC", Datetime: now, AmountSats: -5_000_000_000},
+						{UserID: 1, Asset: "BTC", Datetime: now, AmountSats: -5_000_000_000},
+					},
+				},
+			}
+
+			key := fmt.Sprintf("user:limits:outflow:1:BTC:%s", time.Now().UTC().Format("2006-01-02"))
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, int64(1), "BTC").
+				Return(&rpc.TransactionLimits{Asset: "BTC", MaxDailyOutflowSats: 8_000_000_000}, nil)
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			gomock.InOrder(
+				redisKVS.EXPECT().IncrBy(args.ctx, key, int64(5_000_000_000), 25*time.Hour).Return(int64(5_000_000_000), nil),
+				redisKVS.EXPECT().IncrBy(args.ctx, key, int64(5_000_000_000), 25*time.Hour).Return(int64(10_000_000_000), nil),
+				redisKVS.EXPECT().IncrBy(args.ctx, key, int64(-5_000_000_000), 25*time.Hour).Return(int64(5_000_000_000), nil),
+				redisKVS.EXPECT().IncrBy(args.ctx, key, int64(-5_000_000_000), 25*time.Hour).Return(int64(0), nil),
+			)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: repository.ErrLimitExceeded,
+			}
+		},
+		"Given valid request of CreateTransactions, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateTransactionsParams{
+					Transactions: []*repository.CreateTransactionParams{
+						{UserID: 1, Datetime: now, AmountSats: 10_000_000_000},
+					},
+				},
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().CreateTransactions(args.ctx, args.params).Return(nil, nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.CreateTransactions(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_ReverseTransaction(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.ReverseTransactionParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.Transaction
+		wantErr error
+	}
+
+	now := time.Now()
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of ReverseTransaction, When repository executed successfully, Return no error and publish the balance": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ReverseTransactionParams{
+					ID:     "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+					Reason: "duplicated deposit",
+				},
+			}
+
+			want := &rpc.Transaction{
+				Id:             "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+				UserId:         1,
+				Datetime:       timestamppb.New(now),
+				Amount:         -100,
+				AmountSats:     -10_000_000_000,
+				ReversedId:     args.params.ID,
+				ReversalReason: args.params.Reason,
+			}
+
+			balance := &rpc.UserBalance{
+				Balance:     0,
+				BalanceSats: 0,
+			}
+
+			b, err := protojson.Marshal(balance)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ReverseTransaction(args.ctx, args.params).Return(want, balance, nil)
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:1:BTC", b).Return(nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  mockPubSub,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of ReverseTransaction, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ReverseTransactionParams{
+					ID:     "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a",
+					Reason: "duplicated deposit",
+				},
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ReverseTransaction(args.ctx, args.params).Return(nil, nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.ReverseTransaction(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_ExportTransactions(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.ExportTransactionsParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    []*rpc.Transaction
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Export transactions, When repository executed successfully, Return each sent record": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ExportTransactionsParams{
+					UserID:        1,
+					StartDatetime: time.Now().Add(-time.Hour),
+					EndDatetime:   time.Now(),
+				},
+			}
+
+			want := []*rpc.Transaction{
+				{Id: "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a", UserId: 1, Amount: 100, AmountSats: 10_000_000_000},
+				{Id: "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3b", UserId: 1, Amount: -1, AmountSats: -100_000_000},
+			}
+
+			mockBTCRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockBTCRepo.EXPECT().ExportTransactions(args.ctx, args.params, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *repository.ExportTransactionsParams, send func(*rpc.Transaction) error) error {
+					for _, transaction := range want {
+						if err := send(transaction); err != nil {
+							return err
+						}
+					}
+
+					return nil
+				},
+			)
+
+			return test{
+				fields: fields{
+					btcRepo: mockBTCRepo,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Export transactions, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ExportTransactionsParams{
+					StartDatetime: time.Now().Add(-time.Hour),
+					EndDatetime:   time.Now(),
+				},
+			}
+
+			mockBTCRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockBTCRepo.EXPECT().ExportTransactions(args.ctx, args.params, gomock.Any()).Return(errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockBTCRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			var got []*rpc.Transaction
+
+			err := sut.ExportTransactions(tt.args.ctx, tt.args.params, func(transaction *rpc.Transaction) error {
+				got = append(got, transaction)
+
+				return nil
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_ImportTransactions(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.ImportTransactionsParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *repository.ImportTransactionsResult
+		wantErr error
+	}
+
+	now := time.Now()
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of ImportTransactions, When repository executed successfully, Return the result and publish the changed balances": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ImportTransactionsParams{
+					ImportID: "import-1",
+					Rows: []*repository.ImportRow{
+						{Sequence: 1, Transaction: &repository.CreateTransactionParams{UserID: 1, Datetime: now, AmountSats: 10_000_000_000}},
+						{Sequence: 2, Err: repository.ErrInvalidAmount},
+					},
+				},
+			}
+
+			want := &repository.ImportTransactionsResult{
+				Checkpoint: &rpc.ImportCheckpoint{ImportId: "import-1", Sequence: 2, Imported: 1, Rejected: 1},
+				Imported:   1,
+				Rejections: []*repository.ImportRejection{
+					{Sequence: 2, Err: repository.ErrInvalidAmount},
+				},
+			}
+
+			balances := map[int64][]*rpc.UserBalance{
+				1: {
+					{
+						Balance:     100,
+						BalanceSats: 10_000_000_000,
+						Asset:       "BTC",
+					},
+				},
+			}
+
+			btcBalance, err := protojson.Marshal(balances[1][0])
+			assert.NoError(t, err)
+
+			mockBTCRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockBTCRepo.EXPECT().GetImportCheckpoint(args.ctx, "import-1").Return(nil, repository.ErrNotFound)
+			mockBTCRepo.EXPECT().ImportTransactions(args.ctx, args.params).Return(want, balances, nil)
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:1:BTC", btcBalance).Return(nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockBTCRepo,
+					pubsub:  mockPubSub,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of ImportTransactions, When a debit after the checkpoint exceeds the max amount, Return the rejection of the row": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ImportTransactionsParams{
+					ImportID: "import-1",
+					Rows: []*repository.ImportRow{
+						{Sequence: 1, Transaction: &repository.CreateTransactionParams{UserID: 1, Datetime: now, AmountSats: -10_000_000_000}},
+						{Sequence: 2, Transaction: &repository.CreateTransactionParams{UserID: 1, Datetime: now, AmountSats: -10_000_000_000}},
+					},
+				},
+			}
+
+			limitErr := &repository.LimitError{
+				Limit:  repository.LimitMaxAmount,
+				UserID: 1,
+				Asset:  "BTC",
+				Value:  5_000_000_000,
+			}
+
+			want := &repository.ImportTransactionsResult{
+				Checkpoint: &rpc.ImportCheckpoint{ImportId: "import-1", Sequence: 2, Imported: 1, Rejected: 1},
+				Skipped:    1,
+				Rejections: []*repository.ImportRejection{
+					{Sequence: 2, Err: limitErr},
+				},
+			}
+
+			mockBTCRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockBTCRepo.EXPECT().GetImportCheckpoint(args.ctx, "import-1").
+				Return(&rpc.ImportCheckpoint{ImportId: "import-1", Sequence: 1, Imported: 1}, nil)
+			mockBTCRepo.EXPECT().GetTransactionLimits(args.ctx, int64(1), "").
+				Return(&rpc.TransactionLimits{Asset: "BTC", MaxAmountSats: 5_000_000_000}, nil)
+			mockBTCRepo.EXPECT().ImportTransactions(args.ctx, &repository.ImportTransactionsParams{
+				ImportID: "import-1",
+				Rows: []*repository.ImportRow{
+					args.params.Rows[0],
+					{Sequence: 2, Transaction: args.params.Rows[1].Transaction, Err: limitErr},
+				},
+			}).Return(want, nil, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockBTCRepo,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of ImportTransactions, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ImportTransactionsParams{
+					ImportID: "import-1",
+					Rows: []*repository.ImportRow{
+						{Sequence: 1, Transaction: &repository.CreateTransactionParams{UserID: 1, Datetime: now, AmountSats: 10_000_000_000}},
+					},
+				},
+			}
+
+			mockBTCRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockBTCRepo.EXPECT().GetImportCheckpoint(args.ctx, "import-1").Return(nil, repository.ErrNotFound)
+			mockBTCRepo.EXPECT().ImportTransactions(args.ctx, args.params).Return(nil, nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockBTCRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.ImportTransactions(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_GetStatement(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.GetStatementParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.Statement
+		wantErr error
+	}
+
+	startDatetime := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	endDatetime := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of Get statement, When repository executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetStatementParams{
+					UserID:        1,
+					StartDatetime: startDatetime,
+					EndDatetime:   endDatetime,
+				},
+			}
+
+			want := &rpc.Statement{
+				UserId:             1,
+				Asset:              "BTC",
+				StartDatetime:      timestamppb.New(startDatetime),
+				EndDatetime:        timestamppb.New(endDatetime),
+				OpeningBalance:     50,
+				OpeningBalanceSats: 5_000_000_000,
+				TotalIn:            100,
+				TotalInSats:        10_000_000_000,
+				ClosingBalance:     150,
+				ClosingBalanceSats: 15_000_000_000,
+				Transactions: []*rpc.Transaction{
+					{Id: "0b5c4a5e-4d6f-4f3e-9a55-7d3f0e1c2b3a", UserId: 1, Amount: 100, AmountSats: 10_000_000_000, Asset: "BTC"},
+				},
+			}
+
+			mockBTCRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockBTCRepo.EXPECT().GetStatement(args.ctx, args.params).Return(want, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockBTCRepo,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of Get statement, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.GetStatementParams{
+					UserID:        1,
+					StartDatetime: startDatetime,
+					EndDatetime:   endDatetime,
+				},
+			}
+
+			mockBTCRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockBTCRepo.EXPECT().GetStatement(args.ctx, args.params).Return(nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockBTCRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.GetStatement(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_CreateHold(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.CreateHoldParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.Hold
+		wantErr error
+	}
+
+	now := time.Now()
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of CreateHold, When repository executed successfully, Return no error and publish the balance": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateHoldParams{
+					UserID:     1,
+					AmountSats: 2_000_000_000,
+					ExpiresAt:  now.Add(time.Hour),
+				},
+			}
+
+			want := &rpc.Hold{
+				Id:         "3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7",
+				UserId:     1,
+				Asset:      "BTC",
+				AmountSats: 2_000_000_000,
+				Status:     rpc.HoldStatus_HOLD_STATUS_PENDING,
+				ExpiresAt:  timestamppb.New(args.params.ExpiresAt),
+				CreatedAt:  timestamppb.New(now),
+			}
+
+			balance := &rpc.UserBalance{
+				Balance:              100,
+				BalanceSats:          10_000_000_000,
+				Asset:                "BTC",
+				AvailableBalance:     80,
+				AvailableBalanceSats: 8_000_000_000,
+			}
+
+			b, err := protojson.Marshal(balance)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().CreateHold(args.ctx, args.params).Return(want, balance, nil)
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:1:BTC", b).Return(nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  mockPubSub,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of CreateHold, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CreateHoldParams{
+					UserID:     1,
+					AmountSats: 2_000_000_000,
+					ExpiresAt:  now.Add(time.Hour),
+				},
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().CreateHold(args.ctx, args.params).Return(nil, nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.CreateHold(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_CaptureHold(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.CaptureHoldParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.CaptureHoldResponse
+		wantErr error
+	}
+
+	now := time.Now()
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of CaptureHold, When repository executed successfully, Return no error and publish the balance": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CaptureHoldParams{
+					ID:         "3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7",
+					AmountSats: 1_500_000_000,
+				},
+			}
+
+			hold := &rpc.Hold{
+				Id:            args.params.ID,
+				UserId:        1,
+				Asset:         "BTC",
+				AmountSats:    2_000_000_000,
+				Status:        rpc.HoldStatus_HOLD_STATUS_CAPTURED,
+				TransactionId: "4f5a6b7c-8d9e-4f0a-b1c2-d3e4f5a6b7c8",
+				SettledAt:     timestamppb.New(now),
+			}
+
+			transaction := &rpc.Transaction{
+				Id:         hold.TransactionId,
+				UserId:     1,
+				Datetime:   timestamppb.New(now),
+				Amount:     -15,
+				AmountSats: -1_500_000_000,
+				Asset:      "BTC",
+				Type:       rpc.TransactionType_TRANSACTION_TYPE_WITHDRAWAL,
+			}
+
+			balance := &rpc.UserBalance{
+				Balance:              85,
+				BalanceSats:          8_500_000_000,
+				Asset:                "BTC",
+				AvailableBalance:     85,
+				AvailableBalanceSats: 8_500_000_000,
+			}
+
+			b, err := protojson.Marshal(balance)
+			assert.NoError(t, err)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetPendingHold(args.ctx, args.params.ID).Return(hold, nil)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, int64(1), "BTC").Return(&rpc.TransactionLimits{Asset: "BTC"}, nil)
+			mockJourneyRepo.EXPECT().CaptureHold(args.ctx, args.params).Return(hold, transaction, balance, nil)
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Publish(args.ctx, "user:balance:watch:1:BTC", b).Return(nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  mockPubSub,
+				},
+				args: args,
+				want: &rpc.CaptureHoldResponse{
+					Hold:        hold,
+					Transaction: transaction,
+				},
+				wantErr: nil,
+			}
+		},
+		"Given valid request of CaptureHold, When repository returns hold expired error, Return the error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CaptureHoldParams{
+					ID: "3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7",
+				},
+			}
+
+			hold := &rpc.Hold{
+				Id:         args.params.ID,
+				UserId:     1,
+				Asset:      "BTC",
+				AmountSats: 2_000_000_000,
+				Status:     rpc.HoldStatus_HOLD_STATUS_PENDING,
+			}
+
+			key := fmt.Sprintf("user:limits:outflow:1:BTC:%s", time.Now().UTC().Format("2006-01-02"))
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetPendingHold(args.ctx, args.params.ID).Return(hold, nil)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, int64(1), "BTC").
+				Return(&rpc.TransactionLimits{Asset: "BTC", MaxDailyOutflowSats: 10_000_000_000}, nil)
+			mockJourneyRepo.EXPECT().CaptureHold(args.ctx, args.params).Return(nil, nil, nil, repository.ErrHoldExpired)
+
+			redisKVS := kvs.NewGoMockClient(ctrl)
+			gomock.InOrder(
+				redisKVS.EXPECT().IncrBy(args.ctx, key, int64(2_000_000_000), 25*time.Hour).Return(int64(2_000_000_000), nil),
+				redisKVS.EXPECT().IncrBy(args.ctx, key, int64(-2_000_000_000), 25*time.Hour).Return(int64(0), nil),
+			)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					redis:   redisKVS,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: repository.ErrHoldExpired,
+			}
+		},
+		"Given valid request of CaptureHold, When the captured amount exceeds the max amount, Return the limit error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.CaptureHoldParams{
+					ID: "3e4f5a6b-7c8d-4e9f-a0b1-c2d3e4f5a6b7",
+				},
+			}
+
+			hold := &rpc.Hold{
+				Id:         args.params.ID,
+				UserId:     1,
+				Asset:      "BTC",
+				AmountSats: 2_000_000_000,
+				Status:     rpc.HoldStatus_HOLD_STATUS_PENDING,
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().GetPendingHold(args.ctx, args.params.ID).Return(hold, nil)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(args.ctx, int64(1), "BTC").
+				Return(&rpc.TransactionLimits{Asset: "BTC", MaxAmountSats: 1_000_000_000}, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: repository.ErrLimitExceeded,
+			}
+		},
 	}
 
 	for name, testFn := range tests {
@@ -665,7 +2866,7 @@ func TestBTCUC_GetUserBalance(t *testing.T) {
 
 			sut := sut(tt.fields)
 
-			got, err := sut.GetUserBalance(tt.args.ctx, tt.args.userID)
+			got, err := sut.CaptureHold(tt.args.ctx, tt.args.params)
 			if tt.wantErr != nil {
 				assert.ErrorIs(t, err, tt.wantErr)
 			} else {
@@ -10,6 +10,8 @@ import (
 	"github.com/moemoe89/btc/internal/entities/repository"
 	"github.com/moemoe89/btc/pkg/kvs"
 	"github.com/moemoe89/btc/pkg/logging"
+	"github.com/moemoe89/btc/pkg/mq"
+	"github.com/moemoe89/btc/pkg/pubsub"
 	"github.com/moemoe89/btc/pkg/trace"
 )
 
@@ -17,12 +19,85 @@ import (
 type BTCUsecase interface {
 	// CreateTransaction creates a new record for BTC transaction.
 	// Only single transaction will create by this RPC for a specific User.
+	// The debit is rejected with a LimitError when it exceeds a limit of the User.
 	CreateTransaction(ctx context.Context, params *repository.CreateTransactionParams) (*rpc.Transaction, error)
+	// CreateTransactions creates many records for BTC transaction in a single database transaction.
+	// Returns the result of each entry in the same order as the params.
+	CreateTransactions(
+		ctx context.Context, params *repository.CreateTransactionsParams,
+	) ([]*repository.CreateTransactionsResult, error)
+	// ImportTransactions creates the records for BTC transaction of a chunk of an import within a single database transaction.
+	// The rows up to the checkpoint of the import are skipped, and the rejected rows are reported without aborting the chunk.
+	ImportTransactions(
+		ctx context.Context, params *repository.ImportTransactionsParams,
+	) (*repository.ImportTransactionsResult, error)
+	// GetImportCheckpoint get the checkpoint of an import by the ID.
+	GetImportCheckpoint(ctx context.Context, importID string) (*rpc.ImportCheckpoint, error)
 	// ListTransaction get the list of records for BTC transaction.
 	// The record can be filtered by specific User.
 	ListTransaction(ctx context.Context, params *repository.ListTransactionParams) (*rpc.ListTransactionResponse, error)
+	// ExportTransactions sends each un-aggregated record for BTC transaction ordered by the datetime and the ID.
+	// The records aren't cached, since the export is read once and can be arbitrarily large.
+	ExportTransactions(ctx context.Context, params *repository.ExportTransactionsParams, send func(*rpc.Transaction) error) error
+	// Transfer moves BTC from a User to another User.
+	// The debit and credit transactions are created in a single database transaction.
+	// The debit is rejected with a LimitError when it exceeds a limit of the from User.
+	Transfer(ctx context.Context, params *repository.TransferParams) (*rpc.TransferResponse, error)
+	// GetTransaction get a single record of BTC transaction by the ID.
+	GetTransaction(ctx context.Context, id string) (*rpc.Transaction, error)
+	// ReverseTransaction creates a compensating transaction with the opposite amount of a transaction.
+	// A transaction can only be reversed once.
+	ReverseTransaction(ctx context.Context, params *repository.ReverseTransactionParams) (*rpc.Transaction, error)
 	// GetUserBalance get the latest balance for a specific User.
-	GetUserBalance(ctx context.Context, userID int64) (*rpc.UserBalance, error)
+	// If the AsOf is set, get the balance at that point in time instead.
+	GetUserBalance(ctx context.Context, params *repository.GetUserBalanceParams) (*rpc.UserBalance, error)
+	// GetBalanceHistory get the closing balance at the end of each bucket for a specific User.
+	// The buckets without transactions are omitted, since the balance is unchanged.
+	GetBalanceHistory(ctx context.Context, params *repository.GetBalanceHistoryParams) (*rpc.GetBalanceHistoryResponse, error)
+	// CreateHold reserves an amount of a User until it's captured, released or expired.
+	// The hold reduces the available balance of the User, but not the balance.
+	CreateHold(ctx context.Context, params *repository.CreateHoldParams) (*rpc.Hold, error)
+	// GetHold get a single Hold by the ID.
+	GetHold(ctx context.Context, id string) (*rpc.Hold, error)
+	// CaptureHold settles a pending hold by a withdrawal transaction of the captured amount.
+	// The captured amount may be less than the hold, the rest is released.
+	CaptureHold(ctx context.Context, params *repository.CaptureHoldParams) (*rpc.CaptureHoldResponse, error)
+	// ReleaseHold cancels a pending hold without a debit.
+	ReleaseHold(ctx context.Context, id string) (*rpc.Hold, error)
+	// SetTransactionLimits sets the limits of the debits of a User for an asset, the User ID of 0 sets the default limits.
+	SetTransactionLimits(ctx context.Context, params *repository.SetTransactionLimitsParams) (*rpc.TransactionLimits, error)
+	// GetTransactionLimits get the effective limits of the debits of a User for an asset.
+	GetTransactionLimits(ctx context.Context, userID int64, asset string) (*rpc.TransactionLimits, error)
+	// CreateSchedule creates a transaction of a User that is created by the scheduler at the start time, once or recurring.
+	CreateSchedule(ctx context.Context, params *repository.CreateScheduleParams) (*rpc.Schedule, error)
+	// ListSchedules get the list of schedules ordered by the created time and the ID.
+	// The schedules can be filtered by the User and the status.
+	ListSchedules(ctx context.Context, params *repository.ListSchedulesParams) (*rpc.ListSchedulesResponse, error)
+	// CancelSchedule cancels an active schedule, thus the next runs aren't created.
+	CancelSchedule(ctx context.Context, id string) (*rpc.Schedule, error)
+	// RunDueSchedules claims the due schedules and creates the transaction of the next run of each schedule.
+	// Each run is created once, even when the due schedules are run by many replicas.
+	// Returns the number of the claimed schedules.
+	RunDueSchedules(ctx context.Context) (int, error)
+	// RelayOutboxEvents publishes the events of the committed transactions to the message queue, in the order they're written.
+	// The events are published at-least-once, and the events of a User are published in order.
+	// Returns the number of the published events.
+	RelayOutboxEvents(ctx context.Context) (int, error)
+	// GetStatement get the account statement of a User for an asset and a period.
+	// The opening and closing balances are derived from the same snapshot of the transactions as the listed transactions.
+	GetStatement(ctx context.Context, params *repository.GetStatementParams) (*rpc.Statement, error)
+	// CreateUser creates a new User, the User is active once created.
+	CreateUser(ctx context.Context, params *repository.CreateUserParams) (*rpc.User, error)
+	// GetUser get a single User by the ID.
+	GetUser(ctx context.Context, id int64) (*rpc.User, error)
+	// ListUsers get the list of Users ordered by the ID.
+	// The Users can be filtered by the status.
+	ListUsers(ctx context.Context, params *repository.ListUsersParams) (*rpc.ListUsersResponse, error)
+	// DeactivateUser deactivates a User, thus the User can't create transactions anymore.
+	DeactivateUser(ctx context.Context, id int64) (*rpc.User, error)
+	// WatchUserBalance sends the latest balance of the asset for a specific User,
+	// then a new balance whenever a transaction of the asset for the User is committed, until the context is done.
+	WatchUserBalance(ctx context.Context, userID int64, asset string, send func(*rpc.UserBalance) error) error
 }
 
 // compile time interface implementation check.
@@ -31,22 +106,31 @@ var _ BTCUsecase = (*btcUsecase)(nil)
 // NewBTCUsecase returns BTCUsecase.
 func NewBTCUsecase(
 	btcRepo repository.BTCRepo,
+	priceSource repository.PriceSource,
 	trace trace.Tracer,
 	logger logging.Logger,
 	redis kvs.Client,
+	pubsub pubsub.Client,
+	publisher mq.Publisher,
 ) BTCUsecase {
 	return &btcUsecase{
-		btcRepo: btcRepo,
-		trace:   trace,
-		logger:  logger,
-		redis:   redis,
+		btcRepo:     btcRepo,
+		priceSource: priceSource,
+		trace:       trace,
+		logger:      logger,
+		redis:       redis,
+		pubsub:      pubsub,
+		publisher:   publisher,
 	}
 }
 
 // btcUsecase is a struct for usecase.
 type btcUsecase struct {
-	btcRepo repository.BTCRepo
-	trace   trace.Tracer
-	logger  logging.Logger
-	redis   kvs.Client
+	btcRepo     repository.BTCRepo
+	priceSource repository.PriceSource
+	trace       trace.Tracer
+	logger      logging.Logger
+	redis       kvs.Client
+	pubsub      pubsub.Client
+	publisher   mq.Publisher
 }
@@ -36,6 +36,66 @@ func (m *GoMockBTCUsecase) EXPECT() *GoMockBTCUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CancelSchedule mocks base method.
+func (m *GoMockBTCUsecase) CancelSchedule(ctx context.Context, id string) (*grpc.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSchedule", ctx, id)
+	ret0, _ := ret[0].(*grpc.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSchedule indicates an expected call of CancelSchedule.
+func (mr *GoMockBTCUsecaseMockRecorder) CancelSchedule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSchedule", reflect.TypeOf((*GoMockBTCUsecase)(nil).CancelSchedule), ctx, id)
+}
+
+// CaptureHold mocks base method.
+func (m *GoMockBTCUsecase) CaptureHold(ctx context.Context, params *repository.CaptureHoldParams) (*grpc.CaptureHoldResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, params)
+	ret0, _ := ret[0].(*grpc.CaptureHoldResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *GoMockBTCUsecaseMockRecorder) CaptureHold(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*GoMockBTCUsecase)(nil).CaptureHold), ctx, params)
+}
+
+// CreateHold mocks base method.
+func (m *GoMockBTCUsecase) CreateHold(ctx context.Context, params *repository.CreateHoldParams) (*grpc.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", ctx, params)
+	ret0, _ := ret[0].(*grpc.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *GoMockBTCUsecaseMockRecorder) CreateHold(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*GoMockBTCUsecase)(nil).CreateHold), ctx, params)
+}
+
+// CreateSchedule mocks base method.
+func (m *GoMockBTCUsecase) CreateSchedule(ctx context.Context, params *repository.CreateScheduleParams) (*grpc.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, params)
+	ret0, _ := ret[0].(*grpc.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *GoMockBTCUsecaseMockRecorder) CreateSchedule(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*GoMockBTCUsecase)(nil).CreateSchedule), ctx, params)
+}
+
 // CreateTransaction mocks base method.
 func (m *GoMockBTCUsecase) CreateTransaction(ctx context.Context, params *repository.CreateTransactionParams) (*grpc.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -51,19 +111,213 @@ func (mr *GoMockBTCUsecaseMockRecorder) CreateTransaction(ctx, params interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*GoMockBTCUsecase)(nil).CreateTransaction), ctx, params)
 }
 
+// CreateTransactions mocks base method.
+func (m *GoMockBTCUsecase) CreateTransactions(ctx context.Context, params *repository.CreateTransactionsParams) ([]*repository.CreateTransactionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactions", ctx, params)
+	ret0, _ := ret[0].([]*repository.CreateTransactionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactions indicates an expected call of CreateTransactions.
+func (mr *GoMockBTCUsecaseMockRecorder) CreateTransactions(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactions", reflect.TypeOf((*GoMockBTCUsecase)(nil).CreateTransactions), ctx, params)
+}
+
+// CreateUser mocks base method.
+func (m *GoMockBTCUsecase) CreateUser(ctx context.Context, params *repository.CreateUserParams) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, params)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *GoMockBTCUsecaseMockRecorder) CreateUser(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*GoMockBTCUsecase)(nil).CreateUser), ctx, params)
+}
+
+// DeactivateUser mocks base method.
+func (m *GoMockBTCUsecase) DeactivateUser(ctx context.Context, id int64) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, id)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *GoMockBTCUsecaseMockRecorder) DeactivateUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*GoMockBTCUsecase)(nil).DeactivateUser), ctx, id)
+}
+
+// ExportTransactions mocks base method.
+func (m *GoMockBTCUsecase) ExportTransactions(ctx context.Context, params *repository.ExportTransactionsParams, send func(*grpc.Transaction) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTransactions", ctx, params, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportTransactions indicates an expected call of ExportTransactions.
+func (mr *GoMockBTCUsecaseMockRecorder) ExportTransactions(ctx, params, send interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTransactions", reflect.TypeOf((*GoMockBTCUsecase)(nil).ExportTransactions), ctx, params, send)
+}
+
+// GetBalanceHistory mocks base method.
+func (m *GoMockBTCUsecase) GetBalanceHistory(ctx context.Context, params *repository.GetBalanceHistoryParams) (*grpc.GetBalanceHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceHistory", ctx, params)
+	ret0, _ := ret[0].(*grpc.GetBalanceHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceHistory indicates an expected call of GetBalanceHistory.
+func (mr *GoMockBTCUsecaseMockRecorder) GetBalanceHistory(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceHistory", reflect.TypeOf((*GoMockBTCUsecase)(nil).GetBalanceHistory), ctx, params)
+}
+
+// GetHold mocks base method.
+func (m *GoMockBTCUsecase) GetHold(ctx context.Context, id string) (*grpc.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", ctx, id)
+	ret0, _ := ret[0].(*grpc.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *GoMockBTCUsecaseMockRecorder) GetHold(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*GoMockBTCUsecase)(nil).GetHold), ctx, id)
+}
+
+// GetImportCheckpoint mocks base method.
+func (m *GoMockBTCUsecase) GetImportCheckpoint(ctx context.Context, importID string) (*grpc.ImportCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportCheckpoint", ctx, importID)
+	ret0, _ := ret[0].(*grpc.ImportCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportCheckpoint indicates an expected call of GetImportCheckpoint.
+func (mr *GoMockBTCUsecaseMockRecorder) GetImportCheckpoint(ctx, importID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportCheckpoint", reflect.TypeOf((*GoMockBTCUsecase)(nil).GetImportCheckpoint), ctx, importID)
+}
+
+// GetStatement mocks base method.
+func (m *GoMockBTCUsecase) GetStatement(ctx context.Context, params *repository.GetStatementParams) (*grpc.Statement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", ctx, params)
+	ret0, _ := ret[0].(*grpc.Statement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *GoMockBTCUsecaseMockRecorder) GetStatement(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*GoMockBTCUsecase)(nil).GetStatement), ctx, params)
+}
+
+// GetTransaction mocks base method.
+func (m *GoMockBTCUsecase) GetTransaction(ctx context.Context, id string) (*grpc.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", ctx, id)
+	ret0, _ := ret[0].(*grpc.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *GoMockBTCUsecaseMockRecorder) GetTransaction(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*GoMockBTCUsecase)(nil).GetTransaction), ctx, id)
+}
+
+// GetTransactionLimits mocks base method.
+func (m *GoMockBTCUsecase) GetTransactionLimits(ctx context.Context, userID int64, asset string) (*grpc.TransactionLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionLimits", ctx, userID, asset)
+	ret0, _ := ret[0].(*grpc.TransactionLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionLimits indicates an expected call of GetTransactionLimits.
+func (mr *GoMockBTCUsecaseMockRecorder) GetTransactionLimits(ctx, userID, asset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionLimits", reflect.TypeOf((*GoMockBTCUsecase)(nil).GetTransactionLimits), ctx, userID, asset)
+}
+
+// GetUser mocks base method.
+func (m *GoMockBTCUsecase) GetUser(ctx context.Context, id int64) (*grpc.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(*grpc.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *GoMockBTCUsecaseMockRecorder) GetUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*GoMockBTCUsecase)(nil).GetUser), ctx, id)
+}
+
 // GetUserBalance mocks base method.
-func (m *GoMockBTCUsecase) GetUserBalance(ctx context.Context, userID int64) (*grpc.UserBalance, error) {
+func (m *GoMockBTCUsecase) GetUserBalance(ctx context.Context, params *repository.GetUserBalanceParams) (*grpc.UserBalance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserBalance", ctx, userID)
+	ret := m.ctrl.Call(m, "GetUserBalance", ctx, params)
 	ret0, _ := ret[0].(*grpc.UserBalance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserBalance indicates an expected call of GetUserBalance.
-func (mr *GoMockBTCUsecaseMockRecorder) GetUserBalance(ctx, userID interface{}) *gomock.Call {
+func (mr *GoMockBTCUsecaseMockRecorder) GetUserBalance(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBalance", reflect.TypeOf((*GoMockBTCUsecase)(nil).GetUserBalance), ctx, params)
+}
+
+// ImportTransactions mocks base method.
+func (m *GoMockBTCUsecase) ImportTransactions(ctx context.Context, params *repository.ImportTransactionsParams) (*repository.ImportTransactionsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTransactions", ctx, params)
+	ret0, _ := ret[0].(*repository.ImportTransactionsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportTransactions indicates an expected call of ImportTransactions.
+func (mr *GoMockBTCUsecaseMockRecorder) ImportTransactions(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBalance", reflect.TypeOf((*GoMockBTCUsecase)(nil).GetUserBalance), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTransactions", reflect.TypeOf((*GoMockBTCUsecase)(nil).ImportTransactions), ctx, params)
+}
+
+// ListSchedules mocks base method.
+func (m *GoMockBTCUsecase) ListSchedules(ctx context.Context, params *repository.ListSchedulesParams) (*grpc.ListSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", ctx, params)
+	ret0, _ := ret[0].(*grpc.ListSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *GoMockBTCUsecaseMockRecorder) ListSchedules(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*GoMockBTCUsecase)(nil).ListSchedules), ctx, params)
 }
 
 // ListTransaction mocks base method.
@@ -80,3 +334,122 @@ func (mr *GoMockBTCUsecaseMockRecorder) ListTransaction(ctx, params interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransaction", reflect.TypeOf((*GoMockBTCUsecase)(nil).ListTransaction), ctx, params)
 }
+
+// ListUsers mocks base method.
+func (m *GoMockBTCUsecase) ListUsers(ctx context.Context, params *repository.ListUsersParams) (*grpc.ListUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, params)
+	ret0, _ := ret[0].(*grpc.ListUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *GoMockBTCUsecaseMockRecorder) ListUsers(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*GoMockBTCUsecase)(nil).ListUsers), ctx, params)
+}
+
+// RelayOutboxEvents mocks base method.
+func (m *GoMockBTCUsecase) RelayOutboxEvents(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayOutboxEvents", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayOutboxEvents indicates an expected call of RelayOutboxEvents.
+func (mr *GoMockBTCUsecaseMockRecorder) RelayOutboxEvents(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutboxEvents", reflect.TypeOf((*GoMockBTCUsecase)(nil).RelayOutboxEvents), ctx)
+}
+
+// ReleaseHold mocks base method.
+func (m *GoMockBTCUsecase) ReleaseHold(ctx context.Context, id string) (*grpc.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", ctx, id)
+	ret0, _ := ret[0].(*grpc.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *GoMockBTCUsecaseMockRecorder) ReleaseHold(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*GoMockBTCUsecase)(nil).ReleaseHold), ctx, id)
+}
+
+// ReverseTransaction mocks base method.
+func (m *GoMockBTCUsecase) ReverseTransaction(ctx context.Context, params *repository.ReverseTransactionParams) (*grpc.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseTransaction", ctx, params)
+	ret0, _ := ret[0].(*grpc.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReverseTransaction indicates an expected call of ReverseTransaction.
+func (mr *GoMockBTCUsecaseMockRecorder) ReverseTransaction(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransaction", reflect.TypeOf((*GoMockBTCUsecase)(nil).ReverseTransaction), ctx, params)
+}
+
+// RunDueSchedules mocks base method.
+func (m *GoMockBTCUsecase) RunDueSchedules(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunDueSchedules", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDueSchedules indicates an expected call of RunDueSchedules.
+func (mr *GoMockBTCUsecaseMockRecorder) RunDueSchedules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDueSchedules", reflect.TypeOf((*GoMockBTCUsecase)(nil).RunDueSchedules), ctx)
+}
+
+// SetTransactionLimits mocks base method.
+func (m *GoMockBTCUsecase) SetTransactionLimits(ctx context.Context, params *repository.SetTransactionLimitsParams) (*grpc.TransactionLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransactionLimits", ctx, params)
+	ret0, _ := ret[0].(*grpc.TransactionLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTransactionLimits indicates an expected call of SetTransactionLimits.
+func (mr *GoMockBTCUsecaseMockRecorder) SetTransactionLimits(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransactionLimits", reflect.TypeOf((*GoMockBTCUsecase)(nil).SetTransactionLimits), ctx, params)
+}
+
+// Transfer mocks base method.
+func (m *GoMockBTCUsecase) Transfer(ctx context.Context, params *repository.TransferParams) (*grpc.TransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, params)
+	ret0, _ := ret[0].(*grpc.TransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *GoMockBTCUsecaseMockRecorder) Transfer(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*GoMockBTCUsecase)(nil).Transfer), ctx, params)
+}
+
+// WatchUserBalance mocks base method.
+func (m *GoMockBTCUsecase) WatchUserBalance(ctx context.Context, userID int64, asset string, send func(*grpc.UserBalance) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchUserBalance", ctx, userID, asset, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchUserBalance indicates an expected call of WatchUserBalance.
+func (mr *GoMockBTCUsecaseMockRecorder) WatchUserBalance(ctx, userID, asset, send interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUserBalance", reflect.TypeOf((*GoMockBTCUsecase)(nil).WatchUserBalance), ctx, userID, asset, send)
+}
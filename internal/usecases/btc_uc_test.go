@@ -5,18 +5,26 @@ import (
 	"github.com/moemoe89/btc/internal/entities/repository"
 	"github.com/moemoe89/btc/internal/usecases"
 	"github.com/moemoe89/btc/pkg/kvs"
+	"github.com/moemoe89/btc/pkg/mq"
+	"github.com/moemoe89/btc/pkg/pubsub"
 )
 
 type fields struct {
-	btcRepo repository.BTCRepo
-	redis   kvs.Client
+	btcRepo     repository.BTCRepo
+	priceSource repository.PriceSource
+	redis       kvs.Client
+	pubsub      pubsub.Client
+	publisher   mq.Publisher
 }
 
 func sut(f fields) usecases.BTCUsecase {
 	return usecases.NewBTCUsecase(
 		f.btcRepo,
+		f.priceSource,
 		di.GetTracer().Tracer(),
 		di.GetLogger(),
 		f.redis,
+		f.pubsub,
+		f.publisher,
 	)
 }
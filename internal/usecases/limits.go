@@ -0,0 +1,248 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultCountWindow is the window of the max count, when neither the User nor the default limits set it.
+	defaultCountWindow = 24 * time.Hour
+	// outflowExpiration is the expiration of the daily outflow counter,
+	// it outlives the day a little, thus the counter is never expired while the day is still counted.
+	outflowExpiration = 25 * time.Hour
+	// outflowDateLayout is the layout of the day of the daily outflow counter.
+	outflowDateLayout = "2006-01-02"
+)
+
+// SetTransactionLimits sets the limits of the debits of a User for an asset, the User ID of 0 sets the default limits.
+func (u *btcUsecase) SetTransactionLimits(
+	ctx context.Context, params *repository.SetTransactionLimitsParams,
+) (*rpc.TransactionLimits, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.SetTransactionLimits", nil)
+	defer span.End()
+
+	return u.btcRepo.SetTransactionLimits(ctx, params)
+}
+
+// GetTransactionLimits get the effective limits of the debits of a User for an asset.
+// The limits aren't cached, thus a changed limit is enforced right away.
+func (u *btcUsecase) GetTransactionLimits(ctx context.Context, userID int64, asset string) (*rpc.TransactionLimits, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.GetTransactionLimits", nil)
+	defer span.End()
+
+	return u.btcRepo.GetTransactionLimits(ctx, userID, asset)
+}
+
+// limitCounter is a running counter of a window, incremented by the reserved value of a debit.
+type limitCounter struct {
+	key    string
+	value  int64
+	expire time.Duration
+}
+
+// reserveLimits checks a debit of the User against the effective limits of the asset,
+// and reserves the amount and the count of the debit in the running counters of the windows.
+// Each counter is incremented atomically before it's checked, thus the concurrent debits can't both slip under a limit.
+// Returns the release, which gives the reservation back when the debit isn't committed.
+// The credit isn't limited, and the replay of an already used idempotency key was counted by the original,
+// thus both are returned with a release that does nothing.
+func (u *btcUsecase) reserveLimits(
+	ctx context.Context, userID int64, asset string, amountSats int64, idempotencyKey string,
+) (func(), error) {
+	if amountSats >= 0 {
+		return func() {}, nil
+	}
+
+	if idempotencyKey != "" {
+		used, err := u.btcRepo.FindIdempotencyKeys(ctx, []string{idempotencyKey})
+		if err != nil {
+			return nil, err
+		}
+
+		if used[idempotencyKey] {
+			return func() {}, nil
+		}
+	}
+
+	limits, err := u.btcRepo.GetTransactionLimits(ctx, userID, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.reserveDebit(ctx, limits, userID, -amountSats)
+}
+
+// reserveDebits reserves the limits of each debit of the params in order, as reserveLimits does for a single debit.
+// The limits of a User and an asset are looked up once for all of its debits.
+// Returns the release of each reserved debit and the LimitError of each debit exceeding a limit, by the index of the params.
+// The nil params, the credits, the replays and the debits of a missing User or asset aren't reserved,
+// the latter are rejected by the repository anyway.
+func (u *btcUsecase) reserveDebits(
+	ctx context.Context, params []*repository.CreateTransactionParams,
+) ([]func(), []error, error) {
+	var keys []string
+
+	for _, p := range params {
+		if p != nil && p.AmountSats < 0 && p.IdempotencyKey != "" {
+			keys = append(keys, p.IdempotencyKey)
+		}
+	}
+
+	used := make(map[string]bool)
+
+	if len(keys) > 0 {
+		var err error
+
+		used, err = u.btcRepo.FindIdempotencyKeys(ctx, keys)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var (
+		releases   = make([]func(), len(params))
+		rejections = make([]error, len(params))
+		limits     = make(map[string]*rpc.TransactionLimits)
+	)
+
+	for i, p := range params {
+		if p == nil || p.AmountSats >= 0 || used[p.IdempotencyKey] {
+			continue
+		}
+
+		key := fmt.Sprintf("%d:%s", p.UserID, p.Asset)
+
+		l, ok := limits[key]
+		if !ok {
+			var err error
+
+			l, err = u.btcRepo.GetTransactionLimits(ctx, p.UserID, p.Asset)
+			if err != nil && !errors.Is(err, repository.ErrNotFound) && !errors.Is(err, repository.ErrInvalidAsset) {
+				releaseDebits(releases)
+				return nil, nil, err
+			}
+
+			limits[key] = l
+		}
+
+		if l == nil {
+			continue
+		}
+
+		release, err := u.reserveDebit(ctx, l, p.UserID, -p.AmountSats)
+		if errors.Is(err, repository.ErrLimitExceeded) {
+			rejections[i] = err
+			continue
+		}
+
+		if err != nil {
+			releaseDebits(releases)
+			return nil, nil, err
+		}
+
+		releases[i] = release
+	}
+
+	return releases, rejections, nil
+}
+
+// releaseDebits gives back the reservations of reserveDebits.
+func releaseDebits(releases []func()) {
+	for _, release := range releases {
+		if release != nil {
+			release()
+		}
+	}
+}
+
+// reserveDebit checks an outflow of the User against the limits, and reserves it in the running counters of the windows.
+// Returns the release, which gives the reservation back when the debit isn't committed.
+func (u *btcUsecase) reserveDebit(
+	ctx context.Context, limits *rpc.TransactionLimits, userID int64, outflowSats int64,
+) (func(), error) {
+	if limits.GetMaxAmountSats() > 0 && outflowSats > limits.GetMaxAmountSats() {
+		return nil, &repository.LimitError{
+			Limit:  repository.LimitMaxAmount,
+			UserID: userID,
+			Asset:  limits.GetAsset(),
+			Value:  limits.GetMaxAmountSats(),
+		}
+	}
+
+	var reserved []limitCounter
+
+	release := func() {
+		for _, counter := range reserved {
+			// The failed release only blocks the debits of the User until the window ends,
+			// thus it's logged instead of failing the request.
+			if _, err := u.redis.IncrBy(ctx, counter.key, -counter.value, counter.expire); err != nil {
+				u.logger.Warn("failed releases transaction limit counter", zap.String("key", counter.key), zap.Error(err))
+			}
+		}
+	}
+
+	// reserve increments the counter, and releases every reservation once the limit is exceeded.
+	reserve := func(counter limitCounter, limit string, value int64) error {
+		total, err := u.redis.IncrBy(ctx, counter.key, counter.value, counter.expire)
+		if err != nil {
+			release()
+			return err
+		}
+
+		reserved = append(reserved, counter)
+
+		if total > value {
+			release()
+
+			return &repository.LimitError{Limit: limit, UserID: userID, Asset: limits.GetAsset(), Value: value}
+		}
+
+		return nil
+	}
+
+	var err error
+
+	now := time.Now().UTC()
+
+	if limits.GetMaxDailyOutflowSats() > 0 {
+		counter := limitCounter{
+			key:    fmt.Sprintf("user:limits:outflow:%d:%s:%s", userID, limits.GetAsset(), now.Format(outflowDateLayout)),
+			value:  outflowSats,
+			expire: outflowExpiration,
+		}
+
+		if err = reserve(counter, repository.LimitMaxDailyOutflow, limits.GetMaxDailyOutflowSats()); err != nil {
+			return nil, err
+		}
+	}
+
+	if limits.GetMaxCount() > 0 {
+		window := time.Duration(limits.GetCountWindowSeconds()) * time.Second
+		if window == 0 {
+			window = defaultCountWindow
+		}
+
+		// The window is part of the key, thus a changed window starts a new counter.
+		counter := limitCounter{
+			key: fmt.Sprintf("user:limits:count:%d:%s:%d:%d",
+				userID, limits.GetAsset(), limits.GetCountWindowSeconds(), now.Truncate(window).Unix(),
+			),
+			value:  1,
+			expire: window,
+		}
+
+		if err = reserve(counter, repository.LimitMaxCount, limits.GetMaxCount()); err != nil {
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/pkg/mq"
+)
+
+// outboxBatchSize is the maximum number of the events published by a single relay.
+const outboxBatchSize = 100
+
+// RelayOutboxEvents publishes the events of the committed transactions to the message queue, in the order they're written.
+// The ID of the event is the ID of the message, thus the consumers can drop the events published again.
+func (u *btcUsecase) RelayOutboxEvents(ctx context.Context) (int, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.RelayOutboxEvents", nil)
+	defer span.End()
+
+	return u.btcRepo.RelayOutboxEvents(ctx, outboxBatchSize, func(e *repository.OutboxEvent) error {
+		return u.publisher.Publish(ctx, &mq.Message{
+			ID:   strconv.FormatInt(e.ID, 10),
+			Type: e.Type,
+			Body: e.Payload,
+			Headers: map[string]string{
+				"user_id": strconv.FormatInt(e.UserID, 10),
+			},
+			Timestamp: e.CreatedAt,
+		})
+	})
+}
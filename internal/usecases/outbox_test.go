@@ -0,0 +1,135 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/pkg/mq"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBTCUC_RelayOutboxEvents(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    int
+		wantErr error
+	}
+
+	createdAt := time.Date(2027, time.January, 31, 9, 0, 0, 0, time.UTC)
+
+	events := []*repository.OutboxEvent{
+		{
+			ID:        1,
+			UserID:    1,
+			Type:      repository.OutboxEventTransactionCreated,
+			Payload:   []byte(`{"transaction":{"id":"7c8d9e0f-0000-4000-8000-000000000001","userId":"1"}}`),
+			CreatedAt: createdAt,
+		},
+		{
+			ID:        2,
+			UserID:    1,
+			Type:      repository.OutboxEventBalanceChanged,
+			Payload:   []byte(`{"userId":"1","balance":{"balanceSats":"1000000","asset":"BTC"}}`),
+			CreatedAt: createdAt,
+		},
+	}
+
+	// relay publishes the events by the publish function, until the first event that failed to publish.
+	relay := func(_ context.Context, _ int, publish func(*repository.OutboxEvent) error) (int, error) {
+		for i, e := range events {
+			if err := publish(e); err != nil {
+				return i, err
+			}
+		}
+
+		return len(events), nil
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given the outbox events, When the events published successfully, Return the number of the published events": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().RelayOutboxEvents(ctx, 100, gomock.Any()).DoAndReturn(relay)
+
+			mockPublisher := mq.NewGoMockPublisher(ctrl)
+			gomock.InOrder(
+				mockPublisher.EXPECT().Publish(ctx, &mq.Message{
+					ID:        "1",
+					Type:      "transaction.created",
+					Body:      events[0].Payload,
+					Headers:   map[string]string{"user_id": "1"},
+					Timestamp: createdAt,
+				}).Return(nil),
+				mockPublisher.EXPECT().Publish(ctx, &mq.Message{
+					ID:        "2",
+					Type:      "balance.changed",
+					Body:      events[1].Payload,
+					Headers:   map[string]string{"user_id": "1"},
+					Timestamp: createdAt,
+				}).Return(nil),
+			)
+
+			return test{
+				fields: fields{
+					btcRepo:   mockJourneyRepo,
+					publisher: mockPublisher,
+				},
+				args:    args{ctx: ctx},
+				want:    2,
+				wantErr: nil,
+			}
+		},
+		"Given the outbox events, When the publish of the second event failed, Return the number of the published events and the error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().RelayOutboxEvents(ctx, 100, gomock.Any()).DoAndReturn(relay)
+
+			mockPublisher := mq.NewGoMockPublisher(ctrl)
+			gomock.InOrder(
+				mockPublisher.EXPECT().Publish(ctx, gomock.Any()).Return(nil),
+				mockPublisher.EXPECT().Publish(ctx, gomock.Any()).Return(errInternal),
+			)
+
+			return test{
+				fields: fields{
+					btcRepo:   mockJourneyRepo,
+					publisher: mockPublisher,
+				},
+				args:    args{ctx: ctx},
+				want:    1,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.RelayOutboxEvents(tt.args.ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
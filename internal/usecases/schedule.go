@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// scheduleBatchSize is the maximum number of the due schedules claimed at once.
+	scheduleBatchSize = 100
+	// scheduleLease is the duration a claimed schedule is locked for a replica,
+	// the run that isn't finished within the lease is retried by any replica.
+	scheduleLease = 5 * time.Minute
+)
+
+// CreateSchedule creates a transaction of a User that is created by the scheduler at the start time, once or recurring.
+func (u *btcUsecase) CreateSchedule(ctx context.Context, params *repository.CreateScheduleParams) (*rpc.Schedule, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.CreateSchedule", nil)
+	defer span.End()
+
+	return u.btcRepo.CreateSchedule(ctx, params)
+}
+
+// ListSchedules get the list of schedules ordered by the created time and the ID.
+// The schedules can be filtered by the User and the status.
+func (u *btcUsecase) ListSchedules(ctx context.Context, params *repository.ListSchedulesParams) (*rpc.ListSchedulesResponse, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.ListSchedules", nil)
+	defer span.End()
+
+	schedules, nextPageToken, err := u.btcRepo.ListSchedules(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.ListSchedulesResponse{
+		Schedules:     schedules,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// CancelSchedule cancels an active schedule, thus the next runs aren't created.
+func (u *btcUsecase) CancelSchedule(ctx context.Context, id string) (*rpc.Schedule, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.CancelSchedule", nil)
+	defer span.End()
+
+	return u.btcRepo.CancelSchedule(ctx, id)
+}
+
+// RunDueSchedules claims the due schedules and creates the transaction of the next run of each schedule.
+// Returns the number of the claimed schedules, thus the caller knows whether more schedules may be due.
+func (u *btcUsecase) RunDueSchedules(ctx context.Context) (int, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.RunDueSchedules", nil)
+	defer span.End()
+
+	schedules, err := u.btcRepo.ClaimDueSchedules(ctx, scheduleBatchSize, scheduleLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, schedule := range schedules {
+		u.runSchedule(ctx, schedule)
+	}
+
+	return len(schedules), nil
+}
+
+// runSchedule creates the transaction of the next run of a claimed schedule, then moves the schedule into the following run.
+// The idempotency key is derived from the schedule and the run, thus the run retried by any replica
+// replays the same transaction instead of creating another one.
+func (u *btcUsecase) runSchedule(ctx context.Context, schedule *rpc.Schedule) {
+	runAt := schedule.GetNextRunAt().AsTime()
+
+	params := &repository.FinishScheduleRunParams{
+		ID:        schedule.GetId(),
+		RunAt:     runAt,
+		NextRunAt: nextScheduleRun(schedule),
+	}
+
+	transaction, err := u.CreateTransaction(ctx, &repository.CreateTransactionParams{
+		UserID:         schedule.GetUserId(),
+		Datetime:       runAt,
+		AmountSats:     schedule.GetAmountSats(),
+		IdempotencyKey: fmt.Sprintf("schedule:%s:%d", schedule.GetId(), runAt.Unix()),
+		Asset:          schedule.GetAsset(),
+		Type:           schedule.GetType(),
+		Description:    schedule.GetDescription(),
+		Labels:         schedule.GetLabels(),
+	})
+
+	switch {
+	case err == nil:
+		params.TransactionID = transaction.GetId()
+	case isRejectedRun(err):
+		// The rejected run won't succeed by retrying the same transaction, thus it's skipped.
+		params.Error = err.Error()
+	default:
+		// The lease isn't released, thus the run is retried once the lease ends.
+		u.logger.Error("failed runs schedule", zap.String("id", schedule.GetId()), zap.Error(err))
+		return
+	}
+
+	if _, err = u.btcRepo.FinishScheduleRun(ctx, params); err != nil {
+		u.logger.Warn("failed finishes schedule run", zap.String("id", schedule.GetId()), zap.Error(err))
+	}
+}
+
+// isRejectedRun returns whether the transaction of a run is rejected by the domain rules, instead of a temporary failure.
+func isRejectedRun(err error) bool {
+	return errors.Is(err, repository.ErrInsufficientBalance) || errors.Is(err, repository.ErrUserDeactivated) ||
+		errors.Is(err, repository.ErrLimitExceeded) || errors.Is(err, repository.ErrNotFound) ||
+		errors.Is(err, repository.ErrIdempotencyKeyConflict) || errors.Is(err, repository.ErrInvalidAsset) ||
+		errors.Is(err, repository.ErrInvalidMetadata)
+}
+
+// nextScheduleRun returns the run after the next run of the schedule, zero if the schedule has no more runs.
+// The runs are counted from the start time instead of the previous run, thus the monthly runs don't drift
+// after a shorter month.
+func nextScheduleRun(schedule *rpc.Schedule) time.Time {
+	start := schedule.GetStartAt().AsTime()
+	n := int(schedule.GetRunCount()) + 1
+
+	var next time.Time
+
+	switch schedule.GetInterval() {
+	case rpc.ScheduleInterval_SCHEDULE_INTERVAL_DAILY:
+		next = start.AddDate(0, 0, n)
+	case rpc.ScheduleInterval_SCHEDULE_INTERVAL_WEEKLY:
+		next = start.AddDate(0, 0, 7*n)
+	case rpc.ScheduleInterval_SCHEDULE_INTERVAL_MONTHLY:
+		next = addMonths(start, n)
+	default:
+		return time.Time{}
+	}
+
+	if schedule.GetEndAt() != nil && next.After(schedule.GetEndAt().AsTime()) {
+		return time.Time{}
+	}
+
+	return next
+}
+
+// addMonths adds the months into the date and time,
+// the day after the end of a shorter month is the last day of the month, e.g. Jan 31 + 1 month is Feb 28.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+
+	// The day 0 of the following month is the last day of the month.
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month+time.Month(months), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
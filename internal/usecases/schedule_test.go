@@ -0,0 +1,194 @@
+package usecases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+	"github.com/moemoe89/btc/pkg/pubsub"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBTCUC_RunDueSchedules(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    int
+		wantErr error
+	}
+
+	startAt := time.Date(2027, time.January, 31, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given a due monthly schedule, When the transaction created successfully, Return the claimed schedule and move into the last day of the next month": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			schedule := &rpc.Schedule{
+				Id:         "6b7c8d9e-0000-4000-8000-000000000001",
+				UserId:     1,
+				Asset:      "BTC",
+				AmountSats: 1_000_000,
+				Type:       rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+				Interval:   rpc.ScheduleInterval_SCHEDULE_INTERVAL_MONTHLY,
+				StartAt:    timestamppb.New(startAt),
+				NextRunAt:  timestamppb.New(startAt),
+				Status:     rpc.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+			}
+
+			transaction := &rpc.Transaction{
+				Id:         "7c8d9e0f-0000-4000-8000-000000000001",
+				UserId:     1,
+				AmountSats: 1_000_000,
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ClaimDueSchedules(ctx, 100, 5*time.Minute).Return([]*rpc.Schedule{schedule}, nil)
+			mockJourneyRepo.EXPECT().CreateTransaction(ctx, &repository.CreateTransactionParams{
+				UserID:         1,
+				Datetime:       startAt,
+				AmountSats:     1_000_000,
+				IdempotencyKey: fmt.Sprintf("schedule:%s:%d", schedule.Id, startAt.Unix()),
+				Asset:          "BTC",
+				Type:           rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+			}).Return(transaction, &rpc.UserBalance{BalanceSats: 1_000_000, Asset: "BTC"}, nil)
+			mockJourneyRepo.EXPECT().FinishScheduleRun(ctx, &repository.FinishScheduleRunParams{
+				ID:            schedule.Id,
+				RunAt:         startAt,
+				NextRunAt:     time.Date(2027, time.February, 28, 9, 0, 0, 0, time.UTC),
+				TransactionID: transaction.Id,
+			}).Return(schedule, nil)
+
+			mockPubSub := pubsub.NewGoMockClient(ctrl)
+			mockPubSub.EXPECT().Publish(ctx, "user:balance:watch:1:BTC", gomock.Any()).Return(nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+					pubsub:  mockPubSub,
+				},
+				args:    args{ctx: ctx},
+				want:    1,
+				wantErr: nil,
+			}
+		},
+		"Given a due schedule of the last run, When the debit rejected by insufficient balance, Return the claimed schedule and complete it with the error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			schedule := &rpc.Schedule{
+				Id:         "6b7c8d9e-0000-4000-8000-000000000002",
+				UserId:     1,
+				Asset:      "BTC",
+				AmountSats: -1_000_000,
+				Interval:   rpc.ScheduleInterval_SCHEDULE_INTERVAL_WEEKLY,
+				StartAt:    timestamppb.New(startAt),
+				EndAt:      timestamppb.New(startAt.AddDate(0, 0, 10)),
+				NextRunAt:  timestamppb.New(startAt.AddDate(0, 0, 7)),
+				RunCount:   1,
+				Status:     rpc.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+			}
+
+			errBalance := fmt.Errorf("user id: 1: %w", repository.ErrInsufficientBalance)
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ClaimDueSchedules(ctx, 100, 5*time.Minute).Return([]*rpc.Schedule{schedule}, nil)
+			mockJourneyRepo.EXPECT().FindIdempotencyKeys(ctx, []string{
+				fmt.Sprintf("schedule:%s:%d", schedule.Id, startAt.AddDate(0, 0, 7).Unix()),
+			}).Return(map[string]bool{}, nil)
+			mockJourneyRepo.EXPECT().GetTransactionLimits(ctx, int64(1), "BTC").
+				Return(&rpc.TransactionLimits{UserId: 1, Asset: "BTC"}, nil)
+			mockJourneyRepo.EXPECT().CreateTransaction(ctx, &repository.CreateTransactionParams{
+				UserID:         1,
+				Datetime:       startAt.AddDate(0, 0, 7),
+				AmountSats:     -1_000_000,
+				IdempotencyKey: fmt.Sprintf("schedule:%s:%d", schedule.Id, startAt.AddDate(0, 0, 7).Unix()),
+				Asset:          "BTC",
+			}).Return(nil, nil, errBalance)
+			mockJourneyRepo.EXPECT().FinishScheduleRun(ctx, &repository.FinishScheduleRunParams{
+				ID:    schedule.Id,
+				RunAt: startAt.AddDate(0, 0, 7),
+				Error: errBalance.Error(),
+			}).Return(schedule, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args{ctx: ctx},
+				want:    1,
+				wantErr: nil,
+			}
+		},
+		"Given a due schedule, When the transaction failed temporarily, Return the claimed schedule without finishing the run": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			schedule := &rpc.Schedule{
+				Id:         "6b7c8d9e-0000-4000-8000-000000000003",
+				UserId:     1,
+				Asset:      "BTC",
+				AmountSats: 1_000_000,
+				Interval:   rpc.ScheduleInterval_SCHEDULE_INTERVAL_ONCE,
+				StartAt:    timestamppb.New(startAt),
+				NextRunAt:  timestamppb.New(startAt),
+				Status:     rpc.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ClaimDueSchedules(ctx, 100, 5*time.Minute).Return([]*rpc.Schedule{schedule}, nil)
+			mockJourneyRepo.EXPECT().CreateTransaction(ctx, gomock.Any()).Return(nil, nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args{ctx: ctx},
+				want:    1,
+				wantErr: nil,
+			}
+		},
+		"Given the due schedules, When repository failed to claim, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ClaimDueSchedules(ctx, 100, 5*time.Minute).Return(nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args{ctx: ctx},
+				want:    0,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.RunDueSchedules(tt.args.ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+)
+
+// CreateUser creates a new User, the User is active once created.
+func (u *btcUsecase) CreateUser(ctx context.Context, params *repository.CreateUserParams) (*rpc.User, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.CreateUser", nil)
+	defer span.End()
+
+	return u.btcRepo.CreateUser(ctx, params)
+}
+
+// GetUser get a single User by the ID.
+func (u *btcUsecase) GetUser(ctx context.Context, id int64) (*rpc.User, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.GetUser", nil)
+	defer span.End()
+
+	return u.btcRepo.GetUser(ctx, id)
+}
+
+// ListUsers get the list of Users ordered by the ID.
+// The Users can be filtered by the status.
+func (u *btcUsecase) ListUsers(ctx context.Context, params *repository.ListUsersParams) (*rpc.ListUsersResponse, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.ListUsers", nil)
+	defer span.End()
+
+	users, nextPageToken, err := u.btcRepo.ListUsers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.ListUsersResponse{
+		Users:         users,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// DeactivateUser deactivates a User, thus the User can't create transactions anymore.
+func (u *btcUsecase) DeactivateUser(ctx context.Context, id int64) (*rpc.User, error) {
+	ctx, span := u.trace.StartSpan(ctx, "UC.DeactivateUser", nil)
+	defer span.End()
+
+	return u.btcRepo.DeactivateUser(ctx, id)
+}
@@ -0,0 +1,187 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/internal/entities/repository"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBTCUC_ListUsers(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		params *repository.ListUsersParams
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.ListUsersResponse
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of ListUsers, When repository executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ListUsersParams{
+					PageSize: 1,
+				},
+			}
+
+			users := []*rpc.User{
+				{
+					Id:     1,
+					Status: rpc.UserStatus_USER_STATUS_ACTIVE,
+				},
+			}
+
+			want := &rpc.ListUsersResponse{
+				Users:         users,
+				NextPageToken: "MQ",
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ListUsers(args.ctx, args.params).Return(users, "MQ", nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of ListUsers, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				params: &repository.ListUsersParams{
+					PageSize: 1,
+				},
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().ListUsers(args.ctx, args.params).Return(nil, "", errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.ListUsers(tt.args.ctx, tt.args.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBTCUC_DeactivateUser(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+
+	type test struct {
+		fields  fields
+		args    args
+		want    *rpc.User
+		wantErr error
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller) test{
+		"Given valid request of DeactivateUser, When repository executed successfully, Return no error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				id:  1,
+			}
+
+			want := &rpc.User{
+				Id:     1,
+				Status: rpc.UserStatus_USER_STATUS_DEACTIVATED,
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().DeactivateUser(args.ctx, args.id).Return(want, nil)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    want,
+				wantErr: nil,
+			}
+		},
+		"Given valid request of DeactivateUser, When repository failed to executed, Return an error": func(t *testing.T, ctrl *gomock.Controller) test {
+			ctx := context.Background()
+
+			args := args{
+				ctx: ctx,
+				id:  1,
+			}
+
+			mockJourneyRepo := repository.NewGoMockBTCRepo(ctrl)
+			mockJourneyRepo.EXPECT().DeactivateUser(args.ctx, args.id).Return(nil, errInternal)
+
+			return test{
+				fields: fields{
+					btcRepo: mockJourneyRepo,
+				},
+				args:    args,
+				want:    nil,
+				wantErr: errInternal,
+			}
+		},
+	}
+
+	for name, testFn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tt := testFn(t, ctrl)
+
+			sut := sut(tt.fields)
+
+			got, err := sut.DeactivateUser(tt.args.ctx, tt.args.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+CREATE TABLE idempotency_keys (
+    idempotency_key TEXT PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    datetime TIMESTAMPTZ NOT NULL,
+    amount DECIMAL NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
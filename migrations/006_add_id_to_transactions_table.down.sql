@@ -0,0 +1,3 @@
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS transaction_id;
+DROP INDEX IF EXISTS idx_transactions_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS id;
@@ -0,0 +1,3 @@
+ALTER TABLE transactions ADD COLUMN id UUID NOT NULL DEFAULT gen_random_uuid();
+CREATE UNIQUE INDEX idx_transactions_id ON transactions (id, datetime);
+ALTER TABLE idempotency_keys ADD COLUMN transaction_id UUID;
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN overdraft_limit DECIMAL NOT NULL DEFAULT 0 CHECK (overdraft_limit >= 0);
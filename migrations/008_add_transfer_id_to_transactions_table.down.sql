@@ -0,0 +1,4 @@
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS counterparty_user_id;
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS transfer_id;
+DROP INDEX IF EXISTS idx_transactions_transfer_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_id;
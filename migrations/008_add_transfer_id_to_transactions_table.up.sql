@@ -0,0 +1,4 @@
+ALTER TABLE transactions ADD COLUMN transfer_id UUID;
+CREATE INDEX idx_transactions_transfer_id ON transactions (transfer_id) WHERE transfer_id IS NOT NULL;
+ALTER TABLE idempotency_keys ADD COLUMN transfer_id UUID;
+ALTER TABLE idempotency_keys ADD COLUMN counterparty_user_id INTEGER REFERENCES users (id);
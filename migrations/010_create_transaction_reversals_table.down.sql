@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS transaction_reversals;
+ALTER TABLE transactions DROP COLUMN IF EXISTS reversal_reason;
+ALTER TABLE transactions DROP COLUMN IF EXISTS reversed_id;
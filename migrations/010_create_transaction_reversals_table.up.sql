@@ -0,0 +1,7 @@
+ALTER TABLE transactions ADD COLUMN reversed_id UUID;
+ALTER TABLE transactions ADD COLUMN reversal_reason TEXT;
+CREATE TABLE transaction_reversals (
+    transaction_id UUID PRIMARY KEY,
+    reversal_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS user_balances;
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS asset;
+DROP INDEX IF EXISTS idx_transactions_user_id_asset;
+ALTER TABLE transactions DROP COLUMN IF EXISTS asset;
@@ -0,0 +1,9 @@
+ALTER TABLE transactions ADD COLUMN asset TEXT NOT NULL DEFAULT 'BTC';
+CREATE INDEX idx_transactions_user_id_asset ON transactions (user_id, asset, datetime);
+ALTER TABLE idempotency_keys ADD COLUMN asset TEXT NOT NULL DEFAULT 'BTC';
+CREATE TABLE user_balances (
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    asset TEXT NOT NULL,
+    balance DECIMAL NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, asset)
+);
@@ -0,0 +1,9 @@
+CREATE TABLE prices (
+    asset TEXT NOT NULL,
+    quote_currency TEXT NOT NULL,
+    datetime TIMESTAMPTZ NOT NULL,
+    price DECIMAL NOT NULL CHECK (price >= 0),
+    PRIMARY KEY (asset, quote_currency, datetime)
+);
+
+SELECT create_hypertable('prices', 'datetime');
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_transactions_user_id_external_ref;
+ALTER TABLE transactions DROP COLUMN IF EXISTS labels;
+ALTER TABLE transactions DROP COLUMN IF EXISTS external_ref;
+ALTER TABLE transactions DROP COLUMN IF EXISTS description;
+ALTER TABLE transactions DROP COLUMN IF EXISTS type;
@@ -0,0 +1,5 @@
+ALTER TABLE transactions ADD COLUMN type TEXT CHECK (type IN ('deposit', 'withdrawal', 'fee', 'adjustment'));
+ALTER TABLE transactions ADD COLUMN description TEXT;
+ALTER TABLE transactions ADD COLUMN external_ref TEXT;
+ALTER TABLE transactions ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+CREATE INDEX idx_transactions_user_id_external_ref ON transactions (user_id, external_ref, datetime) WHERE external_ref IS NOT NULL;
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS postings_apply_to_balances ON postings;
+DROP FUNCTION IF EXISTS apply_postings_to_balances();
+DROP TRIGGER IF EXISTS postings_balanced ON postings;
+DROP FUNCTION IF EXISTS check_journal_entry_balanced();
+DROP INDEX IF EXISTS idx_transactions_journal_entry_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS journal_entry_id;
+DROP TABLE IF EXISTS postings;
+DROP TABLE IF EXISTS journal_entries;
+DROP TABLE IF EXISTS ledger_accounts;
@@ -0,0 +1,121 @@
+CREATE TABLE ledger_accounts (
+    code TEXT PRIMARY KEY,
+    type TEXT NOT NULL CHECK (type IN ('user', 'fee', 'house')),
+    user_id INTEGER,
+    asset TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((type = 'user') = (user_id IS NOT NULL))
+);
+CREATE INDEX idx_ledger_accounts_user_id ON ledger_accounts (user_id, asset) WHERE user_id IS NOT NULL;
+
+CREATE TABLE journal_entries (
+    id UUID PRIMARY KEY,
+    kind TEXT NOT NULL CHECK (kind IN ('opening', 'transaction', 'transfer', 'reversal')),
+    datetime TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE postings (
+    id BIGSERIAL PRIMARY KEY,
+    journal_entry_id UUID NOT NULL REFERENCES journal_entries (id),
+    account_code TEXT NOT NULL REFERENCES ledger_accounts (code),
+    amount DECIMAL NOT NULL CHECK (amount <> 0)
+);
+CREATE INDEX idx_postings_journal_entry_id ON postings (journal_entry_id);
+CREATE INDEX idx_postings_account_code ON postings (account_code);
+
+ALTER TABLE transactions ADD COLUMN journal_entry_id UUID;
+CREATE INDEX idx_transactions_journal_entry_id ON transactions (journal_entry_id) WHERE journal_entry_id IS NOT NULL;
+
+CREATE TEMPORARY TABLE opening_balances AS
+    SELECT gen_random_uuid() AS id, user_id, asset, balance
+        FROM (
+            SELECT id AS user_id, 'BTC' AS asset, balance FROM users WHERE COALESCE(balance, 0) <> 0
+            UNION ALL
+            SELECT user_id, asset, balance FROM user_balances WHERE balance <> 0
+        ) b;
+
+INSERT INTO ledger_accounts (code, type, user_id, asset)
+    SELECT 'user:' || user_id || ':' || asset, 'user', user_id, asset FROM opening_balances
+    UNION ALL
+    SELECT DISTINCT 'house:' || asset, 'house', NULL::integer, asset FROM opening_balances;
+
+INSERT INTO journal_entries (id, kind, datetime)
+    SELECT id, 'opening', NOW() FROM opening_balances;
+
+INSERT INTO postings (journal_entry_id, account_code, amount)
+    SELECT id, 'user:' || user_id || ':' || asset, balance FROM opening_balances
+    UNION ALL
+    SELECT id, 'house:' || asset, -balance FROM opening_balances;
+
+DROP TABLE opening_balances;
+
+CREATE FUNCTION check_journal_entry_balanced() RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+            FROM postings p
+                JOIN ledger_accounts a ON a.code = p.account_code
+                    WHERE p.journal_entry_id = NEW.journal_entry_id
+                        GROUP BY a.asset
+                            HAVING SUM(p.amount) <> 0
+    ) THEN
+        RAISE EXCEPTION 'journal entry % is not balanced', NEW.journal_entry_id USING ERRCODE = 'BT002';
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER postings_balanced
+    AFTER INSERT ON postings
+        DEFERRABLE INITIALLY DEFERRED
+            FOR EACH ROW EXECUTE FUNCTION check_journal_entry_balanced();
+
+CREATE FUNCTION apply_postings_to_balances() RETURNS TRIGGER AS $$
+DECLARE
+    overdrawn_user_id INTEGER;
+BEGIN
+    UPDATE users u SET balance = COALESCE(u.balance, 0) + d.amount
+        FROM (
+            SELECT a.user_id, SUM(p.amount) AS amount
+                FROM new_postings p
+                    JOIN ledger_accounts a ON a.code = p.account_code
+                        WHERE a.type = 'user' AND a.asset = 'BTC'
+                            GROUP BY a.user_id
+        ) d
+            WHERE u.id = d.user_id;
+
+    INSERT INTO user_balances (user_id, asset, balance)
+        SELECT a.user_id, a.asset, SUM(p.amount)
+            FROM new_postings p
+                JOIN ledger_accounts a ON a.code = p.account_code
+                    WHERE a.type = 'user' AND a.asset <> 'BTC'
+                        GROUP BY a.user_id, a.asset
+                            ON CONFLICT (user_id, asset) DO UPDATE SET balance = user_balances.balance + EXCLUDED.balance;
+
+    SELECT a.user_id INTO overdrawn_user_id
+        FROM new_postings p
+            JOIN ledger_accounts a ON a.code = p.account_code
+            JOIN users u ON u.id = a.user_id
+            LEFT JOIN user_balances b ON b.user_id = a.user_id AND b.asset = a.asset
+                WHERE a.type = 'user'
+                    GROUP BY a.user_id, a.asset, u.balance, u.overdraft_limit, b.balance
+                        HAVING SUM(p.amount) < 0 AND CASE
+                            WHEN a.asset = 'BTC' THEN COALESCE(u.balance, 0) < -u.overdraft_limit
+                            ELSE COALESCE(b.balance, 0) < 0
+                        END
+                            LIMIT 1;
+
+    IF FOUND THEN
+        RAISE EXCEPTION 'user id: %', overdrawn_user_id USING ERRCODE = 'BT001';
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER postings_apply_to_balances
+    AFTER INSERT ON postings
+        REFERENCING NEW TABLE AS new_postings
+            FOR EACH STATEMENT EXECUTE FUNCTION apply_postings_to_balances();
@@ -0,0 +1,6 @@
+-- The postings of the repairs are kept, thus the balances are unchanged, the entries are converted into opening entries,
+-- since both post an adjustment against the house account without a transaction.
+UPDATE journal_entries SET kind = 'opening' WHERE kind = 'reconciliation';
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_kind_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_kind_check
+    CHECK (kind IN ('opening', 'transaction', 'transfer', 'reversal'));
@@ -0,0 +1,6 @@
+-- The postings of the imports are kept, thus the balances are unchanged, the entries are converted into transaction entries.
+UPDATE journal_entries SET kind = 'transaction' WHERE kind = 'import';
+ALTER TABLE journal_entries DROP CONSTRAINT IF EXISTS journal_entries_kind_check;
+ALTER TABLE journal_entries ADD CONSTRAINT journal_entries_kind_check
+    CHECK (kind IN ('opening', 'transaction', 'transfer', 'reversal', 'reconciliation'));
+DROP TABLE IF EXISTS import_checkpoints;
@@ -0,0 +1,45 @@
+CREATE OR REPLACE FUNCTION apply_postings_to_balances() RETURNS TRIGGER AS $$
+DECLARE
+    overdrawn_user_id INTEGER;
+BEGIN
+    UPDATE users u SET balance = COALESCE(u.balance, 0) + d.amount
+        FROM (
+            SELECT a.user_id, SUM(p.amount) AS amount
+                FROM new_postings p
+                    JOIN ledger_accounts a ON a.code = p.account_code
+                        WHERE a.type = 'user' AND a.asset = 'BTC'
+                            GROUP BY a.user_id
+        ) d
+            WHERE u.id = d.user_id;
+
+    INSERT INTO user_balances (user_id, asset, balance)
+        SELECT a.user_id, a.asset, SUM(p.amount)
+            FROM new_postings p
+                JOIN ledger_accounts a ON a.code = p.account_code
+                    WHERE a.type = 'user' AND a.asset <> 'BTC'
+                        GROUP BY a.user_id, a.asset
+                            ON CONFLICT (user_id, asset) DO UPDATE SET balance = user_balances.balance + EXCLUDED.balance;
+
+    SELECT a.user_id INTO overdrawn_user_id
+        FROM new_postings p
+            JOIN ledger_accounts a ON a.code = p.account_code
+            JOIN users u ON u.id = a.user_id
+            LEFT JOIN user_balances b ON b.user_id = a.user_id AND b.asset = a.asset
+                WHERE a.type = 'user'
+                    GROUP BY a.user_id, a.asset, u.balance, u.overdraft_limit, b.balance
+                        HAVING SUM(p.amount) < 0 AND CASE
+                            WHEN a.asset = 'BTC' THEN COALESCE(u.balance, 0) < -u.overdraft_limit
+                            ELSE COALESCE(b.balance, 0) < 0
+                        END
+                            LIMIT 1;
+
+    IF FOUND THEN
+        RAISE EXCEPTION 'user id: %', overdrawn_user_id USING ERRCODE = 'BT001';
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP FUNCTION IF EXISTS held_amount;
+DROP TABLE IF EXISTS holds;
@@ -0,0 +1,63 @@
+CREATE TABLE holds (
+    id UUID PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    asset TEXT NOT NULL,
+    amount DECIMAL NOT NULL CHECK (amount > 0),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'captured', 'released')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    description TEXT,
+    external_ref TEXT,
+    transaction_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    settled_at TIMESTAMPTZ
+);
+CREATE INDEX idx_holds_pending ON holds (user_id, asset) WHERE status = 'pending';
+
+CREATE FUNCTION held_amount(hold_user_id INTEGER, hold_asset TEXT) RETURNS DECIMAL AS $$
+    SELECT COALESCE(SUM(amount), 0)
+        FROM holds
+            WHERE user_id = hold_user_id AND asset = hold_asset AND status = 'pending' AND expires_at > NOW();
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION apply_postings_to_balances() RETURNS TRIGGER AS $$
+DECLARE
+    overdrawn_user_id INTEGER;
+BEGIN
+    UPDATE users u SET balance = COALESCE(u.balance, 0) + d.amount
+        FROM (
+            SELECT a.user_id, SUM(p.amount) AS amount
+                FROM new_postings p
+                    JOIN ledger_accounts a ON a.code = p.account_code
+                        WHERE a.type = 'user' AND a.asset = 'BTC'
+                            GROUP BY a.user_id
+        ) d
+            WHERE u.id = d.user_id;
+
+    INSERT INTO user_balances (user_id, asset, balance)
+        SELECT a.user_id, a.asset, SUM(p.amount)
+            FROM new_postings p
+                JOIN ledger_accounts a ON a.code = p.account_code
+                    WHERE a.type = 'user' AND a.asset <> 'BTC'
+                        GROUP BY a.user_id, a.asset
+                            ON CONFLICT (user_id, asset) DO UPDATE SET balance = user_balances.balance + EXCLUDED.balance;
+
+    SELECT a.user_id INTO overdrawn_user_id
+        FROM new_postings p
+            JOIN ledger_accounts a ON a.code = p.account_code
+            JOIN users u ON u.id = a.user_id
+            LEFT JOIN user_balances b ON b.user_id = a.user_id AND b.asset = a.asset
+                WHERE a.type = 'user'
+                    GROUP BY a.user_id, a.asset, u.balance, u.overdraft_limit, b.balance
+                        HAVING SUM(p.amount) < 0 AND CASE
+                            WHEN a.asset = 'BTC' THEN COALESCE(u.balance, 0) - held_amount(a.user_id, a.asset) < -u.overdraft_limit
+                            ELSE COALESCE(b.balance, 0) - held_amount(a.user_id, a.asset) < 0
+                        END
+                            LIMIT 1;
+
+    IF FOUND THEN
+        RAISE EXCEPTION 'user id: %', overdrawn_user_id USING ERRCODE = 'BT001';
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
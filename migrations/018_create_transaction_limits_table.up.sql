@@ -0,0 +1,10 @@
+CREATE TABLE transaction_limits (
+    user_id INTEGER NOT NULL,
+    asset TEXT NOT NULL,
+    max_amount DECIMAL CHECK (max_amount > 0),
+    max_daily_outflow DECIMAL CHECK (max_daily_outflow > 0),
+    max_count BIGINT CHECK (max_count > 0),
+    count_window_seconds BIGINT CHECK (count_window_seconds > 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, asset)
+);
@@ -0,0 +1,24 @@
+CREATE TABLE schedules (
+    id UUID PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    asset TEXT NOT NULL,
+    amount DECIMAL NOT NULL CHECK (amount <> 0),
+    type TEXT CHECK (type IN ('deposit', 'withdrawal', 'fee', 'adjustment')),
+    description TEXT,
+    labels JSONB NOT NULL DEFAULT '{}',
+    interval TEXT NOT NULL CHECK (interval IN ('once', 'daily', 'weekly', 'monthly')),
+    start_at TIMESTAMPTZ NOT NULL,
+    end_at TIMESTAMPTZ,
+    next_run_at TIMESTAMPTZ,
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'completed', 'cancelled')),
+    run_count BIGINT NOT NULL DEFAULT 0,
+    last_run_at TIMESTAMPTZ,
+    last_transaction_id UUID,
+    last_error TEXT,
+    locked_until TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    cancelled_at TIMESTAMPTZ,
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE INDEX idx_schedules_due ON schedules (next_run_at) WHERE status = 'active';
+CREATE INDEX idx_schedules_user_id ON schedules (user_id, created_at, id);
@@ -0,0 +1,72 @@
+ALTER TABLE users ADD COLUMN balance DECIMAL;
+UPDATE users SET balance = account_balance(id, 'BTC');
+
+CREATE TABLE user_balances (
+    user_id INTEGER NOT NULL REFERENCES users (id),
+    asset TEXT NOT NULL,
+    balance DECIMAL NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, asset)
+);
+
+INSERT INTO user_balances (user_id, asset, balance)
+    SELECT a.user_id, a.asset, SUM(p.amount)
+        FROM postings p
+            JOIN ledger_accounts a ON a.code = p.account_code
+                WHERE a.type = 'user' AND a.asset <> 'BTC'
+                    GROUP BY a.user_id, a.asset;
+
+DROP TRIGGER IF EXISTS postings_check_overdraft ON postings;
+DROP FUNCTION IF EXISTS check_postings_overdraft();
+
+CREATE FUNCTION apply_postings_to_balances() RETURNS TRIGGER AS $$
+DECLARE
+    overdrawn_user_id INTEGER;
+BEGIN
+    UPDATE users u SET balance = COALESCE(u.balance, 0) + d.amount
+        FROM (
+            SELECT a.user_id, SUM(p.amount) AS amount
+                FROM new_postings p
+                    JOIN ledger_accounts a ON a.code = p.account_code
+                        WHERE a.type = 'user' AND a.asset = 'BTC'
+                            GROUP BY a.user_id
+        ) d
+            WHERE u.id = d.user_id;
+
+    INSERT INTO user_balances (user_id, asset, balance)
+        SELECT a.user_id, a.asset, SUM(p.amount)
+            FROM new_postings p
+                JOIN ledger_accounts a ON a.code = p.account_code
+                    WHERE a.type = 'user' AND a.asset <> 'BTC'
+                        GROUP BY a.user_id, a.asset
+                            ON CONFLICT (user_id, asset) DO UPDATE SET balance = user_balances.balance + EXCLUDED.balance;
+
+    SELECT a.user_id INTO overdrawn_user_id
+        FROM new_postings p
+            JOIN ledger_accounts a ON a.code = p.account_code
+            JOIN users u ON u.id = a.user_id
+            LEFT JOIN user_balances b ON b.user_id = a.user_id AND b.asset = a.asset
+                WHERE a.type = 'user'
+                    GROUP BY a.user_id, a.asset, u.balance, u.overdraft_limit, b.balance
+                        HAVING SUM(p.amount) < 0 AND CASE
+                            WHEN a.asset = 'BTC' THEN COALESCE(u.balance, 0) - held_amount(a.user_id, a.asset) < -u.overdraft_limit
+                            ELSE COALESCE(b.balance, 0) - held_amount(a.user_id, a.asset) < 0
+                        END
+                            LIMIT 1;
+
+    IF FOUND THEN
+        RAISE EXCEPTION 'user id: %', overdrawn_user_id USING ERRCODE = 'BT001';
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER postings_apply_to_balances
+    AFTER INSERT ON postings
+        REFERENCING NEW TABLE AS new_postings
+            FOR EACH STATEMENT EXECUTE FUNCTION apply_postings_to_balances();
+
+DROP FUNCTION IF EXISTS account_balance;
+
+CREATE INDEX idx_postings_account_code ON postings (account_code);
+DROP INDEX IF EXISTS idx_postings_account_code_amount;
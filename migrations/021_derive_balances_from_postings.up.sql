@@ -0,0 +1,57 @@
+-- The balance is the sum of the postings of the account, the covering index keeps the sum an index only scan.
+CREATE INDEX idx_postings_account_code_amount ON postings (account_code) INCLUDE (amount);
+DROP INDEX IF EXISTS idx_postings_account_code;
+
+CREATE FUNCTION account_balance(account_user_id INTEGER, account_asset TEXT) RETURNS DECIMAL AS $$
+    SELECT COALESCE(SUM(amount), 0)
+        FROM postings
+            WHERE account_code = 'user:' || account_user_id || ':' || account_asset;
+$$ LANGUAGE sql STABLE;
+
+DROP TRIGGER IF EXISTS postings_apply_to_balances ON postings;
+DROP FUNCTION IF EXISTS apply_postings_to_balances();
+
+CREATE FUNCTION check_postings_overdraft() RETURNS TRIGGER AS $$
+DECLARE
+    overdrawn_user_id INTEGER;
+BEGIN
+    -- The Users are locked in the order of the ID before the balances are summed,
+    -- thus the concurrent debits of a User are checked one after another.
+    PERFORM 1
+        FROM users
+            WHERE id IN (
+                SELECT a.user_id
+                    FROM new_postings p
+                        JOIN ledger_accounts a ON a.code = p.account_code
+                            WHERE a.type = 'user'
+            )
+                ORDER BY id
+                    FOR UPDATE;
+
+    SELECT a.user_id INTO overdrawn_user_id
+        FROM new_postings p
+            JOIN ledger_accounts a ON a.code = p.account_code
+            JOIN users u ON u.id = a.user_id
+                WHERE a.type = 'user'
+                    GROUP BY a.user_id, a.asset, u.overdraft_limit
+                        HAVING SUM(p.amount) < 0
+                            AND account_balance(a.user_id, a.asset) - held_amount(a.user_id, a.asset)
+                                < CASE WHEN a.asset = 'BTC' THEN -u.overdraft_limit ELSE 0 END
+                            LIMIT 1;
+
+    IF FOUND THEN
+        RAISE EXCEPTION 'user id: %', overdrawn_user_id USING ERRCODE = 'BT001';
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER postings_check_overdraft
+    AFTER INSERT ON postings
+        REFERENCING NEW TABLE AS new_postings
+            FOR EACH STATEMENT EXECUTE FUNCTION check_postings_overdraft();
+
+-- The balances aren't kept next to the postings anymore, thus they can't disagree with the ledger.
+DROP TABLE IF EXISTS user_balances;
+ALTER TABLE users DROP COLUMN IF EXISTS balance;
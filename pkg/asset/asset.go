@@ -0,0 +1,170 @@
+// Package asset is the registry of the assets custodied by the service, with their precision.
+package asset
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrUnknown is an error for indicates the asset is not in the registry.
+	ErrUnknown = errors.New("unknown asset")
+	// ErrTooPrecise is an error for indicates the amount has more precision than the minor unit of the asset.
+	ErrTooPrecise = errors.New("amount has more precision than the minor unit of the asset")
+	// ErrOutOfRange is an error for indicates the amount can't be represented in the minor unit of the asset.
+	ErrOutOfRange = errors.New("amount is out of range")
+)
+
+// Asset is a registered asset.
+// The amounts of the asset are carried in int64 minor units, e.g. satoshis for BTC.
+type Asset struct {
+	Code     string
+	Decimals int
+}
+
+var (
+	// BTC uses satoshi as the minor unit.
+	BTC = Asset{Code: "BTC", Decimals: 8}
+	// ETH uses gwei as the minor unit, since wei overflows int64 above ~9.2 ETH.
+	ETH = Asset{Code: "ETH", Decimals: 9}
+	// USDT uses the same precision as the token contract.
+	USDT = Asset{Code: "USDT", Decimals: 6}
+)
+
+// QuoteDecimals is the precision of the fiat values of the amounts, e.g. cents of USD.
+const QuoteDecimals = 2
+
+// Default is the asset when the code is not set, thus the clients before the multi-asset support keep using BTC.
+var Default = BTC
+
+// registry is the allowed assets by the code.
+var registry = map[string]Asset{
+	BTC.Code:  BTC,
+	ETH.Code:  ETH,
+	USDT.Code: USDT,
+}
+
+// Lookup returns the registered asset by the code, the empty code returns the Default.
+func Lookup(code string) (Asset, error) {
+	if code == "" {
+		return Default, nil
+	}
+
+	a, ok := registry[code]
+	if !ok {
+		return Asset{}, fmt.Errorf("asset: %s: %w", code, ErrUnknown)
+	}
+
+	return a, nil
+}
+
+// Scale returns the number of minor units in 1 unit of the asset.
+func (a Asset) Scale() int64 {
+	scale := int64(1)
+
+	for i := 0; i < a.Decimals; i++ {
+		scale *= 10
+	}
+
+	return scale
+}
+
+// FromUnits converts the amount in units of the asset into minor units.
+// The conversion is based on the shortest decimal representation of the float,
+// thus it's exact and returns ErrTooPrecise if the amount has more decimal places than the asset.
+func (a Asset) FromUnits(amount float64) (int64, error) {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0, fmt.Errorf("amount: %v: %w", amount, ErrOutOfRange)
+	}
+
+	return a.ParseUnits(strconv.FormatFloat(amount, 'f', -1, 64))
+}
+
+// ParseUnits converts the decimal string of the amount in units of the asset into minor units,
+// e.g. a NUMERIC selected as text from the database.
+// The trailing zeros of the fraction are ignored, thus "0.10000000000" is a valid BTC amount.
+func (a Asset) ParseUnits(amount string) (int64, error) {
+	str := strings.TrimPrefix(amount, "-")
+
+	whole, fraction, _ := strings.Cut(str, ".")
+
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > a.Decimals {
+		return 0, fmt.Errorf("amount: %s: %w", amount, ErrTooPrecise)
+	}
+
+	minor, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", a.Decimals-len(fraction)), 10, 64)
+	if err != nil || minor < 0 {
+		return 0, fmt.Errorf("amount: %s: %w", amount, ErrOutOfRange)
+	}
+
+	if len(str) != len(amount) {
+		minor = -minor
+	}
+
+	return minor, nil
+}
+
+// ToUnits converts the amount in minor units into units of the asset.
+// The result is the nearest float to the exact decimal amount.
+func (a Asset) ToUnits(minor int64) float64 {
+	return float64(minor) / float64(a.Scale())
+}
+
+// FormatUnits converts the amount in minor units into the exact decimal string in units of the asset,
+// without the trailing zeros of the fraction, e.g. "-0.5" for -50_000_000 satoshis.
+func (a Asset) FormatUnits(minor int64) string {
+	sign, abs := "", uint64(minor)
+	if minor < 0 {
+		sign, abs = "-", -abs
+	}
+
+	str := strconv.FormatUint(abs, 10)
+
+	if len(str) <= a.Decimals {
+		str = strings.Repeat("0", a.Decimals-len(str)+1) + str
+	}
+
+	whole, fraction := str[:len(str)-a.Decimals], strings.TrimRight(str[len(str)-a.Decimals:], "0")
+	if fraction == "" {
+		return sign + whole
+	}
+
+	return sign + whole + "." + fraction
+}
+
+// QuoteValue values the amount in minor units at the price of 1 unit of the asset.
+// The value is computed in exact decimals from the shortest decimal representation of the price,
+// and rounded once half away from zero to the QuoteDecimals, thus it carries no binary rounding error
+// besides the nearest float to the rounded decimal.
+func (a Asset) QuoteValue(minor int64, price float64) float64 {
+	p, ok := new(big.Rat).SetString(strconv.FormatFloat(price, 'f', -1, 64))
+	if !ok {
+		return math.NaN()
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(QuoteDecimals), nil)
+
+	// The value in the minor unit of the quote currency is minor * price * 10^QuoteDecimals / scale.
+	v := new(big.Rat).Mul(new(big.Rat).SetInt64(minor), p)
+	v.Mul(v, new(big.Rat).SetInt(unit))
+	v.Quo(v, new(big.Rat).SetInt64(a.Scale()))
+
+	q, r := new(big.Int).QuoRem(v.Num(), v.Denom(), new(big.Int))
+
+	// The denominator is positive, thus the remainder has the sign of the value.
+	if new(big.Int).Lsh(new(big.Int).Abs(r), 1).Cmp(v.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+
+	value, err := strconv.ParseFloat(new(big.Rat).SetFrac(q, unit).FloatString(QuoteDecimals), 64)
+	if err != nil {
+		return math.NaN()
+	}
+
+	return value
+}
@@ -0,0 +1,161 @@
+package asset_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/moemoe89/btc/pkg/asset"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	type test struct {
+		code    string
+		want    asset.Asset
+		wantErr error
+	}
+
+	tests := map[string]test{
+		"Given empty code, Return the default asset": {
+			code: "",
+			want: asset.BTC,
+		},
+		"Given registered code, Return the asset": {
+			code: "USDT",
+			want: asset.USDT,
+		},
+		"Given unknown code, Return an error": {
+			code:    "DOGE",
+			wantErr: asset.ErrUnknown,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := asset.Lookup(tt.code)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAsset_FromUnits(t *testing.T) {
+	type test struct {
+		asset   asset.Asset
+		amount  float64
+		want    int64
+		wantErr error
+	}
+
+	tests := map[string]test{
+		"Given fraction of ETH, Return the exact gwei": {
+			asset:  asset.ETH,
+			amount: 1.000000001,
+			want:   1_000_000_001,
+		},
+		"Given negative USDT, Return the negative minor units": {
+			asset:  asset.USDT,
+			amount: -2.5,
+			want:   -2_500_000,
+		},
+		"Given more precision than USDT, Return an error": {
+			asset:   asset.USDT,
+			amount:  0.0000001,
+			wantErr: asset.ErrTooPrecise,
+		},
+		"Given too big amount of ETH, Return an error": {
+			asset:   asset.ETH,
+			amount:  1e10,
+			wantErr: asset.ErrOutOfRange,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tt.asset.FromUnits(tt.amount)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAsset_ParseUnits(t *testing.T) {
+	type test struct {
+		asset   asset.Asset
+		amount  string
+		want    int64
+		wantErr error
+	}
+
+	tests := map[string]test{
+		"Given decimal with trailing zeros, Return the exact satoshis": {
+			asset:  asset.BTC,
+			amount: "-0.100000000000",
+			want:   -10_000_000,
+		},
+		"Given whole amount of USDT, Return the minor units": {
+			asset:  asset.USDT,
+			amount: "12",
+			want:   12_000_000,
+		},
+		"Given more precision than BTC, Return an error": {
+			asset:   asset.BTC,
+			amount:  "0.000000001",
+			wantErr: asset.ErrTooPrecise,
+		},
+		"Given not a decimal, Return an error": {
+			asset:   asset.BTC,
+			amount:  "abc",
+			wantErr: asset.ErrOutOfRange,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tt.asset.ParseUnits(tt.amount)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAsset_ToUnits(t *testing.T) {
+	assert.Equal(t, 2.5, asset.USDT.ToUnits(2_500_000))
+	assert.Equal(t, 0.00000001, asset.BTC.ToUnits(1))
+	assert.Equal(t, 1.000000001, asset.ETH.ToUnits(1_000_000_001))
+}
+
+func TestAsset_FormatUnits(t *testing.T) {
+	assert.Equal(t, "2.5", asset.USDT.FormatUnits(2_500_000))
+	assert.Equal(t, "0.00000001", asset.BTC.FormatUnits(1))
+	assert.Equal(t, "-100", asset.BTC.FormatUnits(-10_000_000_000))
+	assert.Equal(t, "0", asset.BTC.FormatUnits(0))
+	assert.Equal(t, "-9223372036.854775808", asset.ETH.FormatUnits(math.MinInt64))
+}
+
+func TestAsset_QuoteValue(t *testing.T) {
+	// 0.1 BTC at 0.3 is 0.03 exactly, while 0.1 * 0.3 in float is 0.030000000000000002.
+	assert.Equal(t, 0.03, asset.BTC.QuoteValue(10_000_000, 0.3))
+	assert.Equal(t, 2010.12, asset.BTC.QuoteValue(10_050_617, 20_000))
+	// The half cent is rounded away from zero.
+	assert.Equal(t, 0.01, asset.BTC.QuoteValue(1_000_000, 0.5))
+	assert.Equal(t, -0.01, asset.BTC.QuoteValue(-1_000_000, 0.5))
+	assert.Equal(t, 0.0, asset.BTC.QuoteValue(999_999, 0.5))
+	assert.Equal(t, 2.5, asset.USDT.QuoteValue(2_500_000, 1))
+}
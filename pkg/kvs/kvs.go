@@ -14,6 +14,9 @@ type Client interface {
 	Set(ctx context.Context, key string, value interface{}, expire time.Duration) (interface{}, error)
 	// Get gets the value by the given key.
 	Get(ctx context.Context, key string) (interface{}, error)
+	// IncrBy atomically increments the integer value of the key by the given value, the missing key starts from 0.
+	// The expiration time is refreshed on every increment. Returns the value after the increment.
+	IncrBy(ctx context.Context, key string, value int64, expire time.Duration) (int64, error)
 	// Close closes the connection of KVS client.
 	Close() error
 }
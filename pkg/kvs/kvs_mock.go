@@ -64,6 +64,21 @@ func (mr *GoMockClientMockRecorder) Get(ctx, key interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*GoMockClient)(nil).Get), ctx, key)
 }
 
+// IncrBy mocks base method.
+func (m *GoMockClient) IncrBy(ctx context.Context, key string, value int64, expire time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrBy", ctx, key, value, expire)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrBy indicates an expected call of IncrBy.
+func (mr *GoMockClientMockRecorder) IncrBy(ctx, key, value, expire interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrBy", reflect.TypeOf((*GoMockClient)(nil).IncrBy), ctx, key, value, expire)
+}
+
 // Set mocks base method.
 func (m *GoMockClient) Set(ctx context.Context, key string, value interface{}, expire time.Duration) (interface{}, error) {
 	m.ctrl.T.Helper()
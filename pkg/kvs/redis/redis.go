@@ -77,6 +77,23 @@ func (r *redisClient) Get(ctx context.Context, key string) (interface{}, error)
 	return val, nil
 }
 
+func (r *redisClient) IncrBy(ctx context.Context, key string, value int64, expire time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+
+	// The increment and the expiration are executed in a transaction, thus the key can't be left without the expiration.
+	_, err := r.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.IncrBy(ctx, key, value)
+		pipe.Expire(ctx, key, expire)
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute incrby command of redis. key: %v, value: %v: %w", key, value, err)
+	}
+
+	return incr.Val(), nil
+}
+
 func (r *redisClient) Close() error {
 	if err := r.Client.Close(); err != nil {
 		return fmt.Errorf("failed to close redis connection: %w", err)
@@ -0,0 +1,33 @@
+package mq
+
+//go:generate rm -f ./mq_mock.go
+//go:generate mockgen -destination mq_mock.go -package mq -mock_names Publisher=GoMockPublisher -source mq.go
+
+import (
+	"context"
+	"time"
+)
+
+// Message is a message published to the message queue.
+type Message struct {
+	// ID is the unique ID of the message, it's kept when the message is published again,
+	// thus it can be used by the consumers to drop the duplicates.
+	ID string
+	// Type is the type of the message, also used as the routing key.
+	Type string
+	// Body is the JSON of the message.
+	Body []byte
+	// Headers are the attributes of the message, e.g. the User ID to partition the messages by.
+	Headers map[string]string
+	// Timestamp is the date and time the message is created.
+	Timestamp time.Time
+}
+
+// Publisher is an interface for publishing messages to the durable message queue.
+type Publisher interface {
+	// Publish publishes the message, and waits until it's confirmed by the broker.
+	// The messages published by the same Publisher are routed in the order of the calls.
+	Publish(ctx context.Context, msg *Message) error
+	// Close closes the connection of the Publisher.
+	Close() error
+}
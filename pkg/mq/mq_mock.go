@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: mq.go
+
+// Package mq is a generated GoMock package.
+package mq
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// GoMockPublisher is a mock of Publisher interface.
+type GoMockPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *GoMockPublisherMockRecorder
+}
+
+// GoMockPublisherMockRecorder is the mock recorder for GoMockPublisher.
+type GoMockPublisherMockRecorder struct {
+	mock *GoMockPublisher
+}
+
+// NewGoMockPublisher creates a new mock instance.
+func NewGoMockPublisher(ctrl *gomock.Controller) *GoMockPublisher {
+	mock := &GoMockPublisher{ctrl: ctrl}
+	mock.recorder = &GoMockPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *GoMockPublisher) EXPECT() *GoMockPublisherMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *GoMockPublisher) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *GoMockPublisherMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*GoMockPublisher)(nil).Close))
+}
+
+// Publish mocks base method.
+func (m *GoMockPublisher) Publish(ctx context.Context, msg *Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *GoMockPublisherMockRecorder) Publish(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*GoMockPublisher)(nil).Publish), ctx, msg)
+}
@@ -0,0 +1,159 @@
+package rabbitmq
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/moemoe89/btc/pkg/mq"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type rabbitMQPublisher struct {
+	url            string
+	exchange       string
+	confirmTimeout time.Duration
+
+	// mu serializes the publishing, thus the messages are routed in the order of the calls.
+	mu   sync.Mutex
+	conn *amqp.Connection
+	ch   *amqp.Channel
+}
+
+// New returns RabbitMQ publisher implementations.
+// The connection is opened on the first publish and opened again after it's lost,
+// thus the service can start while the broker isn't reachable.
+func New(opts ...Option) (mq.Publisher, error) {
+	r := new(rabbitMQPublisher)
+
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(r); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+func (r *rabbitMQPublisher) Publish(ctx context.Context, msg *mq.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.connect(); err != nil {
+		return err
+	}
+
+	headers := make(amqp.Table, len(msg.Headers))
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+
+	//nolint:exhaustivestruct
+	confirmation, err := r.ch.PublishWithDeferredConfirmWithContext(ctx, r.exchange, msg.Type, false, false, amqp.Publishing{
+		Headers:      headers,
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    msg.ID,
+		Timestamp:    msg.Timestamp,
+		Type:         msg.Type,
+		Body:         msg.Body,
+	})
+	if err != nil {
+		r.reset()
+
+		return fmt.Errorf("failed to publish message of rabbitmq. id: %v: %w", msg.ID, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.confirmTimeout)
+	defer cancel()
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		// The confirmation of the next message can't be told apart from a late one, thus the channel is opened again.
+		r.reset()
+
+		return fmt.Errorf("failed to wait confirmation of rabbitmq. id: %v: %w", msg.ID, err)
+	}
+
+	// The pending confirmations are nacked when the channel is closed.
+	if !acked {
+		r.reset()
+
+		return fmt.Errorf("message of rabbitmq is nacked. id: %v", msg.ID)
+	}
+
+	return nil
+}
+
+func (r *rabbitMQPublisher) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.conn == nil {
+		return nil
+	}
+
+	err := r.conn.Close()
+
+	r.conn, r.ch = nil, nil
+
+	return err
+}
+
+// connect opens the connection and the channel in the confirm mode, unless they're already open.
+// The exchange is declared durable, thus the messages routed to the durable queues survive a restart of the broker.
+func (r *rabbitMQPublisher) connect() error {
+	if r.ch != nil && !r.ch.IsClosed() && !r.conn.IsClosed() {
+		return nil
+	}
+
+	r.reset()
+
+	conn, err := amqp.Dial(r.url)
+	if err != nil {
+		return fmt.Errorf("failed to connect to rabbitmq: %w", err)
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+
+		return fmt.Errorf("failed to open a channel of rabbitmq: %w", err)
+	}
+
+	err = ch.ExchangeDeclare(
+		r.exchange, // name
+		"topic",    // type
+		true,       // durable
+		false,      // auto-deleted
+		false,      // internal
+		false,      // no-wait
+		nil,        // arguments
+	)
+	if err != nil {
+		_ = conn.Close()
+
+		return fmt.Errorf("failed to declare exchange of rabbitmq. exchange: %v: %w", r.exchange, err)
+	}
+
+	if err = ch.Confirm(false); err != nil {
+		_ = conn.Close()
+
+		return fmt.Errorf("failed to put channel of rabbitmq into confirm mode: %w", err)
+	}
+
+	r.conn, r.ch = conn, ch
+
+	return nil
+}
+
+// reset closes the connection, thus it's opened again by the next publish.
+func (r *rabbitMQPublisher) reset() {
+	if r.conn != nil {
+		_ = r.conn.Close()
+	}
+
+	r.conn, r.ch = nil, nil
+}
@@ -0,0 +1,99 @@
+package local
+
+import (
+	"context"
+	"sync"
+
+	"github.com/moemoe89/btc/pkg/pubsub"
+)
+
+// bufferSize is the number of messages buffered for each subscription.
+// The messages are dropped when the subscriber doesn't keep up.
+const bufferSize = 100
+
+type localClient struct {
+	mu            sync.RWMutex
+	subscriptions map[string]map[*subscription]struct{}
+}
+
+// New returns in-memory pub/sub implementations.
+// The messages are only fan-out within the process, thus it's meant for tests and local development.
+func New() pubsub.Client {
+	return &localClient{
+		subscriptions: make(map[string]map[*subscription]struct{}),
+	}
+}
+
+func (c *localClient) Publish(_ context.Context, channel string, message []byte) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for s := range c.subscriptions[channel] {
+		select {
+		case s.messages <- message:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (c *localClient) Subscribe(_ context.Context, channel string) (pubsub.Subscription, error) {
+	s := &subscription{
+		client:   c,
+		channel:  channel,
+		messages: make(chan []byte, bufferSize),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.subscriptions[channel] == nil {
+		c.subscriptions[channel] = make(map[*subscription]struct{})
+	}
+
+	c.subscriptions[channel][s] = struct{}{}
+
+	return s, nil
+}
+
+func (c *localClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for channel, subscriptions := range c.subscriptions {
+		for s := range subscriptions {
+			s.closeOnce.Do(func() { close(s.messages) })
+		}
+
+		delete(c.subscriptions, channel)
+	}
+
+	return nil
+}
+
+type subscription struct {
+	client    *localClient
+	channel   string
+	messages  chan []byte
+	closeOnce sync.Once
+}
+
+func (s *subscription) Messages() <-chan []byte {
+	return s.messages
+}
+
+func (s *subscription) Close() error {
+	s.client.mu.Lock()
+	defer s.client.mu.Unlock()
+
+	delete(s.client.subscriptions[s.channel], s)
+
+	if len(s.client.subscriptions[s.channel]) == 0 {
+		delete(s.client.subscriptions, s.channel)
+	}
+
+	s.closeOnce.Do(func() { close(s.messages) })
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package local_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moemoe89/btc/pkg/pubsub/local"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocal_PublishSubscribe(t *testing.T) {
+	ctx := context.Background()
+
+	sut := local.New()
+	defer func() { _ = sut.Close() }()
+
+	sub1, err := sut.Subscribe(ctx, "channel-1")
+	assert.NoError(t, err)
+
+	sub2, err := sut.Subscribe(ctx, "channel-1")
+	assert.NoError(t, err)
+
+	other, err := sut.Subscribe(ctx, "channel-2")
+	assert.NoError(t, err)
+
+	err = sut.Publish(ctx, "channel-1", []byte("message"))
+	assert.NoError(t, err)
+
+	// The message is fan-out to all subscribers of the channel.
+	assert.Equal(t, []byte("message"), <-sub1.Messages())
+	assert.Equal(t, []byte("message"), <-sub2.Messages())
+	assert.Len(t, other.Messages(), 0)
+
+	// The closed subscription doesn't receive the message anymore.
+	assert.NoError(t, sub1.Close())
+
+	_, ok := <-sub1.Messages()
+	assert.False(t, ok)
+
+	err = sut.Publish(ctx, "channel-1", []byte("message-2"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []byte("message-2"), <-sub2.Messages())
+}
+
+func TestLocal_Close(t *testing.T) {
+	ctx := context.Background()
+
+	sut := local.New()
+
+	sub, err := sut.Subscribe(ctx, "channel-1")
+	assert.NoError(t, err)
+
+	assert.NoError(t, sut.Close())
+
+	_, ok := <-sub.Messages()
+	assert.False(t, ok)
+
+	// Close the subscription after the client is closed is safe.
+	assert.NoError(t, sub.Close())
+}
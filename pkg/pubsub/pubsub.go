@@ -0,0 +1,29 @@
+package pubsub
+
+//go:generate rm -f ./pubsub_mock.go
+//go:generate mockgen -destination pubsub_mock.go -package pubsub -mock_names Client=GoMockClient,Subscription=GoMockSubscription -source pubsub.go
+
+import (
+	"context"
+)
+
+// Client is an interface for publish/subscribe messaging.
+// The messages are fan-out to all subscribers of the channel, across the instances.
+type Client interface {
+	// Publish publishes the message to the channel.
+	Publish(ctx context.Context, channel string, message []byte) error
+	// Subscribe subscribes to the channel.
+	// The subscription is active once this returns, thus no messages published afterward are missed.
+	Subscribe(ctx context.Context, channel string) (Subscription, error)
+	// Close closes the connection of pub/sub client.
+	Close() error
+}
+
+// Subscription is an interface for an active subscription of a channel.
+type Subscription interface {
+	// Messages returns the channel of the received messages.
+	// The channel is closed when the subscription is closed.
+	Messages() <-chan []byte
+	// Close unsubscribes from the channel.
+	Close() error
+}
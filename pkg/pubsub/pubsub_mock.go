@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pubsub.go
+
+// Package pubsub is a generated GoMock package.
+package pubsub
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// GoMockClient is a mock of Client interface.
+type GoMockClient struct {
+	ctrl     *gomock.Controller
+	recorder *GoMockClientMockRecorder
+}
+
+// GoMockClientMockRecorder is the mock recorder for GoMockClient.
+type GoMockClientMockRecorder struct {
+	mock *GoMockClient
+}
+
+// NewGoMockClient creates a new mock instance.
+func NewGoMockClient(ctrl *gomock.Controller) *GoMockClient {
+	mock := &GoMockClient{ctrl: ctrl}
+	mock.recorder = &GoMockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *GoMockClient) EXPECT() *GoMockClientMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *GoMockClient) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *GoMockClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*GoMockClient)(nil).Close))
+}
+
+// Publish mocks base method.
+func (m *GoMockClient) Publish(ctx context.Context, channel string, message []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, channel, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *GoMockClientMockRecorder) Publish(ctx, channel, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*GoMockClient)(nil).Publish), ctx, channel, message)
+}
+
+// Subscribe mocks base method.
+func (m *GoMockClient) Subscribe(ctx context.Context, channel string) (Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, channel)
+	ret0, _ := ret[0].(Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *GoMockClientMockRecorder) Subscribe(ctx, channel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*GoMockClient)(nil).Subscribe), ctx, channel)
+}
+
+// GoMockSubscription is a mock of Subscription interface.
+type GoMockSubscription struct {
+	ctrl     *gomock.Controller
+	recorder *GoMockSubscriptionMockRecorder
+}
+
+// GoMockSubscriptionMockRecorder is the mock recorder for GoMockSubscription.
+type GoMockSubscriptionMockRecorder struct {
+	mock *GoMockSubscription
+}
+
+// NewGoMockSubscription creates a new mock instance.
+func NewGoMockSubscription(ctrl *gomock.Controller) *GoMockSubscription {
+	mock := &GoMockSubscription{ctrl: ctrl}
+	mock.recorder = &GoMockSubscriptionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *GoMockSubscription) EXPECT() *GoMockSubscriptionMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *GoMockSubscription) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *GoMockSubscriptionMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*GoMockSubscription)(nil).Close))
+}
+
+// Messages mocks base method.
+func (m *GoMockSubscription) Messages() <-chan []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Messages")
+	ret0, _ := ret[0].(<-chan []byte)
+	return ret0
+}
+
+// Messages indicates an expected call of Messages.
+func (mr *GoMockSubscriptionMockRecorder) Messages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Messages", reflect.TypeOf((*GoMockSubscription)(nil).Messages))
+}
@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Option configures Redis pub/sub client.
+type Option func(r *redisClient) error
+
+var defaultOptions = []Option{
+	WithAddr("127.0.0.1:6379"),
+	WithDB(0),
+}
+
+// WithAddr returns an option that set address.
+func WithAddr(addr string) Option {
+	return func(r *redisClient) error {
+		if len(addr) == 0 {
+			return errors.New("failed to set redis.addr")
+		}
+
+		r.addr = addr
+
+		return nil
+	}
+}
+
+// WithPassword returns an option that set password.
+func WithPassword(pass string) Option {
+	return func(r *redisClient) error {
+		if len(pass) == 0 {
+			return errors.New("failed to set redis.password")
+		}
+
+		r.password = pass
+
+		return nil
+	}
+}
+
+// WithDB returns an option that set db.
+func WithDB(db int) Option {
+	return func(r *redisClient) error {
+		if db < 0 {
+			return fmt.Errorf("failed to set redis.db: %d", db)
+		}
+
+		r.db = db
+
+		return nil
+	}
+}
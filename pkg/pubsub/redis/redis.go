@@ -0,0 +1,111 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/moemoe89/btc/pkg/pubsub"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisClient struct {
+	*redis.Client
+
+	addr     string
+	password string
+	db       int
+}
+
+// New returns Redis pub/sub implementations.
+func New(opts ...Option) (pubsub.Client, error) {
+	r := new(redisClient)
+
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(r); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	//nolint:exhaustivestruct
+	r.Client = redis.NewClient(&redis.Options{
+		Addr:     r.addr,
+		Password: r.password,
+		DB:       r.db,
+	})
+
+	return r, nil
+}
+
+func (r *redisClient) Publish(ctx context.Context, channel string, message []byte) error {
+	if err := r.Client.Publish(ctx, channel, message).Err(); err != nil {
+		return fmt.Errorf("failed to execute publish command of redis. channel: %v: %w", channel, err)
+	}
+
+	return nil
+}
+
+func (r *redisClient) Subscribe(ctx context.Context, channel string) (pubsub.Subscription, error) {
+	ps := r.Client.Subscribe(ctx, channel)
+
+	// Wait for the confirmation, thus the subscription is active once this returns.
+	if _, err := ps.Receive(ctx); err != nil {
+		_ = ps.Close()
+
+		return nil, fmt.Errorf("failed to execute subscribe command of redis. channel: %v: %w", channel, err)
+	}
+
+	s := &subscription{
+		PubSub:   ps,
+		messages: make(chan []byte),
+		done:     make(chan struct{}),
+	}
+
+	go s.forward()
+
+	return s, nil
+}
+
+func (r *redisClient) Close() error {
+	if err := r.Client.Close(); err != nil {
+		return fmt.Errorf("failed to close redis connection: %w", err)
+	}
+
+	return nil
+}
+
+type subscription struct {
+	*redis.PubSub
+
+	messages chan []byte
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+// forward forwards the payload of the received messages, until the subscription is closed.
+func (s *subscription) forward() {
+	defer close(s.messages)
+
+	for msg := range s.PubSub.Channel() {
+		select {
+		case s.messages <- []byte(msg.Payload):
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *subscription) Messages() <-chan []byte {
+	return s.messages
+}
+
+func (s *subscription) Close() error {
+	s.doneOnce.Do(func() { close(s.done) })
+
+	if err := s.PubSub.Close(); err != nil {
+		return fmt.Errorf("failed to close redis subscription: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package satoshi
+
+import (
+	"github.com/moemoe89/btc/pkg/asset"
+)
+
+// PerBTC is the number of satoshis in 1 BTC.
+const PerBTC = 100_000_000
+
+var (
+	// ErrSubSatoshi is an error for indicates the amount has more precision than 1 satoshi.
+	ErrSubSatoshi = asset.ErrTooPrecise
+	// ErrOutOfRange is an error for indicates the amount can't be represented in satoshis.
+	ErrOutOfRange = asset.ErrOutOfRange
+)
+
+// FromBTC converts the amount in BTC into satoshis.
+// The conversion is based on the shortest decimal representation of the float,
+// thus it's exact and returns ErrSubSatoshi if the amount has more than 8 decimal places.
+func FromBTC(btc float64) (int64, error) {
+	return asset.BTC.FromUnits(btc)
+}
+
+// ToBTC converts the amount in satoshis into BTC.
+// The result is the nearest float to the exact decimal amount.
+func ToBTC(sats int64) float64 {
+	return asset.BTC.ToUnits(sats)
+}
@@ -0,0 +1,68 @@
+package satoshi_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/moemoe89/btc/pkg/satoshi"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromBTC(t *testing.T) {
+	type test struct {
+		btc     float64
+		want    int64
+		wantErr error
+	}
+
+	tests := map[string]test{
+		"Given whole BTC, Return the satoshis": {
+			btc:  100,
+			want: 10_000_000_000,
+		},
+		"Given fraction of BTC, Return the exact satoshis": {
+			btc:  0.1,
+			want: 10_000_000,
+		},
+		"Given 1 satoshi, Return 1": {
+			btc:  0.00000001,
+			want: 1,
+		},
+		"Given negative BTC, Return the negative satoshis": {
+			btc:  -100.5,
+			want: -10_050_000_000,
+		},
+		"Given sub-satoshi precision, Return an error": {
+			btc:     0.000000001,
+			wantErr: satoshi.ErrSubSatoshi,
+		},
+		"Given too big amount, Return an error": {
+			btc:     1e12,
+			wantErr: satoshi.ErrOutOfRange,
+		},
+		"Given NaN, Return an error": {
+			btc:     math.NaN(),
+			wantErr: satoshi.ErrOutOfRange,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := satoshi.FromBTC(tt.btc)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestToBTC(t *testing.T) {
+	assert.Equal(t, 100.5, satoshi.ToBTC(10_050_000_000))
+	assert.Equal(t, 0.1, satoshi.ToBTC(10_000_000))
+	assert.Equal(t, -0.00000001, satoshi.ToBTC(-1))
+}
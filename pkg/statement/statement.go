@@ -0,0 +1,192 @@
+// Package statement renders the account statement of a User into a plain text or HTML document.
+package statement
+
+import (
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"text/tabwriter"
+	texttemplate "text/template"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/pkg/asset"
+)
+
+// ErrUnknownFormat is an error for indicates the format of the document is not supported.
+var ErrUnknownFormat = errors.New("unknown statement format")
+
+// dateTimeLayout is the layout of the date and time within the document.
+const dateTimeLayout = "2006-01-02 15:04:05 MST"
+
+// textTemplate is the plain text document, the columns are separated by tabs and aligned by a tabwriter.
+var textTemplate = texttemplate.Must(texttemplate.New("text").Parse(`ACCOUNT STATEMENT
+
+User:	{{.UserID}}
+Asset:	{{.Asset}}
+Period:	{{.StartDatetime}} to {{.EndDatetime}}
+Generated:	{{.GeneratedAt}}
+
+Opening balance:	{{.OpeningBalance}}
+Total in:	{{.TotalIn}}
+Total out:	{{.TotalOut}}
+Closing balance:	{{.ClosingBalance}}
+
+Date	Type	Description	Reference	Amount	Balance
+{{range .Rows}}{{.Datetime}}	{{.Type}}	{{.Description}}	{{.ExternalRef}}	{{.Amount}}	{{.Balance}}
+{{else}}No transactions within the period.
+{{end}}`))
+
+// htmlTemplate is the HTML document, the values are escaped by the template.
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("html").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Account Statement - User {{.UserID}} - {{.Asset}}</title>
+</head>
+<body>
+<h1>Account Statement</h1>
+<table>
+<tr><th>User</th><td>{{.UserID}}</td></tr>
+<tr><th>Asset</th><td>{{.Asset}}</td></tr>
+<tr><th>Period</th><td>{{.StartDatetime}} to {{.EndDatetime}}</td></tr>
+<tr><th>Generated</th><td>{{.GeneratedAt}}</td></tr>
+</table>
+<h2>Summary</h2>
+<table>
+<tr><th>Opening balance</th><td>{{.OpeningBalance}}</td></tr>
+<tr><th>Total in</th><td>{{.TotalIn}}</td></tr>
+<tr><th>Total out</th><td>{{.TotalOut}}</td></tr>
+<tr><th>Closing balance</th><td>{{.ClosingBalance}}</td></tr>
+</table>
+<h2>Transactions</h2>
+<table>
+<tr><th>Date</th><th>Type</th><th>Description</th><th>Reference</th><th>Amount</th><th>Balance</th></tr>
+{{range .Rows}}<tr><td>{{.Datetime}}</td><td>{{.Type}}</td><td>{{.Description}}</td><td>{{.ExternalRef}}</td><td>{{.Amount}}</td><td>{{.Balance}}</td></tr>
+{{else}}<tr><td colspan="6">No transactions within the period.</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// document is the values of the rendered statement, the amounts are the exact decimals in units of the asset.
+type document struct {
+	UserID         int64
+	Asset          string
+	StartDatetime  string
+	EndDatetime    string
+	GeneratedAt    string
+	OpeningBalance string
+	TotalIn        string
+	TotalOut       string
+	ClosingBalance string
+	Rows           []row
+}
+
+// row is a transaction of the rendered statement, with the running balance right after the transaction.
+type row struct {
+	Datetime    string
+	Type        string
+	Description string
+	ExternalRef string
+	Amount      string
+	Balance     string
+}
+
+// ContentType returns the content type of the document in the format.
+func ContentType(format rpc.StatementFormat) string {
+	if format == rpc.StatementFormat_STATEMENT_FORMAT_HTML {
+		return "text/html; charset=utf-8"
+	}
+
+	return "text/plain; charset=utf-8"
+}
+
+// Extension returns the file extension of the document in the format, without the dot.
+func Extension(format rpc.StatementFormat) string {
+	if format == rpc.StatementFormat_STATEMENT_FORMAT_HTML {
+		return "html"
+	}
+
+	return "txt"
+}
+
+// Render writes the document of the statement in the format, the unspecified format is plain text.
+func Render(w io.Writer, s *rpc.Statement, format rpc.StatementFormat) error {
+	switch format {
+	case rpc.StatementFormat_STATEMENT_FORMAT_UNSPECIFIED, rpc.StatementFormat_STATEMENT_FORMAT_TEXT:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+		if err := textTemplate.Execute(tw, newDocument(s, textValue)); err != nil {
+			return err
+		}
+
+		return tw.Flush()
+	case rpc.StatementFormat_STATEMENT_FORMAT_HTML:
+		return htmlTemplate.Execute(w, newDocument(s, func(v string) string { return v }))
+	default:
+		return fmt.Errorf("format: %s: %w", format, ErrUnknownFormat)
+	}
+}
+
+// newDocument converts the statement into the values of the document, the free-text values are cleaned by the clean.
+func newDocument(s *rpc.Statement, clean func(string) string) *document {
+	a, _ := asset.Lookup(s.GetAsset())
+
+	doc := &document{
+		UserID:         s.GetUserId(),
+		Asset:          a.Code,
+		StartDatetime:  formatDateTime(s.GetStartDatetime().AsTime()),
+		EndDatetime:    formatDateTime(s.GetEndDatetime().AsTime()),
+		GeneratedAt:    formatDateTime(s.GetGeneratedAt().AsTime()),
+		OpeningBalance: a.FormatUnits(s.GetOpeningBalanceSats()),
+		TotalIn:        a.FormatUnits(s.GetTotalInSats()),
+		TotalOut:       a.FormatUnits(s.GetTotalOutSats()),
+		ClosingBalance: a.FormatUnits(s.GetClosingBalanceSats()),
+		Rows:           make([]row, 0, len(s.GetTransactions())),
+	}
+
+	balanceSats := s.GetOpeningBalanceSats()
+
+	for _, transaction := range s.GetTransactions() {
+		balanceSats += transaction.GetAmountSats()
+
+		// The reversal has no description, thus the reason is shown instead.
+		description := transaction.GetDescription()
+		if description == "" {
+			description = transaction.GetReversalReason()
+		}
+
+		doc.Rows = append(doc.Rows, row{
+			Datetime:    formatDateTime(transaction.GetDatetime().AsTime()),
+			Type:        typeName(transaction.GetType()),
+			Description: clean(description),
+			ExternalRef: clean(transaction.GetExternalRef()),
+			Amount:      a.FormatUnits(transaction.GetAmountSats()),
+			Balance:     a.FormatUnits(balanceSats),
+		})
+	}
+
+	return doc
+}
+
+// formatDateTime formats the date and time in UTC.
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeLayout)
+}
+
+// typeName returns the lower-case name of the type without the prefix, empty for the unspecified type.
+func typeName(t rpc.TransactionType) string {
+	if t == rpc.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+		return ""
+	}
+
+	return strings.ToLower(strings.TrimPrefix(t.String(), "TRANSACTION_TYPE_"))
+}
+
+// textValue replaces the tabs and the line breaks of a free-text value, since they break the columns of the plain text.
+func textValue(v string) string {
+	return strings.NewReplacer("\t", " ", "\r", " ", "\n", " ").Replace(v)
+}
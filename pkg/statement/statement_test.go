@@ -0,0 +1,142 @@
+package statement_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/pkg/statement"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRender(t *testing.T) {
+	type test struct {
+		statement *rpc.Statement
+		format    rpc.StatementFormat
+		want      string
+		wantErr   error
+	}
+
+	datetime := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	s := &rpc.Statement{
+		UserId:             1,
+		Asset:              "BTC",
+		StartDatetime:      timestamppb.New(datetime),
+		EndDatetime:        timestamppb.New(datetime.AddDate(0, 1, 0)),
+		OpeningBalanceSats: 5_000_000_000,
+		TotalInSats:        10_050_000_000,
+		TotalOutSats:       2_000_000_000,
+		ClosingBalanceSats: 13_050_000_000,
+		Transactions: []*rpc.Transaction{
+			{
+				Datetime:    timestamppb.New(datetime.Add(time.Hour)),
+				AmountSats:  10_050_000_000,
+				Asset:       "BTC",
+				Type:        rpc.TransactionType_TRANSACTION_TYPE_DEPOSIT,
+				Description: "salary\t<february>",
+				ExternalRef: "bank-1",
+			},
+			{
+				Datetime:       timestamppb.New(datetime.AddDate(0, 0, 1)),
+				AmountSats:     -2_000_000_000,
+				Asset:          "BTC",
+				ReversalReason: "duplicated",
+			},
+		},
+		GeneratedAt: timestamppb.New(datetime.AddDate(0, 1, 0)),
+	}
+
+	tests := map[string]test{
+		"Given unspecified format, Return the plain text document with the running balance": {
+			statement: s,
+			format:    rpc.StatementFormat_STATEMENT_FORMAT_UNSPECIFIED,
+			want: `ACCOUNT STATEMENT
+
+User:       1
+Asset:      BTC
+Period:     2023-02-01 00:00:00 UTC to 2023-03-01 00:00:00 UTC
+Generated:  2023-03-01 00:00:00 UTC
+
+Opening balance:  50
+Total in:         100.5
+Total out:        20
+Closing balance:  130.5
+
+Date                     Type     Description        Reference  Amount  Balance
+2023-02-01 01:00:00 UTC  deposit  salary <february>  bank-1     100.5   150.5
+2023-02-02 00:00:00 UTC           duplicated                    -20     130.5
+`,
+		},
+		"Given HTML format, Return the HTML document with the escaped values": {
+			statement: &rpc.Statement{
+				UserId:        1,
+				Asset:         "ETH",
+				StartDatetime: timestamppb.New(datetime),
+				EndDatetime:   timestamppb.New(datetime.AddDate(0, 1, 0)),
+				Transactions: []*rpc.Transaction{
+					{
+						Datetime:    timestamppb.New(datetime),
+						AmountSats:  1,
+						Asset:       "ETH",
+						Description: "<b>gas</b>",
+					},
+				},
+				GeneratedAt: timestamppb.New(datetime),
+			},
+			format: rpc.StatementFormat_STATEMENT_FORMAT_HTML,
+			want: `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Account Statement - User 1 - ETH</title>
+</head>
+<body>
+<h1>Account Statement</h1>
+<table>
+<tr><th>User</th><td>1</td></tr>
+<tr><th>Asset</th><td>ETH</td></tr>
+<tr><th>Period</th><td>2023-02-01 00:00:00 UTC to 2023-03-01 00:00:00 UTC</td></tr>
+<tr><th>Generated</th><td>2023-02-01 00:00:00 UTC</td></tr>
+</table>
+<h2>Summary</h2>
+<table>
+<tr><th>Opening balance</th><td>0</td></tr>
+<tr><th>Total in</th><td>0</td></tr>
+<tr><th>Total out</th><td>0</td></tr>
+<tr><th>Closing balance</th><td>0</td></tr>
+</table>
+<h2>Transactions</h2>
+<table>
+<tr><th>Date</th><th>Type</th><th>Description</th><th>Reference</th><th>Amount</th><th>Balance</th></tr>
+<tr><td>2023-02-01 00:00:00 UTC</td><td></td><td>&lt;b&gt;gas&lt;/b&gt;</td><td></td><td>0.000000001</td><td>0.000000001</td></tr>
+</table>
+</body>
+</html>
+`,
+		},
+		"Given unknown format, Return an error": {
+			statement: s,
+			format:    rpc.StatementFormat(99),
+			wantErr:   statement.ErrUnknownFormat,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := statement.Render(&buf, tt.statement, tt.format)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
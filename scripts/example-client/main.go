@@ -7,6 +7,7 @@ import (
 	"time"
 
 	rpc "github.com/moemoe89/btc/api/go/grpc"
+	"github.com/moemoe89/btc/pkg/satoshi"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -24,20 +25,28 @@ func main() {
 	client := rpc.NewBTCServiceClient(conn)
 
 	rand.Seed(time.Now().UnixNano())
-	num := rand.Float64() * 100
-	num = float64(int(num+0.5)) / 100
+	amountSats := rand.Int63n(satoshi.PerBTC) + 1
 
 	// Call CreateTransaction RPC.
 	transaction, err := client.CreateTransaction(context.Background(), &rpc.CreateTransactionRequest{
-		UserId:   1,
-		Datetime: timestamppb.New(time.Now()),
-		Amount:   num,
+		UserId:     1,
+		Datetime:   timestamppb.New(time.Now()),
+		AmountSats: amountSats,
 	})
 	if err != nil {
 		log.Fatal(err)
 	}
 	log.Printf("transaction created: %v\n", transaction)
 
+	// Call GetTransaction RPC.
+	transaction, err = client.GetTransaction(context.Background(), &rpc.GetTransactionRequest{
+		Id: transaction.GetId(),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("getting transaction: %v\n", transaction)
+
 	// Call ListTransaction RPC.
 	transactions, err := client.ListTransaction(context.Background(), &rpc.ListTransactionRequest{
 		UserId:        1,
@@ -57,4 +66,15 @@ func main() {
 		log.Fatal(err)
 	}
 	log.Printf("getting user balance: %v\n", balance)
+
+	// Call GetBalanceHistory RPC.
+	history, err := client.GetBalanceHistory(context.Background(), &rpc.GetBalanceHistoryRequest{
+		UserId:        1,
+		StartDatetime: timestamppb.New(time.Now().Add(-24 * time.Hour)),
+		EndDatetime:   timestamppb.New(time.Now()),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("getting user balance history: %v\n", history)
 }
@@ -7,14 +7,16 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/moemoe89/btc/pkg/satoshi"
+
 	"github.com/google/uuid"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
 type Transaction struct {
-	UserID   int64     `json:"user_id"`
-	Amount   float64   `json:"amount"`
-	Datetime time.Time `json:"datetime"`
+	UserID     int64     `json:"user_id"`
+	AmountSats int64     `json:"amount_sats"`
+	Datetime   time.Time `json:"datetime"`
 }
 
 const (
@@ -49,13 +51,12 @@ func main() {
 	}
 
 	rand.Seed(time.Now().UnixNano())
-	num := rand.Float64() * 100
-	num = float64(int(num+0.5)) / 100
+	amountSats := rand.Int63n(satoshi.PerBTC) + 1
 
 	trx := &Transaction{
-		UserID:   1,
-		Amount:   num,
-		Datetime: time.Now(),
+		UserID:     1,
+		AmountSats: amountSats,
+		Datetime:   time.Now(),
 	}
 
 	body, err := json.Marshal(trx)
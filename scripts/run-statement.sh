@@ -0,0 +1,20 @@
+#!/bin/bash
+
+# app config
+export APP_ENV=dev
+
+# master db config
+export POSTGRES_USER_MASTER=test
+export POSTGRES_PASSWORD_MASTER=test
+export POSTGRES_HOST_MASTER=localhost
+export POSTGRES_PORT_MASTER=5432
+export POSTGRES_DB_MASTER=test
+
+# slave db config
+export POSTGRES_USER_SLAVE=test
+export POSTGRES_PASSWORD_SLAVE=test
+export POSTGRES_HOST_SLAVE=localhost
+export POSTGRES_PORT_SLAVE=5433
+export POSTGRES_DB_SLAVE=test
+
+go build -o main-statement ./cmd/statement/main.go && ./main-statement "$@"